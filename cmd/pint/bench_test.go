@@ -19,6 +19,7 @@ func BenchmarkFindEntries(b *testing.B) {
 	finder := discovery.NewGlobFinder(
 		[]string{"bench/rules"},
 		git.NewPathFilter(nil, nil, nil),
+		false,
 	)
 	for n := 0; n < b.N; n++ {
 		_, _ = finder.Find()
@@ -31,6 +32,7 @@ func BenchmarkCheckRules(b *testing.B) {
 	finder := discovery.NewGlobFinder(
 		[]string{"bench/rules"},
 		git.NewPathFilter(nil, nil, nil),
+		false,
 	)
 	entries, err := finder.Find()
 	if err != nil {
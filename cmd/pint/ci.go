@@ -20,9 +20,10 @@ import (
 )
 
 var (
-	baseBranchFlag = "base-branch"
-	failOnFlag     = "fail-on"
-	teamCityFlag   = "teamcity"
+	baseBranchFlag       = "base-branch"
+	failOnFlag           = "fail-on"
+	teamCityFlag         = "teamcity"
+	annotateGitBlameFlag = "annotate-git-blame"
 )
 
 var ciCmd = &cli.Command{
@@ -44,7 +45,7 @@ var ciCmd = &cli.Command{
 		},
 		&cli.StringFlag{
 			Name:    failOnFlag,
-			Aliases: []string{"w"},
+			Aliases: []string{"w", "fail-on-severity"},
 			Value:   "bug",
 			Usage:   "Exit with non-zero code if there are problems with given severity (or higher) detected.",
 		},
@@ -54,6 +55,11 @@ var ciCmd = &cli.Command{
 			Value:   false,
 			Usage:   "Print found problems using TeamCity Service Messages format.",
 		},
+		&cli.BoolFlag{
+			Name:  annotateGitBlameFlag,
+			Value: false,
+			Usage: "Use git blame to find and report the author who last changed each rule with problems.",
+		},
 	},
 }
 
@@ -93,17 +99,22 @@ func actionCI(c *cli.Context) error {
 	var entries []discovery.Entry
 	filter := git.NewPathFilter(includeRe, excludeRe, meta.cfg.Parser.CompileRelaxed())
 
-	entries, err = discovery.NewGlobFinder([]string{"*"}, filter).Find()
+	entries, err = discovery.NewGlobFinder([]string{"*"}, filter, meta.cfg.Parser.ReportEmpty).Find()
 	if err != nil {
 		return err
 	}
 
-	entries, err = discovery.NewGitBranchFinder(git.RunGit, filter, baseBranch, meta.cfg.CI.MaxCommits).Find(entries)
+	entries, err = discovery.NewGitBranchFinder(git.RunGit, filter, baseBranch, meta.cfg.CI.MaxCommits, meta.cfg.Parser.ReportEmpty).Find(entries)
 	if err != nil {
 		return err
 	}
 
-	ctx := context.WithValue(context.Background(), config.CommandKey, config.CICommand)
+	if c.Bool(annotateGitBlameFlag) {
+		entries = discovery.NewGitBlameAnnotator(git.RunGit).Annotate(entries)
+	}
+
+	ctx, cancel := meta.withTimeout(context.WithValue(context.Background(), config.CommandKey, config.CICommand))
+	defer cancel()
 
 	gen := config.NewPrometheusGenerator(meta.cfg, metricsRegistry)
 	defer gen.Stop()
@@ -115,6 +126,9 @@ func actionCI(c *cli.Context) error {
 	slog.Debug("Generated all Prometheus servers", slog.Int("count", gen.Count()))
 
 	summary, err := checkRules(ctx, meta.workers, meta.isOffline, gen, meta.cfg, entries)
+	if terr := meta.timeoutError(ctx); terr != nil {
+		return terr
+	}
 	if err != nil {
 		return err
 	}
@@ -208,6 +222,9 @@ func actionCI(c *cli.Context) error {
 		return fmt.Errorf("submitting reports: %w", err)
 	}
 
+	if err = fatalProblemsError(bySeverity); err != nil {
+		return err
+	}
 	if problemsFound {
 		return fmt.Errorf("problems found")
 	}
@@ -224,6 +241,15 @@ func logSeverityCounters(src map[checks.Severity]int) (attrs []any) {
 	return attrs
 }
 
+// fatalProblemsError returns an error if there are any problems with Fatal
+// severity, those always fail the run, no matter what --fail-on is set to.
+func fatalProblemsError(src map[checks.Severity]int) error {
+	if c := src[checks.Fatal]; c > 0 {
+		return fmt.Errorf("%w: found %d problem(s) with severity %s", errFatal, c, checks.Fatal)
+	}
+	return nil
+}
+
 func detectCI(cfg *config.CI) *config.CI {
 	var isNil, isDirty bool
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"regexp"
 
 	"github.com/cloudflare/pint/internal/checks"
@@ -16,7 +17,11 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-var requireOwnerFlag = "require-owner"
+var (
+	requireOwnerFlag = "require-owner"
+	outputFlag       = "output"
+	diffFlag         = "diff"
+)
 
 var lintCmd = &cli.Command{
 	Name:   "lint",
@@ -47,6 +52,16 @@ var lintCmd = &cli.Command{
 			Value:   false,
 			Usage:   "Report problems using TeamCity Service Messages.",
 		},
+		&cli.StringFlag{
+			Name:  outputFlag,
+			Value: "console",
+			Usage: "Set output format for reported problems, one of: console, html. HTML reports are written to stdout.",
+		},
+		&cli.BoolFlag{
+			Name:  diffFlag,
+			Value: false,
+			Usage: "Only report problems on lines modified in the working tree compared to git HEAD.",
+		},
 	},
 }
 
@@ -61,14 +76,36 @@ func actionLint(c *cli.Context) error {
 		return fmt.Errorf("at least one file or directory required")
 	}
 
+	minSeverity, err := checks.ParseSeverity(c.String(minSeverityFlag))
+	if err != nil {
+		return fmt.Errorf("invalid --%s value: %w", minSeverityFlag, err)
+	}
+	failOn, err := checks.ParseSeverity(c.String(failOnFlag))
+	if err != nil {
+		return fmt.Errorf("invalid --%s value: %w", failOnFlag, err)
+	}
+
+	var r reporter.Reporter
+	switch {
+	case c.Bool(teamCityFlag):
+		r = reporter.NewTeamCityReporter(os.Stderr)
+	case c.String(outputFlag) == "html":
+		r = reporter.NewHTMLReporter(os.Stdout, minSeverity)
+	case c.String(outputFlag) == "console":
+		r = reporter.NewConsoleReporter(os.Stderr, minSeverity)
+	default:
+		return fmt.Errorf("invalid --%s value: %q, must be one of: console, html", outputFlag, c.String(outputFlag))
+	}
+
 	slog.Info("Finding all rules to check", slog.Any("paths", paths))
-	finder := discovery.NewGlobFinder(paths, git.NewPathFilter(nil, nil, meta.cfg.Parser.CompileRelaxed()))
+	finder := discovery.NewGlobFinder(paths, git.NewPathFilter(nil, nil, meta.cfg.Parser.CompileRelaxed()), meta.cfg.Parser.ReportEmpty)
 	entries, err := finder.Find()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.WithValue(context.Background(), config.CommandKey, config.LintCommand)
+	ctx, cancel := meta.withTimeout(context.WithValue(context.Background(), config.CommandKey, config.LintCommand))
+	defer cancel()
 
 	gen := config.NewPrometheusGenerator(meta.cfg, metricsRegistry)
 	defer gen.Stop()
@@ -78,6 +115,9 @@ func actionLint(c *cli.Context) error {
 	}
 
 	summary, err := checkRules(ctx, meta.workers, meta.isOffline, gen, meta.cfg, entries)
+	if terr := meta.timeoutError(ctx); terr != nil {
+		return terr
+	}
 	if err != nil {
 		return err
 	}
@@ -86,20 +126,18 @@ func actionLint(c *cli.Context) error {
 		summary.Report(verifyOwners(entries, meta.cfg.Owners.CompileAllowed())...)
 	}
 
-	minSeverity, err := checks.ParseSeverity(c.String(minSeverityFlag))
-	if err != nil {
-		return fmt.Errorf("invalid --%s value: %w", minSeverityFlag, err)
-	}
-	failOn, err := checks.ParseSeverity(c.String(failOnFlag))
-	if err != nil {
-		return fmt.Errorf("invalid --%s value: %w", failOnFlag, err)
-	}
-
-	var r reporter.Reporter
-	if c.Bool(teamCityFlag) {
-		r = reporter.NewTeamCityReporter(os.Stderr)
-	} else {
-		r = reporter.NewConsoleReporter(os.Stderr, minSeverity)
+	if c.Bool(diffFlag) {
+		diffLines, err := git.DiffLines(git.RunGit)
+		if err != nil {
+			return fmt.Errorf("failed to get git diff: %w", err)
+		}
+		untracked, err := git.UntrackedFiles(git.RunGit)
+		if err != nil {
+			return fmt.Errorf("failed to get the list of untracked files: %w", err)
+		}
+		summary.FilterReports(func(report reporter.Report) bool {
+			return isReportInDiff(report, diffLines, untracked)
+		})
 	}
 
 	err = r.Submit(summary)
@@ -127,6 +165,9 @@ func actionLint(c *cli.Context) error {
 		slog.Info(fmt.Sprintf("%d problem(s) not visible because of --%s=%s flag", hiddenProblems, minSeverityFlag, c.String(minSeverityFlag)))
 	}
 
+	if err = fatalProblemsError(bySeverity); err != nil {
+		return err
+	}
 	if failProblems > 0 {
 		return fmt.Errorf("found %d problem(s) with severity %s or higher", failProblems, failOn)
 	}
@@ -182,3 +223,26 @@ func verifyOwners(entries []discovery.Entry, allowedOwners []*regexp.Regexp) (re
 	}
 	return reports
 }
+
+// isReportInDiff returns true if problem lines of given report overlap
+// with any of the lines modified in the git diff.
+// All lines of untracked files are treated as modified.
+func isReportInDiff(r reporter.Report, diffLines map[string][]int, untracked []string) bool {
+	for _, path := range untracked {
+		path = filepath.Clean(path)
+		if path == filepath.Clean(r.Path.Name) || path == filepath.Clean(r.Path.SymlinkTarget) {
+			return true
+		}
+	}
+
+	lines, ok := diffLines[filepath.Clean(r.Path.Name)]
+	if !ok {
+		lines = diffLines[filepath.Clean(r.Path.SymlinkTarget)]
+	}
+	for _, line := range lines {
+		if line >= r.Problem.Lines.First && line <= r.Problem.Lines.Last {
+			return true
+		}
+	}
+	return false
+}
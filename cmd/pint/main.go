@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	"go.uber.org/automaxprocs/maxprocs"
@@ -18,6 +21,19 @@ const (
 	offlineFlag  = "offline"
 	noColorFlag  = "no-color"
 	workersFlag  = "workers"
+	timeoutFlag  = "timeout"
+
+	// Exit code used when --timeout was reached, so it can be told apart
+	// from a run that completed but found problems.
+	timeoutExitCode = 2
+	// Exit code used when any problem with Fatal severity was found,
+	// regardless of the --fail-on value.
+	fatalExitCode = 3
+)
+
+var (
+	errTimeout = errors.New("timeout reached")
+	errFatal   = errors.New("fatal error")
 )
 
 var (
@@ -32,39 +48,51 @@ func newApp() *cli.App {
 			&cli.PathFlag{
 				Name:    configFlag,
 				Aliases: []string{"c"},
+				EnvVars: []string{"PINT_CONFIG"},
 				Value:   ".pint.hcl",
 				Usage:   "Configuration file to use.",
 			},
 			&cli.IntFlag{
 				Name:    workersFlag,
 				Aliases: []string{"w"},
+				EnvVars: []string{"PINT_WORKERS"},
 				Value:   10,
 				Usage:   "Number of worker threads for running checks.",
 			},
 			&cli.StringFlag{
 				Name:    logLevelFlag,
 				Aliases: []string{"l"},
+				EnvVars: []string{"PINT_LOG_LEVEL"},
 				Value:   slog.LevelInfo.String(),
 				Usage:   "Log level.",
 			},
 			&cli.BoolFlag{
 				Name:    noColorFlag,
 				Aliases: []string{"n"},
+				EnvVars: []string{"PINT_NO_COLOR"},
 				Value:   false,
 				Usage:   "Disable output colouring.",
 			},
 			&cli.StringSliceFlag{
 				Name:    disabledFlag,
 				Aliases: []string{"d"},
+				EnvVars: []string{"PINT_DISABLED"},
 				Value:   cli.NewStringSlice(),
 				Usage:   "List of checks to disable (example: promql/cost).",
 			},
 			&cli.BoolFlag{
 				Name:    offlineFlag,
 				Aliases: []string{"o"},
+				EnvVars: []string{"PINT_OFFLINE"},
 				Value:   false,
 				Usage:   "Disable all check that send live queries to Prometheus servers.",
 			},
+			&cli.DurationFlag{
+				Name:    timeoutFlag,
+				EnvVars: []string{"PINT_TIMEOUT"},
+				Value:   0,
+				Usage:   "Abort the run if it takes longer than given duration, 0 means no timeout.",
+			},
 		},
 		Commands: []*cli.Command{
 			versionCmd,
@@ -81,6 +109,24 @@ type actionMeta struct {
 	cfg       config.Config
 	isOffline bool
 	workers   int
+	timeout   time.Duration
+}
+
+// withTimeout returns a context that will be cancelled once --timeout duration passes.
+func (meta actionMeta) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if meta.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, meta.timeout)
+}
+
+// timeoutError returns an error if given context was cancelled because
+// --timeout duration passed.
+func (meta actionMeta) timeoutError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: run didn't complete in %s", errTimeout, meta.timeout)
+	}
+	return nil
 }
 
 func actionSetup(c *cli.Context) (meta actionMeta, err error) {
@@ -100,6 +146,11 @@ func actionSetup(c *cli.Context) (meta actionMeta, err error) {
 		return meta, fmt.Errorf("--%s flag must be > 0", workersFlag)
 	}
 
+	meta.timeout = c.Duration(timeoutFlag)
+	if meta.timeout < 0 {
+		return meta, fmt.Errorf("--%s flag cannot be negative", timeoutFlag)
+	}
+
 	meta.cfg, err = config.Load(c.Path(configFlag), c.IsSet(configFlag))
 	if err != nil {
 		return meta, fmt.Errorf("failed to load config file %q: %w", c.Path(configFlag), err)
@@ -118,6 +169,12 @@ func main() {
 	err := app.Run(os.Args)
 	if err != nil {
 		slog.Error("Execution completed with error(s)", slog.Any("err", err))
+		if errors.Is(err, errTimeout) {
+			os.Exit(timeoutExitCode)
+		}
+		if errors.Is(err, errFatal) {
+			os.Exit(fatalExitCode)
+		}
 		os.Exit(1)
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -124,6 +125,29 @@ func httpServer(ts *testscript.TestScript, _ bool, args []string) {
 		mocks.add(name, httpMock{pattern: path, handler: func(w http.ResponseWriter, r *http.Request) {
 			username, password, ok := r.BasicAuth()
 			if ok && username == user && password == pass {
+				if json.Valid([]byte(body)) {
+					w.Header().Set("Content-Type", "application/json")
+				}
+				w.WriteHeader(code)
+				_, err := w.Write([]byte(body))
+				ts.Check(err)
+				return
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+		}})
+	// http bearer-response name /200 token 200 OK
+	case "bearer-response":
+		if len(args) < 6 {
+			ts.Fatalf("! http response command requires '$NAME $PATH $TOKEN $CODE $BODY' args, got [%s]", strings.Join(args, " "))
+		}
+		name := args[1]
+		path := regexp.MustCompile(args[2])
+		token := args[3]
+		code, err := strconv.Atoi(args[4])
+		ts.Check(err)
+		body := strings.Join(args[5:], " ")
+		mocks.add(name, httpMock{pattern: path, handler: func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "Bearer "+token {
 				w.WriteHeader(code)
 				_, err := w.Write([]byte(body))
 				ts.Check(err)
@@ -31,6 +31,7 @@ var (
 
 const (
 	yamlParseReporter   = "yaml/parse"
+	yamlEmptyReporter   = "yaml/empty"
 	ignoreFileReporter  = "ignore/file"
 	pintCommentReporter = "pint/comment"
 )
@@ -84,11 +85,14 @@ func checkRules(ctx context.Context, workers int, isOffline bool, gen *config.Pr
 	results := make(chan reporter.Report, workers*5)
 	wg := sync.WaitGroup{}
 
+	settings, err := cfg.CheckSettings()
+	if err != nil {
+		return summary, err
+	}
+
 	ctx = context.WithValue(ctx, promapi.AllPrometheusServers, gen.Servers())
-	for _, s := range cfg.Check {
-		settings, _ := s.Decode()
-		key := checks.SettingsKey(s.Name)
-		ctx = context.WithValue(ctx, key, settings)
+	for name, s := range settings {
+		ctx = context.WithValue(ctx, checks.SettingsKey(name), s)
 	}
 
 	for w := 1; w <= workers; w++ {
@@ -106,6 +110,7 @@ func checkRules(ctx context.Context, workers int, isOffline bool, gen *config.Pr
 
 	var onlineChecksCount, offlineChecksCount, checkedEntriesCount atomic.Int64
 	go func() {
+		defer close(jobs)
 		for _, entry := range entries {
 			switch {
 			case entry.State == discovery.Excluded:
@@ -133,7 +138,7 @@ func checkRules(ctx context.Context, workers int, isOffline bool, gen *config.Pr
 				}
 
 				checkedEntriesCount.Inc()
-				checkList := cfg.GetChecksForRule(ctx, gen, entry, entry.DisabledChecks)
+				checkList := cfg.GetChecksForRule(ctx, gen, entry, settings, entry.DisabledChecks)
 				for _, check := range checkList {
 					checkIterationChecks.Inc()
 					if check.Meta().IsOnline {
@@ -154,7 +159,6 @@ func checkRules(ctx context.Context, workers int, isOffline bool, gen *config.Pr
 				jobs <- scanJob{entry: entry, allEntries: entries, check: nil}
 			}
 		}
-		defer close(jobs)
 	}()
 
 	for result := range results {
@@ -186,7 +190,29 @@ func scanWorker(ctx context.Context, jobs <-chan scanJob, results chan<- reporte
 		default:
 			var commentErr comments.CommentError
 			var ignoreErr discovery.FileIgnoreError
+			var emptyErr discovery.FileEmptyError
 			switch {
+			case errors.As(job.entry.PathError, &emptyErr):
+				results <- reporter.Report{
+					Path: discovery.Path{
+						Name:          job.entry.Path.Name,
+						SymlinkTarget: job.entry.Path.SymlinkTarget,
+					},
+					ModifiedLines: job.entry.ModifiedLines,
+					Problem: checks.Problem{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: yamlEmptyReporter,
+						Text:     emptyErr.Error(),
+						Details: `This file was found when searching for rule files but it doesn't contain any recording or alerting rules.
+If that's expected then you can instruct pint to ignore this file using comments, see [pint docs](https://cloudflare.github.io/pint/ignoring.html).`,
+						Severity: checks.Warning,
+					},
+					Owner:  job.entry.Owner,
+					Author: job.entry.AuthorEmail,
+				}
 			case errors.As(job.entry.PathError, &ignoreErr):
 				results <- reporter.Report{
 					Path: discovery.Path{
@@ -203,7 +229,8 @@ func scanWorker(ctx context.Context, jobs <-chan scanJob, results chan<- reporte
 						Text:     ignoreErr.Error(),
 						Severity: checks.Information,
 					},
-					Owner: job.entry.Owner,
+					Owner:  job.entry.Owner,
+					Author: job.entry.AuthorEmail,
 				}
 			case errors.As(job.entry.PathError, &commentErr):
 				results <- reporter.Report{
@@ -221,7 +248,8 @@ func scanWorker(ctx context.Context, jobs <-chan scanJob, results chan<- reporte
 						Text:     fmt.Sprintf("This comment is not a valid pint control comment: %s", commentErr.Error()),
 						Severity: checks.Warning,
 					},
-					Owner: job.entry.Owner,
+					Owner:  job.entry.Owner,
+					Author: job.entry.AuthorEmail,
 				}
 			case job.entry.PathError != nil:
 				line, e := tryDecodingYamlError(job.entry.PathError)
@@ -244,7 +272,8 @@ If this file is a template that will be rendered into valid YAML then you can in
 `,
 						Severity: checks.Fatal,
 					},
-					Owner: job.entry.Owner,
+					Owner:  job.entry.Owner,
+					Author: job.entry.AuthorEmail,
 				}
 			case job.entry.Rule.Error.Err != nil:
 				results <- reporter.Report{
@@ -265,7 +294,8 @@ If this file is a template that will be rendered into valid YAML then you can in
 This usually means that it's missing some required fields.`,
 						Severity: checks.Fatal,
 					},
-					Owner: job.entry.Owner,
+					Owner:  job.entry.Owner,
+					Author: job.entry.AuthorEmail,
 				}
 			default:
 				if job.entry.State == discovery.Unknown {
@@ -290,6 +320,7 @@ This usually means that it's missing some required fields.`,
 						Rule:          job.entry.Rule,
 						Problem:       problem,
 						Owner:         job.entry.Owner,
+						Author:        job.entry.AuthorEmail,
 					}
 				}
 			}
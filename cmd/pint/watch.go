@@ -30,6 +30,8 @@ import (
 )
 
 const (
+	headerFlag      = "header"
+	maxSizeFlag     = "max-size"
 	intervalFlag    = "interval"
 	listenFlag      = "listen"
 	pidfileFlag     = "pidfile"
@@ -56,9 +58,9 @@ var watchCmd = &cli.Command{
 				}
 
 				slog.Debug("Starting glob watch", slog.Any("paths", paths))
-				return actionWatch(c, meta, func(_ context.Context) ([]string, error) {
+				return actionWatch(c, meta, globEntries(meta.cfg, func(_ context.Context) ([]string, error) {
 					return paths, nil
-				})
+				}))
 			},
 		},
 		{
@@ -87,12 +89,62 @@ var watchCmd = &cli.Command{
 
 				slog.Debug("Starting rule_fules watch", slog.String("name", args[0]))
 
-				return actionWatch(c, meta, func(ctx context.Context) ([]string, error) {
+				return actionWatch(c, meta, globEntries(meta.cfg, func(ctx context.Context) ([]string, error) {
 					cfg, err := prom.Config(ctx, time.Millisecond)
 					if err != nil {
 						return nil, fmt.Errorf("failed to query %q Prometheus configuration: %w", prom.Name(), err)
 					}
 					return cfg.Config.RuleFiles, nil
+				}))
+			},
+		},
+		{
+			Name:  "http",
+			Usage: "Check rules from a YAML file or a ZIP archive of rule files served over HTTP.",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  headerFlag,
+					Usage: "Extra HTTP header to send with each request, in 'Name: value' format. Can be passed multiple times.",
+				},
+				&cli.Int64Flag{
+					Name:  maxSizeFlag,
+					Value: 100 * 1024 * 1024,
+					Usage: "Maximum size of the HTTP response body in bytes, bigger responses will be rejected. Also limits the total size of files extracted from ZIP archives.",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				meta, err := actionSetup(c)
+				if err != nil {
+					return err
+				}
+
+				args := c.Args().Slice()
+				if len(args) != 1 {
+					return fmt.Errorf("exactly one argument required with the URI to fetch rules from")
+				}
+
+				headers := map[string]string{}
+				for _, h := range c.StringSlice(headerFlag) {
+					k, v, ok := strings.Cut(h, ":")
+					if !ok {
+						return fmt.Errorf("invalid --%s value %q, expected 'Name: value'", headerFlag, h)
+					}
+					headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+				}
+
+				slog.Debug("Starting http watch", slog.String("uri", args[0]))
+
+				finder := discovery.NewHTTPFinder(
+					args[0],
+					headers,
+					c.Duration(intervalFlag),
+					git.NewPathFilter(nil, nil, meta.cfg.Parser.CompileRelaxed()),
+					c.Int64(maxSizeFlag),
+					meta.cfg.Parser.ReportEmpty,
+				)
+				return actionWatch(c, meta, func(ctx context.Context) ([]discovery.Entry, error) {
+					slog.Info("Fetching rules to check", slog.String("uri", args[0]))
+					return finder.Find(ctx)
 				})
 			},
 		},
@@ -130,7 +182,7 @@ var watchCmd = &cli.Command{
 	},
 }
 
-func actionWatch(c *cli.Context, meta actionMeta, f pathFinderFunc) error {
+func actionWatch(c *cli.Context, meta actionMeta, f entryFinderFunc) error {
 	minSeverity, err := checks.ParseSeverity(c.String(minSeverityFlag))
 	if err != nil {
 		return fmt.Errorf("invalid --%s value: %w", minSeverityFlag, err)
@@ -261,7 +313,7 @@ func startTimer(ctx context.Context, workers int, isOffline bool, gen *config.Pr
 }
 
 type problemCollector struct {
-	finder           pathFinderFunc
+	finder           entryFinderFunc
 	fileOwners       map[string]string
 	summary          *reporter.Summary
 	problem          *prometheus.Desc
@@ -273,7 +325,7 @@ type problemCollector struct {
 	lock             sync.Mutex
 }
 
-func newProblemCollector(cfg config.Config, f pathFinderFunc, minSeverity checks.Severity, maxProblems int) *problemCollector {
+func newProblemCollector(cfg config.Config, f entryFinderFunc, minSeverity checks.Severity, maxProblems int) *problemCollector {
 	return &problemCollector{
 		finder:     f,
 		cfg:        cfg,
@@ -302,13 +354,7 @@ func newProblemCollector(cfg config.Config, f pathFinderFunc, minSeverity checks
 }
 
 func (c *problemCollector) scan(ctx context.Context, workers int, isOffline bool, gen *config.PrometheusGenerator) error {
-	paths, err := c.finder(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get the list of paths to check: %w", err)
-	}
-
-	slog.Info("Finding all rules to check", slog.Any("paths", paths))
-	entries, err := discovery.NewGlobFinder(paths, git.NewPathFilter(nil, nil, c.cfg.Parser.CompileRelaxed())).Find()
+	entries, err := c.finder(ctx)
 	if err != nil {
 		return err
 	}
@@ -413,4 +459,21 @@ func (c *problemCollector) Collect(ch chan<- prometheus.Metric) {
 	}
 }
 
-type pathFinderFunc func(ctx context.Context) ([]string, error)
+type (
+	pathFinderFunc  func(ctx context.Context) ([]string, error)
+	entryFinderFunc func(ctx context.Context) ([]discovery.Entry, error)
+)
+
+// globEntries returns a finder that will check all files matching paths
+// returned by f.
+func globEntries(cfg config.Config, f pathFinderFunc) entryFinderFunc {
+	return func(ctx context.Context) ([]discovery.Entry, error) {
+		paths, err := f(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the list of paths to check: %w", err)
+		}
+
+		slog.Info("Finding all rules to check", slog.Any("paths", paths))
+		return discovery.NewGlobFinder(paths, git.NewPathFilter(nil, nil, cfg.Parser.CompileRelaxed()), cfg.Parser.ReportEmpty).Find()
+	}
+}
@@ -0,0 +1,119 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type statusResponse struct {
+	Config struct {
+		Original string `json:"original"`
+	} `json:"config"`
+}
+
+type configFile struct {
+	Route *Route `yaml:"route"`
+}
+
+// How long to cache the routing tree for, this matters for pint watch.
+const cacheTTL = time.Minute * 5
+
+func NewClient(uri string, timeout time.Duration) *Client {
+	return &Client{
+		uri:     strings.TrimSuffix(uri, "/"),
+		safeURI: sanitizeURI(uri),
+		timeout: timeout,
+		client:  http.Client{},
+	}
+}
+
+// Client talks to the Alertmanager API, the routing tree is cached
+// and reused by all checks.
+type Client struct {
+	fetchedAt time.Time
+	route     *Route
+	err       error
+	uri       string
+	safeURI   string
+	client    http.Client
+	timeout   time.Duration
+	mu        sync.Mutex
+}
+
+func (c *Client) URI() string {
+	return c.safeURI
+}
+
+// Routes returns the root of the routing tree configured on Alertmanager.
+func (c *Client) Routes(ctx context.Context) (*Route, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.fetchedAt.IsZero() || time.Since(c.fetchedAt) > cacheTTL {
+		c.route, c.err = c.fetchRoutes(ctx)
+		c.fetchedAt = time.Now()
+	}
+	return c.route, c.err
+}
+
+func (c *Client) fetchRoutes(ctx context.Context) (*Route, error) {
+	slog.Debug("Getting Alertmanager configuration", slog.String("uri", c.safeURI))
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.uri+"/api/v2/status", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Alertmanager status: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("failed to query Alertmanager status: %s", resp.Status)
+	}
+
+	var status statusResponse
+	if err = json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("failed to decode Alertmanager status: %w", err)
+	}
+
+	var cfg configFile
+	if err = yaml.Unmarshal([]byte(status.Config.Original), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode Alertmanager config: %w", err)
+	}
+	if cfg.Route == nil {
+		return nil, errors.New("no route found in Alertmanager config")
+	}
+	if err = cfg.Route.compile(nil); err != nil {
+		return nil, fmt.Errorf("failed to parse Alertmanager routes: %w", err)
+	}
+	return cfg.Route, nil
+}
+
+func sanitizeURI(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+	if u.User != nil {
+		if _, pwdSet := u.User.Password(); pwdSet {
+			u.User = url.UserPassword(u.User.Username(), "xxx")
+		}
+		return u.String()
+	}
+	return s
+}
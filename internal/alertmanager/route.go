@@ -0,0 +1,147 @@
+package alertmanager
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+)
+
+// Alertmanager uses this value for group_wait when it's not set on any route.
+const defaultGroupWait = time.Second * 30
+
+var matcherRe = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*(=~|!~|!=|=)\s*(.*?)\s*$`)
+
+// Route is a single node of the Alertmanager routing tree.
+type Route struct {
+	Match     map[string]string `yaml:"match"`
+	MatchRE   map[string]string `yaml:"match_re"`
+	Receiver  string            `yaml:"receiver"`
+	GroupWait string            `yaml:"group_wait"`
+	Matchers  []string          `yaml:"matchers"`
+	Routes    []*Route          `yaml:"routes"`
+	Continue  bool              `yaml:"continue"`
+	matchers  []*labels.Matcher
+	groupWait time.Duration
+}
+
+// compile parses all matchers and resolves settings inherited from the parent route.
+func (r *Route) compile(parent *Route) error {
+	switch {
+	case r.GroupWait != "":
+		dur, err := model.ParseDuration(r.GroupWait)
+		if err != nil {
+			return fmt.Errorf("invalid group_wait: %w", err)
+		}
+		r.groupWait = time.Duration(dur)
+	case parent != nil:
+		r.groupWait = parent.groupWait
+	default:
+		r.groupWait = defaultGroupWait
+	}
+	if parent != nil && r.Receiver == "" {
+		r.Receiver = parent.Receiver
+	}
+
+	for name, val := range r.Match {
+		r.matchers = append(r.matchers, labels.MustNewMatcher(labels.MatchEqual, name, val))
+	}
+	for name, val := range r.MatchRE {
+		m, err := labels.NewMatcher(labels.MatchRegexp, name, val)
+		if err != nil {
+			return fmt.Errorf("invalid match_re for %q: %w", name, err)
+		}
+		r.matchers = append(r.matchers, m)
+	}
+	for _, s := range r.Matchers {
+		m, err := parseMatcher(s)
+		if err != nil {
+			return err
+		}
+		r.matchers = append(r.matchers, m)
+	}
+	for _, child := range r.Routes {
+		if err := child.compile(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MightMatch returns true if this route could match an alert with given labels.
+// Matchers for labels that are not present in lset are ignored since the value
+// of those labels is not known until the alert fires.
+func (r *Route) MightMatch(lset map[string]string) bool {
+	for _, m := range r.matchers {
+		val, ok := lset[m.Name]
+		if !ok {
+			continue
+		}
+		if !m.Matches(val) {
+			return false
+		}
+	}
+	return true
+}
+
+// GroupWaitDuration returns the group_wait value for this route, either set on it
+// or inherited from the parent route.
+func (r *Route) GroupWaitDuration() time.Duration {
+	return r.groupWait
+}
+
+// MatchingRoutes returns all routes that an alert with given labels might
+// be sent to, following the same rules as Alertmanager: the first matching
+// child route is used, unless it has continue set, and if no child route
+// matches then the alert stays on this route.
+func (r *Route) MatchingRoutes(lset map[string]string) (routes []*Route) {
+	for _, child := range r.Routes {
+		if !child.MightMatch(lset) {
+			continue
+		}
+		routes = append(routes, child.MatchingRoutes(lset)...)
+		if !child.Continue {
+			break
+		}
+	}
+	if len(routes) == 0 {
+		routes = append(routes, r)
+	}
+	return routes
+}
+
+func parseMatcher(s string) (*labels.Matcher, error) {
+	parts := matcherRe.FindStringSubmatch(s)
+	if parts == nil {
+		return nil, fmt.Errorf("invalid matcher: %s", s)
+	}
+
+	val := parts[3]
+	if len(val) > 1 && val[0] == '"' {
+		var err error
+		if val, err = strconv.Unquote(val); err != nil {
+			return nil, fmt.Errorf("invalid matcher value: %s", s)
+		}
+	}
+
+	var mt labels.MatchType
+	switch parts[2] {
+	case "=":
+		mt = labels.MatchEqual
+	case "!=":
+		mt = labels.MatchNotEqual
+	case "=~":
+		mt = labels.MatchRegexp
+	case "!~":
+		mt = labels.MatchNotRegexp
+	}
+
+	m, err := labels.NewMatcher(mt, parts[1], val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid matcher: %s: %w", s, err)
+	}
+	return m, nil
+}
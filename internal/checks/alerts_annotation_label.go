@@ -0,0 +1,123 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	AnnotationLabelCheckName    = "alerts/annotation_label"
+	AnnotationLabelCheckDetails = `Annotations can use ` + "`$labels`" + ` to access labels of the time series that triggered the alert.
+If the query doesn't return a label that an annotation is using then it will be rendered as ` + "`<no value>`" + `.
+Make sure that the query returns all labels used in annotations, for example by adding them to ` + "`by(...)`" + ` when using aggregations.`
+)
+
+func NewAnnotationLabelCheck(prom *promapi.FailoverGroup) AnnotationLabelCheck {
+	return AnnotationLabelCheck{prom: prom}
+}
+
+type AnnotationLabelCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c AnnotationLabelCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c AnnotationLabelCheck) String() string {
+	return fmt.Sprintf("%s(%s)", AnnotationLabelCheckName, c.prom.Name())
+}
+
+func (c AnnotationLabelCheck) Reporter() string {
+	return AnnotationLabelCheckName
+}
+
+func (c AnnotationLabelCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil || rule.AlertingRule.Annotations == nil {
+		return problems
+	}
+
+	node := utils.RemoveConditions(rule.AlertingRule.Expr.Value.Value)
+	if vs, ok := node.(*promParser.VectorSelector); ok && vs.Name == "" {
+		return problems
+	}
+
+	type labelResult struct {
+		uri     string
+		missing bool
+	}
+	results := map[string]labelResult{}
+	for _, annotation := range rule.AlertingRule.Annotations.Items {
+		names := getTemplateLabels(annotation.Key.Value, annotation.Value.Value)
+		slices.Sort(names)
+		for _, name := range slices.Compact(names) {
+			res, ok := results[name]
+			if !ok {
+				missing, uri, err := c.isLabelMissing(ctx, node, name)
+				if err != nil {
+					text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+					problems = append(problems, Problem{
+						Lines:    rule.AlertingRule.Expr.Value.Lines,
+						Reporter: c.Reporter(),
+						Text:     text,
+						Severity: severity,
+					})
+					return problems
+				}
+				res = labelResult{uri: uri, missing: missing}
+				results[name] = res
+			}
+			if !res.missing {
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines: parser.LineRange{
+					First: annotation.Key.Lines.First,
+					Last:  annotation.Value.Lines.Last,
+				},
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` annotation is using `$labels.%s` but the query doesn't return `%s` label on %s, it will be rendered as `<no value>`.",
+					annotation.Key.Value, name, name, promText(c.prom.Name(), res.uri)),
+				Details:  AnnotationLabelCheckDetails,
+				Severity: Bug,
+			})
+		}
+	}
+
+	return problems
+}
+
+// isLabelMissing returns true if query returns some results but none of them
+// have the given label.
+func (c AnnotationLabelCheck) isLabelMissing(ctx context.Context, node promParser.Node, name string) (bool, string, error) {
+	qr, err := c.prom.Query(ctx, fmt.Sprintf("count(%s) by (%s)", node.String(), name))
+	if err != nil {
+		return false, "", err
+	}
+	if len(qr.Series) == 0 {
+		// No results, we can't tell which labels this query returns.
+		return false, qr.URI, nil
+	}
+	for _, s := range qr.Series {
+		if s.Labels.Get(name) != "" {
+			return false, qr.URI, nil
+		}
+	}
+	return true, qr.URI, nil
+}
@@ -0,0 +1,175 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAnnotationLabelCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAnnotationLabelCheck(prom)
+}
+
+func annotationLabelText(annotation, name, prom, uri string) string {
+	return fmt.Sprintf("`%s` annotation is using `$labels.%s` but the query doesn't return `%s` label on `%s` Prometheus server at %s, it will be rendered as `<no value>`.",
+		annotation, name, name, prom, uri)
+}
+
+func TestAnnotationLabelCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(bar)\n",
+			checker:     newAnnotationLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: sum(bar\n  annotations:\n    summary: '{{ $labels.job }}'\n",
+			checker:     newAnnotationLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts without annotations",
+			content:     "- alert: foo\n  expr: sum(bar) > 0\n",
+			checker:     newAnnotationLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores annotations without labels",
+			content:     "- alert: foo\n  expr: sum(bar) > 0\n  annotations:\n    summary: 'value is {{ $value }}'\n",
+			checker:     newAnnotationLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- alert: foo\n  expr: sum(bar) by (job) > 0\n  annotations:\n    summary: '{{ $labels.job }}'\n",
+			checker:     newAnnotationLabelCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AnnotationLabelCheckName,
+						Text:     checkErrorUnableToRun(checks.AnnotationLabelCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum by (job) (bar)) by (job)"},
+					},
+					resp: respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no results",
+			content:     "- alert: foo\n  expr: sum(bar) by (job) > 0\n  annotations:\n    summary: '{{ $labels.job }}'\n",
+			checker:     newAnnotationLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum by (job) (bar)) by (job)"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+		{
+			description: "label present",
+			content:     "- alert: foo\n  expr: sum(bar) by (job) > 0\n  annotations:\n    summary: '{{ $labels.job }}'\n",
+			checker:     newAnnotationLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum by (job) (bar)) by (job)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+							generateSample(map[string]string{"job": "foo"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "label missing",
+			content:     "- alert: foo\n  expr: rate(errors_total[5m]) > 0\n  annotations:\n    summary: '{{ $labels.job }} on {{ $labels.instance }}'\n    description: 'instance {{ $labels.instance }}'\n",
+			checker:     newAnnotationLabelCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.AnnotationLabelCheckName,
+						Text:     annotationLabelText("summary", "instance", "prom", uri),
+						Details:  checks.AnnotationLabelCheckDetails,
+						Severity: checks.Bug,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 5,
+							Last:  5,
+						},
+						Reporter: checks.AnnotationLabelCheckName,
+						Text:     annotationLabelText("description", "instance", "prom", uri),
+						Details:  checks.AnnotationLabelCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(rate(errors_total[5m])) by (job)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "foo"}),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(rate(errors_total[5m])) by (instance)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
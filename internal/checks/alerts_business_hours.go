@@ -0,0 +1,137 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	BusinessHoursCheckName    = "alerts/business_hours"
+	BusinessHoursCheckDetails = `The alert query didn't return any results during business hours (Monday to Friday, 9am to 5pm) but it did return results at other times.
+This usually means that the metrics used in this query are only exported outside of business hours, for example by a batch job that runs at night.
+If the alert is using ` + "`for`" + ` then it might never fire if the time series disappear before the ` + "`for`" + ` duration is reached.`
+
+	businessHoursStart = 9
+	businessHoursEnd   = 17
+)
+
+type BusinessHoursSettings struct {
+	Timezone string `hcl:"timezone,optional" json:"timezone,omitempty"`
+	tz       *time.Location
+}
+
+func (c *BusinessHoursSettings) Validate() error {
+	c.tz = time.UTC
+	if c.Timezone != "" {
+		tz, err := time.LoadLocation(c.Timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone value: %w", err)
+		}
+		c.tz = tz
+	}
+	return nil
+}
+
+func (c *BusinessHoursSettings) Location() *time.Location {
+	return c.tz
+}
+
+func NewBusinessHoursCheck(prom *promapi.FailoverGroup, tz *time.Location) BusinessHoursCheck {
+	return BusinessHoursCheck{prom: prom, tz: tz}
+}
+
+type BusinessHoursCheck struct {
+	prom *promapi.FailoverGroup
+	tz   *time.Location
+}
+
+func (c BusinessHoursCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c BusinessHoursCheck) String() string {
+	return fmt.Sprintf("%s(%s)", BusinessHoursCheckName, c.prom.Name())
+}
+
+func (c BusinessHoursCheck) Reporter() string {
+	return BusinessHoursCheckName
+}
+
+func (c BusinessHoursCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	node := utils.RemoveConditions(rule.AlertingRule.Expr.Value.Value)
+	if vs, ok := node.(*promParser.VectorSelector); ok && vs.Name == "" {
+		return problems
+	}
+
+	params := promapi.NewRelativeRange(time.Hour*24*7, time.Minute*5)
+	qr, err := c.prom.RangeQuery(ctx, fmt.Sprintf("count(%s)", node.String()), params)
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+		problems = append(problems, Problem{
+			Lines:    rule.AlertingRule.Expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	if len(qr.Series.Ranges) == 0 {
+		return problems
+	}
+
+	for _, r := range qr.Series.Ranges {
+		if overlapsBusinessHours(r.Start, r.End, c.tz) {
+			return problems
+		}
+	}
+
+	delta := qr.Series.Until.Sub(qr.Series.From).Round(time.Minute)
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.Expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s` returned results on %s in the last %s but never during business hours in `%s` time zone.",
+			node.String(), promText(c.prom.Name(), qr.URI), output.HumanizeDuration(delta), c.tz),
+		Details:  BusinessHoursCheckDetails,
+		Severity: Warning,
+	})
+	return problems
+}
+
+// overlapsBusinessHours returns true if given time range overlaps with
+// business hours on any working day.
+func overlapsBusinessHours(start, end time.Time, tz *time.Location) bool {
+	start, end = start.In(tz), end.In(tz)
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, tz); !day.After(end); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		open := time.Date(day.Year(), day.Month(), day.Day(), businessHoursStart, 0, 0, 0, tz)
+		closed := time.Date(day.Year(), day.Month(), day.Day(), businessHoursEnd, 0, 0, 0, tz)
+		if start.Before(closed) && end.After(open) {
+			return true
+		}
+	}
+	return false
+}
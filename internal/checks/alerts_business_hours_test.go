@@ -0,0 +1,187 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newBusinessHoursCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewBusinessHoursCheck(prom, time.UTC)
+}
+
+func businessHoursText(query, name, uri, tz string) string {
+	return fmt.Sprintf("`%s` returned results on `%s` Prometheus server at %s in the last 1w but never during business hours in `%s` time zone.",
+		query, name, uri, tz)
+}
+
+// nightlySamples returns series present every night between 1am and 5am UTC.
+func nightlySamples() (samples []*model.SampleStream) {
+	now := time.Now().UTC()
+	from := now.Add(time.Hour * 24 * -7)
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC); day.Before(now); day = day.AddDate(0, 0, 1) {
+		start := day.Add(time.Hour)
+		end := day.Add(time.Hour * 5)
+		if start.Before(from) || end.After(now) {
+			continue
+		}
+		samples = append(samples, generateSampleStream(map[string]string{}, start, end, time.Minute*5))
+	}
+	return samples
+}
+
+func TestBusinessHoursCheck(t *testing.T) {
+	content := "- alert: foo\n  expr: backup_errors_total > 0\n  for: 2h\n"
+
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newBusinessHoursCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: sum(foo) >\n",
+			checker:     newBusinessHoursCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     content,
+			checker:     newBusinessHoursCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.BusinessHoursCheckName,
+						Text:     checkErrorUnableToRun(checks.BusinessHoursCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no results",
+			content:     content,
+			checker:     newBusinessHoursCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "count(backup_errors_total)"},
+					},
+					resp: matrixResponse{samples: []*model.SampleStream{}},
+				},
+			},
+		},
+		{
+			description: "results during business hours",
+			content:     content,
+			checker:     newBusinessHoursCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "count(backup_errors_total)"},
+					},
+					resp: matrixResponse{
+						samples: []*model.SampleStream{
+							generateSampleStream(
+								map[string]string{},
+								time.Now().Add(time.Hour*24*-7),
+								time.Now(),
+								time.Minute*5,
+							),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "results only at night",
+			content:     content,
+			checker:     newBusinessHoursCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.BusinessHoursCheckName,
+						Text:     businessHoursText("backup_errors_total", "prom", uri, "UTC"),
+						Details:  checks.BusinessHoursCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "count(backup_errors_total)"},
+					},
+					resp: matrixResponse{samples: nightlySamples()},
+				},
+			},
+		},
+		{
+			description: "results only at night / different time zone",
+			content:     content,
+			checker: func(prom *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewBusinessHoursCheck(prom, time.FixedZone("JST", 9*60*60))
+			},
+			prometheus: newSimpleProm,
+			problems:   noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "count(backup_errors_total)"},
+					},
+					resp: matrixResponse{samples: nightlySamples()},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
+
+func TestBusinessHoursSettings(t *testing.T) {
+	s := checks.BusinessHoursSettings{}
+	require.NoError(t, s.Validate())
+	require.Equal(t, time.UTC, s.Location())
+
+	s = checks.BusinessHoursSettings{Timezone: "Europe/London"}
+	require.NoError(t, s.Validate())
+	require.Equal(t, "Europe/London", s.Location().String())
+
+	s = checks.BusinessHoursSettings{Timezone: "Foo/Bar"}
+	require.EqualError(t, s.Validate(), "invalid timezone value: unknown time zone Foo/Bar")
+}
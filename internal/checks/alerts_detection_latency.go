@@ -0,0 +1,108 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	DetectionLatencyCheckName    = "alerts/detection_latency"
+	DetectionLatencyCheckDetails = `An alert can only fire after the problem it's detecting is visible in the query results for the whole ` + "`for`" + ` duration.
+Queries using range vectors or subqueries can also take up to the length of the longest time range to reflect a change in the underlying metrics.
+Together these two values give the worst case delay between the problem starting and the alert firing.`
+)
+
+type DetectionLatencySettings struct {
+	Max string `hcl:"max" json:"max"`
+	max time.Duration
+}
+
+func (c *DetectionLatencySettings) Validate() error {
+	dur, err := model.ParseDuration(c.Max)
+	if err != nil {
+		return err
+	}
+	if dur <= 0 {
+		return errors.New("max must be greater than zero")
+	}
+	c.max = time.Duration(dur)
+	return nil
+}
+
+func (c *DetectionLatencySettings) MaxLatency() time.Duration {
+	return c.max
+}
+
+func NewDetectionLatencyCheck(maxLatency time.Duration) DetectionLatencyCheck {
+	return DetectionLatencyCheck{maxLatency: maxLatency}
+}
+
+type DetectionLatencyCheck struct {
+	maxLatency time.Duration
+}
+
+func (c DetectionLatencyCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c DetectionLatencyCheck) String() string {
+	return DetectionLatencyCheckName
+}
+
+func (c DetectionLatencyCheck) Reporter() string {
+	return DetectionLatencyCheckName
+}
+
+func (c DetectionLatencyCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	window := maxRangeWindow(rule.AlertingRule.Expr.Query)
+	forDur := time.Duration(alertForDuration(rule.AlertingRule))
+	latency := window + forDur
+	if latency <= c.maxLatency {
+		return problems
+	}
+
+	lines := rule.AlertingRule.Expr.Value.Lines
+	if rule.AlertingRule.For != nil {
+		lines = rule.AlertingRule.For.Lines
+	}
+
+	var parts []string
+	if window > 0 {
+		parts = append(parts, fmt.Sprintf("`%s` longest query range", output.HumanizeDuration(window)))
+	}
+	if forDur > 0 {
+		parts = append(parts, fmt.Sprintf("`%s` for", output.HumanizeDuration(forDur)))
+	}
+
+	problems = append(problems, Problem{
+		Lines:    lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("This alert can take up to `%s` to fire (%s), which is more than the maximum allowed detection latency of `%s`.",
+			output.HumanizeDuration(latency), strings.Join(parts, " plus "), output.HumanizeDuration(c.maxLatency)),
+		Details:  DetectionLatencyCheckDetails,
+		Severity: Bug,
+	})
+
+	return problems
+}
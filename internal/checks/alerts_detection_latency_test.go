@@ -0,0 +1,119 @@
+package checks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newDetectionLatencyCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewDetectionLatencyCheck(time.Minute * 15)
+}
+
+func TestDetectionLatencyCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(rate(foo[1h]))\n",
+			checker:     newDetectionLatencyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: rate(foo[1h]\n  for: 1h\n",
+			checker:     newDetectionLatencyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "latency equal to the limit",
+			content:     "- alert: foo\n  expr: rate(foo[5m]) > 0\n  for: 10m\n",
+			checker:     newDetectionLatencyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "for over the limit",
+			content:     "- alert: foo\n  expr: up == 0\n  for: 20m\n",
+			checker:     newDetectionLatencyCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.DetectionLatencyCheckName,
+						Text:     "This alert can take up to `20m` to fire (`20m` for), which is more than the maximum allowed detection latency of `15m`.",
+						Details:  checks.DetectionLatencyCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "range and for over the limit",
+			content:     "- alert: foo\n  expr: rate(foo[10m]) > 0 and max_over_time(bar[1h:5m]) > 0\n  for: 5m\n",
+			checker:     newDetectionLatencyCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.DetectionLatencyCheckName,
+						Text:     "This alert can take up to `1h5m` to fire (`1h` longest query range plus `5m` for), which is more than the maximum allowed detection latency of `15m`.",
+						Details:  checks.DetectionLatencyCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "range over the limit without for",
+			content:     "- alert: foo\n  expr: rate(foo[30m]) > 0\n",
+			checker:     newDetectionLatencyCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DetectionLatencyCheckName,
+						Text:     "This alert can take up to `30m` to fire (`30m` longest query range), which is more than the maximum allowed detection latency of `15m`.",
+						Details:  checks.DetectionLatencyCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
+
+func TestDetectionLatencySettings(t *testing.T) {
+	s := checks.DetectionLatencySettings{Max: "15m"}
+	require.NoError(t, s.Validate())
+	require.Equal(t, time.Minute*15, s.MaxLatency())
+
+	s = checks.DetectionLatencySettings{}
+	require.EqualError(t, s.Validate(), "empty duration string")
+
+	s = checks.DetectionLatencySettings{Max: "foo"}
+	require.EqualError(t, s.Validate(), `not a valid duration string: "foo"`)
+
+	s = checks.DetectionLatencySettings{Max: "0s"}
+	require.EqualError(t, s.Validate(), "max must be greater than zero")
+}
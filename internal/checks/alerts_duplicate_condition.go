@@ -0,0 +1,135 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	AlertDuplicateConditionCheckName    = "alerts/duplicate_condition"
+	AlertDuplicateConditionCheckDetails = `Multiple alerting rules are using the same query and the same ` + "`for`" + ` value, so they will always fire at the same time.
+This usually creates duplicated notifications for the same problem.
+Consider removing all but one of these alerts, or make their conditions different.`
+)
+
+func NewAlertDuplicateConditionCheck() AlertDuplicateConditionCheck {
+	return AlertDuplicateConditionCheck{}
+}
+
+type AlertDuplicateConditionCheck struct{}
+
+func (c AlertDuplicateConditionCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c AlertDuplicateConditionCheck) String() string {
+	return AlertDuplicateConditionCheckName
+}
+
+func (c AlertDuplicateConditionCheck) Reporter() string {
+	return AlertDuplicateConditionCheckName
+}
+
+func (c AlertDuplicateConditionCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	var query string
+	queryLen := len(rule.AlertingRule.Expr.Query.Expr.String())
+	forDur := alertForDuration(rule.AlertingRule)
+
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if entry.Rule.AlertingRule == nil || entry.Rule.AlertingRule.Expr.SyntaxError != nil {
+			continue
+		}
+		// Rules from different files might be deployed to different Prometheus servers.
+		if entry.Path.Name != path.Name || entry.Rule.Lines.First == rule.Lines.First {
+			continue
+		}
+		// Alerts with the same name are usually different severity variants of the same alert.
+		if entry.Rule.AlertingRule.Alert.Value == rule.AlertingRule.Alert.Value {
+			continue
+		}
+		if alertForDuration(entry.Rule.AlertingRule) != forDur {
+			continue
+		}
+		// Normalizing doesn't change the length of the query, so we can skip it
+		// for queries that can't be identical.
+		if len(entry.Rule.AlertingRule.Expr.Query.Expr.String()) != queryLen {
+			continue
+		}
+		if query == "" {
+			query = normalizeQuery(rule.AlertingRule.Expr.Query.Expr)
+		}
+		if normalizeQuery(entry.Rule.AlertingRule.Expr.Query.Expr) != query {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    rule.AlertingRule.Expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("This alert is using the same condition as `%s` alert at %s:%d, both alerts will always fire at the same time.",
+				entry.Rule.AlertingRule.Alert.Value, entry.Path.SymlinkTarget, entry.Rule.AlertingRule.Alert.Lines.First),
+			Details:  AlertDuplicateConditionCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
+
+func alertForDuration(rule *parser.AlertingRule) model.Duration {
+	if rule.For == nil {
+		return 0
+	}
+	d, _ := model.ParseDuration(rule.For.Value)
+	return d
+}
+
+// normalizeQuery returns a string representation of given query with label
+// matchers and grouping labels sorted, so that queries that only differ
+// in the order of those are considered identical.
+func normalizeQuery(expr promParser.Node) string {
+	// Parse the query again to get a copy of the AST that's safe to modify.
+	node, err := promParser.ParseExpr(expr.String())
+	if err != nil {
+		return expr.String()
+	}
+	promParser.Inspect(node, func(n promParser.Node, _ []promParser.Node) error {
+		switch e := n.(type) {
+		case *promParser.VectorSelector:
+			slices.SortFunc(e.LabelMatchers, func(a, b *labels.Matcher) int {
+				return strings.Compare(a.String(), b.String())
+			})
+		case *promParser.AggregateExpr:
+			slices.Sort(e.Grouping)
+		case *promParser.BinaryExpr:
+			if e.VectorMatching != nil {
+				slices.Sort(e.VectorMatching.MatchingLabels)
+				slices.Sort(e.VectorMatching.Include)
+			}
+		}
+		return nil
+	})
+	return node.String()
+}
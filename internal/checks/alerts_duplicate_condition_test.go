@@ -0,0 +1,127 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAlertDuplicateConditionCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAlertDuplicateConditionCheck()
+}
+
+func TestAlertDuplicateConditionCheck(t *testing.T) {
+	otherEntries := mustParseContent(`
+- alert: DiskFull
+  expr: disk_used_ratio{job="node",  env="prod"} > 0.9
+  for: 5m
+  labels:
+    severity: critical
+`)
+	otherFileEntries := mustParseContent(`
+- alert: DiskFull
+  expr: disk_used_ratio{job="node",  env="prod"} > 0.9
+  for: 5m
+`)
+	for i := range otherFileEntries {
+		otherFileEntries[i].Path.Name = "other.yml"
+		otherFileEntries[i].Path.SymlinkTarget = "other.yml"
+	}
+
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: disk_used_ratio >\n",
+			checker:     newAlertDuplicateConditionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: disk_used_ratio{job=\"node\",  env=\"prod\"} > 0.9\n",
+			checker:     newAlertDuplicateConditionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores alerts with the same name",
+			content:     "- alert: DiskFull\n  expr: disk_used_ratio{job=\"node\",  env=\"prod\"} > 0.9\n  for: 5m\n",
+			checker:     newAlertDuplicateConditionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores alerts with different for",
+			content:     "- alert: DiskSpaceLow\n  expr: disk_used_ratio{job=\"node\",  env=\"prod\"} > 0.9\n  for: 1h\n",
+			checker:     newAlertDuplicateConditionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores different queries",
+			content:     "- alert: DiskSpaceLow\n  expr: disk_used_ratio{job=\"node\",  env=\"prod\"} > 0.8\n  for: 5m\n",
+			checker:     newAlertDuplicateConditionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores alerts from other files",
+			content:     "- alert: DiskSpaceLow\n  expr: disk_used_ratio{job=\"node\",  env=\"prod\"} > 0.9\n  for: 5m\n",
+			checker:     newAlertDuplicateConditionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherFileEntries,
+		},
+		{
+			description: "identical condition",
+			content:     "- alert: DiskSpaceLow\n  expr: disk_used_ratio{env=\"prod\", job=\"node\"} >   0.9\n  for: 300s\n  labels:\n    severity: warning\n",
+			checker:     newAlertDuplicateConditionCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AlertDuplicateConditionCheckName,
+						Text:     "This alert is using the same condition as `DiskFull` alert at fake.yml:2, both alerts will always fire at the same time.",
+						Details:  checks.AlertDuplicateConditionCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: otherEntries,
+		},
+		{
+			description: "identical condition with different grouping order",
+			content:     "- alert: foo\n  expr: sum(up) by(job, instance) == 0\n",
+			checker:     newAlertDuplicateConditionCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AlertDuplicateConditionCheckName,
+						Text:     "This alert is using the same condition as `bar` alert at fake.yml:3, both alerts will always fire at the same time.",
+						Details:  checks.AlertDuplicateConditionCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: mustParseContent("\n\n- alert: bar\n  expr: sum by(instance, job) (up) == 0\n"),
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,182 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
+)
+
+const (
+	ExtractOpportunityCheckName    = "alerts/extract_opportunity"
+	ExtractOpportunityCheckDetails = `The same sub-query is evaluated independently by every alerting rule that uses it.
+Moving it to a recording rule means that Prometheus only needs to evaluate it once per evaluation interval, and all the alerts can then use the pre-aggregated results.`
+
+	defaultExtractOpportunityMinRules = 3
+)
+
+type ExtractOpportunitySettings struct {
+	MinRules int `hcl:"minRules,optional" json:"minRules,omitempty"`
+	minRules int
+}
+
+func (c *ExtractOpportunitySettings) Validate() error {
+	if c.MinRules < 0 {
+		return errors.New("minRules cannot be negative")
+	}
+	if c.MinRules == 1 {
+		return errors.New("minRules must be at least 2")
+	}
+	c.minRules = defaultExtractOpportunityMinRules
+	if c.MinRules > 0 {
+		c.minRules = c.MinRules
+	}
+	return nil
+}
+
+func (c *ExtractOpportunitySettings) Limit() int {
+	return c.minRules
+}
+
+func NewExtractOpportunityCheck(minRules int) ExtractOpportunityCheck {
+	if minRules <= 0 {
+		minRules = defaultExtractOpportunityMinRules
+	}
+	return ExtractOpportunityCheck{minRules: minRules}
+}
+
+type ExtractOpportunityCheck struct {
+	enabledChecks []string
+	minRules      int
+}
+
+func (c ExtractOpportunityCheck) WithEnabledChecks(names []string) RuleChecker {
+	c.enabledChecks = names
+	return c
+}
+
+func (c ExtractOpportunityCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c ExtractOpportunityCheck) String() string {
+	return ExtractOpportunityCheckName
+}
+
+func (c ExtractOpportunityCheck) Reporter() string {
+	return ExtractOpportunityCheckName
+}
+
+func (c ExtractOpportunityCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	var others []map[string]struct{}
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if entry.Rule.AlertingRule == nil || entry.Rule.AlertingRule.Expr.SyntaxError != nil {
+			continue
+		}
+		if entry.Path.Name == path.Name && entry.Rule.Lines.First == rule.Lines.First {
+			continue
+		}
+		queries := map[string]struct{}{}
+		for _, node := range extractCandidates(entry.Rule.AlertingRule.Expr.Query) {
+			queries[normalizeQuery(node.Expr)] = struct{}{}
+		}
+		if len(queries) > 0 {
+			others = append(others, queries)
+		}
+	}
+	if len(others)+1 < c.minRules {
+		return problems
+	}
+
+	// Queries compared against a threshold by multiple alerts are already
+	// reported by alerts/threshold, don't report them twice.
+	var skipQuery string
+	if slices.Contains(c.enabledChecks, ThresholdRecordingRuleCheckName) {
+		if query, thresholdOthers := thresholdAlerts(path, rule, entries); len(thresholdOthers) > 0 {
+			skipQuery = query
+		}
+	}
+
+	done := map[string]struct{}{}
+	var reported []*parser.PromQLNode
+	for _, node := range extractCandidates(rule.AlertingRule.Expr.Query) {
+		// Only report the outermost repeated query, sub-queries of it would be reported too otherwise.
+		if hasParentNode(node, reported) {
+			continue
+		}
+		query := normalizeQuery(node.Expr)
+		if _, ok := done[query]; ok {
+			continue
+		}
+		done[query] = struct{}{}
+
+		count := 1
+		for _, queries := range others {
+			if _, ok := queries[query]; ok {
+				count++
+			}
+		}
+		if count < c.minRules {
+			continue
+		}
+		reported = append(reported, node)
+		if skipQuery != "" && node.Expr.String() == skipQuery {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    rule.AlertingRule.Expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is used by %d alerting rules, consider moving it to a recording rule so it's only evaluated once.",
+				node.Expr, count),
+			Details:  ExtractOpportunityCheckDetails,
+			Severity: Information,
+		})
+	}
+
+	return problems
+}
+
+// extractCandidates returns all function calls and aggregations that
+// are querying time series, ordered from the outermost one.
+func extractCandidates(node *parser.PromQLNode) (nodes []*parser.PromQLNode) {
+	switch node.Expr.(type) {
+	case *promParser.Call, *promParser.AggregateExpr:
+		if len(utils.HasVectorSelector(node)) > 0 {
+			nodes = append(nodes, node)
+		}
+	}
+	for _, child := range node.Children {
+		nodes = append(nodes, extractCandidates(child)...)
+	}
+	return nodes
+}
+
+func hasParentNode(node *parser.PromQLNode, parents []*parser.PromQLNode) bool {
+	for p := node.Parent; p != nil; p = p.Parent {
+		if slices.Contains(parents, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newExtractOpportunityCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewExtractOpportunityCheck(0)
+}
+
+func TestExtractOpportunityCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(rate(foo[5m]))\n",
+			checker:     newExtractOpportunityCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- record: foo\n  expr: sum(rate(foo[5m]))\n- alert: bar\n  expr: sum(rate(foo[5m])) > 0\n- alert: baz\n  expr: sum(rate(foo[5m])) > 1\n"),
+		},
+		{
+			description: "ignores queries used by less than 3 rules",
+			content:     "- alert: foo\n  expr: sum(rate(foo[5m])) > 0\n",
+			checker:     newExtractOpportunityCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- alert: foo\n  expr: sum(rate(foo[5m])) > 0\n- alert: bar\n  expr: sum(rate(foo[5m])) > 1\n- alert: baz\n  expr: sum(rate(bar[5m])) > 1\n"),
+		},
+		{
+			description: "ignores selectors and literals",
+			content:     "- alert: foo\n  expr: foo > 0\n",
+			checker:     newExtractOpportunityCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- alert: foo\n  expr: foo > 0\n- alert: bar\n  expr: foo > 1\n- alert: baz\n  expr: foo > 2\n"),
+		},
+		{
+			description: "reports outermost repeated query",
+			content:     "- alert: foo\n  expr: sum(rate(foo{job=\"api\", env=\"prod\"}[5m])) by (job, instance) > 0\n",
+			checker:     newExtractOpportunityCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ExtractOpportunityCheckName,
+						Text:     "`sum by (job, instance) (rate(foo{env=\"prod\",job=\"api\"}[5m]))` is used by 3 alerting rules, consider moving it to a recording rule so it's only evaluated once.",
+						Details:  checks.ExtractOpportunityCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			entries: mustParseContent("- alert: foo\n  expr: sum(rate(foo{job=\"api\", env=\"prod\"}[5m])) by (job, instance) > 0\n- alert: bar\n  expr: sum(rate(foo{env=\"prod\", job=\"api\"}[5m])) by (instance, job) > 1\n- alert: baz\n  expr: sum(rate(foo{job=\"api\", env=\"prod\"}[5m])) by (job, instance) < 5\n"),
+		},
+		{
+			description: "reports multiple repeated queries",
+			content:     "- alert: foo\n  expr: rate(errors[5m]) / rate(requests[5m]) > 0.1\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewExtractOpportunityCheck(2)
+			},
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ExtractOpportunityCheckName,
+						Text:     "`rate(errors[5m])` is used by 2 alerting rules, consider moving it to a recording rule so it's only evaluated once.",
+						Details:  checks.ExtractOpportunityCheckDetails,
+						Severity: checks.Information,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ExtractOpportunityCheckName,
+						Text:     "`rate(requests[5m])` is used by 3 alerting rules, consider moving it to a recording rule so it's only evaluated once.",
+						Details:  checks.ExtractOpportunityCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			entries: mustParseContent("- alert: foo\n  expr: rate(errors[5m]) / rate(requests[5m]) > 0.1\n- alert: bar\n  expr: rate(errors[5m]) > 1\n- alert: baz\n  expr: rate(requests[5m]) < 1\n- alert: qux\n  expr: sum(rate(requests[5m])) == 0\n"),
+		},
+		{
+			description: "skips queries reported by alerts/threshold",
+			content:     "- alert: foo\n  expr: sum(rate(foo{job=\"api\", env=\"prod\"}[5m])) by (job, instance) > 0\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewExtractOpportunityCheck(0).WithEnabledChecks([]string{checks.ThresholdRecordingRuleCheckName})
+			},
+			prometheus: noProm,
+			problems:   noProblems,
+			entries:    mustParseContent("- alert: foo\n  expr: sum(rate(foo{job=\"api\", env=\"prod\"}[5m])) by (job, instance) > 0\n- alert: bar\n  expr: sum(rate(foo{env=\"prod\", job=\"api\"}[5m])) by (instance, job) > 1\n- alert: baz\n  expr: sum(rate(foo{job=\"api\", env=\"prod\"}[5m])) by (job, instance) < 5\n"),
+		},
+		{
+			description: "reports repeated queries when alerts/threshold is enabled but doesn't report them",
+			content:     "- alert: foo\n  expr: rate(errors[5m]) / rate(requests[5m]) > 0.1\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewExtractOpportunityCheck(2).WithEnabledChecks([]string{checks.ThresholdRecordingRuleCheckName})
+			},
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ExtractOpportunityCheckName,
+						Text:     "`rate(errors[5m])` is used by 2 alerting rules, consider moving it to a recording rule so it's only evaluated once.",
+						Details:  checks.ExtractOpportunityCheckDetails,
+						Severity: checks.Information,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ExtractOpportunityCheckName,
+						Text:     "`rate(requests[5m])` is used by 3 alerting rules, consider moving it to a recording rule so it's only evaluated once.",
+						Details:  checks.ExtractOpportunityCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			entries: mustParseContent("- alert: foo\n  expr: rate(errors[5m]) / rate(requests[5m]) > 0.1\n- alert: bar\n  expr: rate(errors[5m]) > 1\n- alert: baz\n  expr: rate(requests[5m]) < 1\n- alert: qux\n  expr: sum(rate(requests[5m])) == 0\n"),
+		},
+	}
+	runTests(t, testCases)
+}
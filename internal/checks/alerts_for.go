@@ -3,6 +3,7 @@ package checks
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/prometheus/common/model"
 
@@ -19,7 +20,14 @@ func NewAlertsForCheck() AlertsForChecksFor {
 	return AlertsForChecksFor{}
 }
 
-type AlertsForChecksFor struct{}
+type AlertsForChecksFor struct {
+	enabledChecks []string
+}
+
+func (c AlertsForChecksFor) WithEnabledChecks(names []string) RuleChecker {
+	c.enabledChecks = names
+	return c
+}
 
 func (c AlertsForChecksFor) Meta() CheckMeta {
 	return CheckMeta{
@@ -59,6 +67,10 @@ func (c AlertsForChecksFor) Check(_ context.Context, _ discovery.Path, rule pars
 func (c AlertsForChecksFor) checkField(name, value string, lines parser.LineRange) (problems []Problem) {
 	d, err := model.ParseDuration(value)
 	if err != nil {
+		if _, ok := parseUnorderedDuration(value); ok && slices.Contains(c.enabledChecks, ForDurationOrderCheckName) {
+			// Valid units used in the wrong order, alerts/for_order will report it.
+			return problems
+		}
 		problems = append(problems, Problem{
 			Lines:    lines,
 			Reporter: c.Reporter(),
@@ -0,0 +1,161 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	AlertsForFormatCheckName    = "alerts/for_format"
+	AlertsForFormatCheckDetails = `Older Prometheus releases don't support all time duration units.
+Use a duration that doesn't rely on the week unit, for example use ` + "`7d`" + ` instead of ` + "`1w`" + `.
+Supported time durations are documented [here](https://prometheus.io/docs/prometheus/latest/querying/basics/#time-durations).`
+)
+
+type AlertsForFormatSettings struct {
+	WeeksMinVersion string `hcl:"weeksMinVersion" json:"weeksMinVersion"`
+	weeksMinVersion version
+}
+
+func (c *AlertsForFormatSettings) Validate() (err error) {
+	if c.weeksMinVersion, err = parseVersion(c.WeeksMinVersion); err != nil {
+		return fmt.Errorf("invalid weeksMinVersion value: %w", err)
+	}
+	return nil
+}
+
+func NewAlertsForFormatCheck(prom *promapi.FailoverGroup) AlertsForFormatCheck {
+	return AlertsForFormatCheck{prom: prom}
+}
+
+type AlertsForFormatCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c AlertsForFormatCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c AlertsForFormatCheck) String() string {
+	return fmt.Sprintf("%s(%s)", AlertsForFormatCheckName, c.prom.Name())
+}
+
+func (c AlertsForFormatCheck) Reporter() string {
+	return AlertsForFormatCheckName
+}
+
+func (c AlertsForFormatCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil {
+		return problems
+	}
+
+	fields := []*parser.YamlNode{}
+	names := []string{}
+	if rule.AlertingRule.For != nil && usesWeeks(rule.AlertingRule.For.Value) {
+		fields = append(fields, rule.AlertingRule.For)
+		names = append(names, "for")
+	}
+	if rule.AlertingRule.KeepFiringFor != nil && usesWeeks(rule.AlertingRule.KeepFiringFor.Value) {
+		fields = append(fields, rule.AlertingRule.KeepFiringFor)
+		names = append(names, "keep_firing_for")
+	}
+	if len(fields) == 0 {
+		return problems
+	}
+
+	// This check is only enabled when there's a check block for it,
+	// which must set the oldest Prometheus release supporting weeks.
+	s := ctx.Value(SettingsKey(c.Reporter()))
+	if s == nil {
+		return problems
+	}
+	settings := s.(*AlertsForFormatSettings)
+
+	info, err := c.prom.BuildInfo(ctx)
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+		problems = append(problems, Problem{
+			Lines:    fields[0].Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	ver, err := parseVersion(info.BuildInfo.Version)
+	if err != nil {
+		return problems
+	}
+	if !ver.isOlder(settings.weeksMinVersion) {
+		return problems
+	}
+
+	for i, field := range fields {
+		problems = append(problems, Problem{
+			Lines:    field.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s: %s` is using weeks as the duration unit, which is not supported by %s running Prometheus `%s`.",
+				names[i], field.Value, promText(c.prom.Name(), info.URI), info.BuildInfo.Version),
+			Details:  AlertsForFormatCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
+
+func usesWeeks(s string) bool {
+	if _, err := model.ParseDuration(s); err != nil {
+		return false
+	}
+	return strings.Contains(s, "w")
+}
+
+type version struct {
+	major, minor, patch int
+}
+
+func (v version) isOlder(o version) bool {
+	if v.major != o.major {
+		return v.major < o.major
+	}
+	if v.minor != o.minor {
+		return v.minor < o.minor
+	}
+	return v.patch < o.patch
+}
+
+func parseVersion(s string) (v version, err error) {
+	s = strings.TrimPrefix(s, "v")
+	if idx := strings.IndexAny(s, "-+"); idx >= 0 {
+		s = s[:idx]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return v, fmt.Errorf("%q is not a valid version", s)
+	}
+	dst := []*int{&v.major, &v.minor, &v.patch}
+	for i, p := range parts {
+		if *dst[i], err = strconv.Atoi(p); err != nil {
+			return v, fmt.Errorf("%q is not a valid version", s)
+		}
+	}
+	return v, nil
+}
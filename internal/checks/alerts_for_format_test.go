@@ -0,0 +1,205 @@
+package checks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAlertsForFormatCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAlertsForFormatCheck(prom)
+}
+
+func forFormatText(field, value, name, uri, version string) string {
+	return fmt.Sprintf("`%s: %s` is using weeks as the duration unit, which is not supported by `%s` Prometheus server at %s running Prometheus `%s`.", field, value, name, uri, version)
+}
+
+func forFormatContext(t *testing.T, weeksMinVersion string) func() context.Context {
+	return func() context.Context {
+		s := checks.AlertsForFormatSettings{
+			WeeksMinVersion: weeksMinVersion,
+		}
+		if err := s.Validate(); err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		return context.WithValue(context.Background(), checks.SettingsKey(checks.AlertsForFormatCheckName), &s)
+	}
+}
+
+func TestAlertsForFormatCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts without for",
+			content:     "- alert: foo\n  expr: foo > 1\n",
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores durations without weeks",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 7d\n  keep_firing_for: 1h\n",
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores invalid durations",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 1w1w\n",
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores weeks when not configured",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 1w\n",
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "weeks on a recent Prometheus",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 1w\n",
+			ctx:         forFormatContext(t, "2.0.0"),
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "2.45.0"},
+				},
+			},
+		},
+		{
+			description: "weeks on an old Prometheus",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 1w\n  keep_firing_for: 2w1d\n",
+			ctx:         forFormatContext(t, "2.0.0"),
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.AlertsForFormatCheckName,
+						Text:     forFormatText("for", "1w", "prom", uri, "1.8.2"),
+						Details:  checks.AlertsForFormatCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.AlertsForFormatCheckName,
+						Text:     forFormatText("keep_firing_for", "2w1d", "prom", uri, "1.8.2"),
+						Details:  checks.AlertsForFormatCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "1.8.2"},
+				},
+			},
+		},
+		{
+			description: "weeks on Prometheus older than weeksMinVersion",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 1w\n",
+			ctx:         forFormatContext(t, "2.50.0"),
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.AlertsForFormatCheckName,
+						Text:     forFormatText("for", "1w", "prom", uri, "2.45.0-rc.0"),
+						Details:  checks.AlertsForFormatCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "2.45.0-rc.0"},
+				},
+			},
+		},
+		{
+			description: "weeks on Prometheus newer than weeksMinVersion",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 1w\n",
+			ctx:         forFormatContext(t, "2.50"),
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "2.50.1"},
+				},
+			},
+		},
+		{
+			description: "unparsable version",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 1w\n",
+			ctx:         forFormatContext(t, "2.0.0"),
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "main"},
+				},
+			},
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- alert: foo\n  expr: foo > 1\n  for: 1w\n",
+			ctx:         forFormatContext(t, "2.0.0"),
+			checker:     newAlertsForFormatCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.AlertsForFormatCheckName,
+						Text:     checkErrorUnableToRun(checks.AlertsForFormatCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,91 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	ForOffsetCheckName    = "alerts/for_offset"
+	ForOffsetCheckDetails = `The [offset](https://prometheus.io/docs/prometheus/latest/querying/basics/#offset-modifier) modifier makes the query look at data from the past, and ` + "`for`" + ` makes Prometheus wait before firing the alert.
+Both delays add up, so the alert will only fire after problems were present for the sum of both durations.
+Double check that this is the intended behaviour.`
+)
+
+func NewForOffsetCheck() ForOffsetCheck {
+	return ForOffsetCheck{}
+}
+
+type ForOffsetCheck struct{}
+
+func (c ForOffsetCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c ForOffsetCheck) String() string {
+	return ForOffsetCheckName
+}
+
+func (c ForOffsetCheck) Reporter() string {
+	return ForOffsetCheckName
+}
+
+func (c ForOffsetCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.For == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	forDur, err := model.ParseDuration(rule.AlertingRule.For.Value)
+	if err != nil || forDur <= 0 {
+		return problems
+	}
+
+	offset := maxOffset(rule.AlertingRule.Expr.Query)
+	if offset <= 0 {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.Expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("This query is using `offset %s` and the alert has `for: %s`, so it will only fire after problems were present for at least %s.",
+			output.HumanizeDuration(offset), rule.AlertingRule.For.Value, output.HumanizeDuration(offset+time.Duration(forDur))),
+		Details:  ForOffsetCheckDetails,
+		Severity: Information,
+	})
+
+	return problems
+}
+
+// maxOffset returns the longest offset modifier used in given query.
+func maxOffset(node *parser.PromQLNode) (offset time.Duration) {
+	for _, n := range parser.WalkDownExpr[promParser.Node](node) {
+		var o time.Duration
+		switch e := n.Expr.(type) {
+		case *promParser.VectorSelector:
+			o = e.OriginalOffset
+		case *promParser.SubqueryExpr:
+			o = e.OriginalOffset
+		}
+		if o > offset {
+			offset = o
+		}
+	}
+	return offset
+}
@@ -0,0 +1,122 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newForOffsetCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewForOffsetCheck()
+}
+
+func TestForOffsetCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: up offset 5m\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: up offset 5m ==\n  for: 5m\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts without for",
+			content:     "- alert: foo\n  expr: up offset 5m == 0\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts with for: 0",
+			content:     "- alert: foo\n  expr: up offset 5m == 0\n  for: 0s\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts without offset",
+			content:     "- alert: foo\n  expr: up == 0\n  for: 5m\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores negative offset",
+			content:     "- alert: foo\n  expr: up offset -5m == 0\n  for: 5m\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "for with offset",
+			content:     "- alert: foo\n  expr: up offset 10m == 0\n  for: 5m\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ForOffsetCheckName,
+						Text:     "This query is using `offset 10m` and the alert has `for: 5m`, so it will only fire after problems were present for at least 15m.",
+						Details:  checks.ForOffsetCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "for with multiple offsets",
+			content:     "- alert: foo\n  expr: rate(errors[5m] offset 1h) > rate(errors[5m] offset 1d) + max_over_time(sum(requests)[1h:] offset 2h)\n  for: 1h\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ForOffsetCheckName,
+						Text:     "This query is using `offset 1d` and the alert has `for: 1h`, so it will only fire after problems were present for at least 1d1h.",
+						Details:  checks.ForOffsetCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "for with subquery offset",
+			content:     "- alert: foo\n  expr: max_over_time(sum(up)[5m:] offset 30m) == 0\n  for: 5m\n",
+			checker:     newForOffsetCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ForOffsetCheckName,
+						Text:     "This query is using `offset 30m` and the alert has `for: 5m`, so it will only fire after problems were present for at least 35m.",
+						Details:  checks.ForOffsetCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,132 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	ForDurationOrderCheckName    = "alerts/for_order"
+	ForDurationOrderCheckDetails = `Prometheus duration strings can combine multiple units but these must be ordered from the largest to the smallest one, each unit can be used only once.
+Supported time durations are documented [here](https://prometheus.io/docs/prometheus/latest/querying/basics/#time-durations).`
+)
+
+var (
+	durationUnitRe = regexp.MustCompile(`([0-9]+)(ms|y|w|d|h|m|s)`)
+	durationUnits  = map[string]time.Duration{
+		"y":  time.Hour * 24 * 365,
+		"w":  time.Hour * 24 * 7,
+		"d":  time.Hour * 24,
+		"h":  time.Hour,
+		"m":  time.Minute,
+		"s":  time.Second,
+		"ms": time.Millisecond,
+	}
+)
+
+func NewForDurationOrderCheck() ForDurationOrderCheck {
+	return ForDurationOrderCheck{}
+}
+
+type ForDurationOrderCheck struct{}
+
+func (c ForDurationOrderCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c ForDurationOrderCheck) String() string {
+	return ForDurationOrderCheckName
+}
+
+func (c ForDurationOrderCheck) Reporter() string {
+	return ForDurationOrderCheckName
+}
+
+func (c ForDurationOrderCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil {
+		return problems
+	}
+
+	if rule.AlertingRule.For != nil {
+		problems = append(problems, c.checkField("for", rule.AlertingRule.For.Value, rule.AlertingRule.For.Lines)...)
+	}
+	if rule.AlertingRule.KeepFiringFor != nil {
+		problems = append(problems, c.checkField("keep_firing_for", rule.AlertingRule.KeepFiringFor.Value, rule.AlertingRule.KeepFiringFor.Lines)...)
+	}
+
+	return problems
+}
+
+func (c ForDurationOrderCheck) checkField(name, value string, lines parser.LineRange) (problems []Problem) {
+	if _, err := model.ParseDuration(value); err == nil {
+		return problems
+	}
+
+	d, ok := parseUnorderedDuration(value)
+	if !ok {
+		// Not just a problem with unit order, alerts/for will report it.
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s: %s` is using duration units in the wrong order, units must be ordered from the largest to the smallest, use `%s: %s` instead.",
+			name, value, name, model.Duration(d)),
+		Details:  ForDurationOrderCheckDetails,
+		Severity: Warning,
+	})
+	return problems
+}
+
+// parseUnorderedDuration will parse a duration string made of valid
+// number+unit pairs regardless of the order of units.
+// Each unit can only be used once.
+func parseUnorderedDuration(s string) (d time.Duration, ok bool) {
+	matches := durationUnitRe.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return 0, false
+	}
+
+	var pos int
+	seen := map[string]struct{}{}
+	for _, m := range matches {
+		if m[0] != pos {
+			return 0, false
+		}
+		pos = m[1]
+
+		unit := s[m[4]:m[5]]
+		if _, ok := seen[unit]; ok {
+			return 0, false
+		}
+		seen[unit] = struct{}{}
+
+		n, err := strconv.ParseInt(s[m[2]:m[3]], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		d += time.Duration(n) * durationUnits[unit]
+	}
+	if pos != len(s) {
+		return 0, false
+	}
+
+	return d, true
+}
@@ -0,0 +1,108 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newForDurationOrderCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewForDurationOrderCheck()
+}
+
+func TestForDurationOrderCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(foo) without(job)\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts without for",
+			content:     "- alert: foo\n  expr: foo\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "single unit",
+			content:     "- alert: foo\n  expr: foo\n  for: 30m\n  keep_firing_for: 1h\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ordered units",
+			content:     "- alert: foo\n  expr: foo\n  for: 1h30m\n  keep_firing_for: 1d2h3m4s5ms\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "invalid duration",
+			content:     "- alert: foo\n  expr: foo\n  for: abc\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "invalid unit",
+			content:     "- alert: foo\n  expr: foo\n  for: 30m1x\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "reversed for",
+			content:     "- alert: foo\n  expr: foo\n  for: 30m1h\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.ForDurationOrderCheckName,
+						Text:     "`for: 30m1h` is using duration units in the wrong order, units must be ordered from the largest to the smallest, use `for: 1h30m` instead.",
+						Details:  checks.ForDurationOrderCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "reversed keep_firing_for",
+			content:     "- alert: foo\n  expr: foo\n  for: 5m\n  keep_firing_for: 10s2d\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.ForDurationOrderCheckName,
+						Text:     "`keep_firing_for: 10s2d` is using duration units in the wrong order, units must be ordered from the largest to the smallest, use `keep_firing_for: 2d10s` instead.",
+						Details:  checks.ForDurationOrderCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "repeated unit",
+			content:     "- alert: foo\n  expr: foo\n  for: 1h1h\n",
+			checker:     newForDurationOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,88 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	ForRetentionCheckName    = "alerts/for_retention"
+	ForRetentionCheckDetails = `Alerts with ` + "`for`" + ` set will only fire after the alert query returned results for the whole ` + "`for`" + ` duration.
+If ` + "`for`" + ` is longer than the amount of metrics history Prometheus keeps, then the data this alert depends on might be gone before the alert has a chance to fire.
+Make sure that ` + "`for`" + ` is shorter than the [retention](https://prometheus.io/docs/prometheus/latest/storage/#operational-aspects) configured on Prometheus.`
+)
+
+func NewForRetentionCheck(prom *promapi.FailoverGroup) ForRetentionCheck {
+	return ForRetentionCheck{prom: prom}
+}
+
+type ForRetentionCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c ForRetentionCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c ForRetentionCheck) String() string {
+	return fmt.Sprintf("%s(%s)", ForRetentionCheckName, c.prom.Name())
+}
+
+func (c ForRetentionCheck) Reporter() string {
+	return ForRetentionCheckName
+}
+
+func (c ForRetentionCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.For == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	forDur, err := model.ParseDuration(rule.AlertingRule.For.Value)
+	if err != nil || forDur <= 0 {
+		return problems
+	}
+
+	status, err := c.prom.TSDBStatus(ctx, 0)
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+		problems = append(problems, Problem{
+			Lines:    rule.AlertingRule.For.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	// Retention is unknown if we couldn't get it from Prometheus flags.
+	if status.RetentionDuration <= 0 || time.Duration(forDur) <= status.RetentionDuration {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.For.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("This alert has `for: %s` but %s is configured to only keep %s of metrics history, this alert might never fire.",
+			rule.AlertingRule.For.Value, promText(c.prom.Name(), status.URI), output.HumanizeDuration(status.RetentionDuration)),
+		Details:  ForRetentionCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
@@ -0,0 +1,134 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newForRetentionCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewForRetentionCheck(prom)
+}
+
+func forRetentionText(name, uri, forVal, retention string) string {
+	return fmt.Sprintf("This alert has `for: %s` but `%s` Prometheus server at %s is configured to only keep %s of metrics history, this alert might never fire.", forVal, name, uri, retention)
+}
+
+func TestForRetentionCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: up == 0\n",
+			checker:     newForRetentionCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: up ==\n  for: 1d\n",
+			checker:     newForRetentionCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts without for",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newForRetentionCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- alert: foo\n  expr: up == 0\n  for: 1d\n",
+			checker:     newForRetentionCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.ForRetentionCheckName,
+						Text:     checkErrorUnableToRun(checks.ForRetentionCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTSDBPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "unknown retention",
+			content:     "- alert: foo\n  expr: up == 0\n  for: 30d\n",
+			checker:     newForRetentionCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTSDBPath},
+					resp:  tsdbResponse{numSeries: 100},
+				},
+				{
+					conds: []requestCondition{requireFlagsPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "for shorter than retention",
+			content:     "- alert: foo\n  expr: up == 0\n  for: 1h\n",
+			checker:     newForRetentionCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTSDBPath},
+					resp:  tsdbResponse{numSeries: 100},
+				},
+				{
+					conds: []requestCondition{requireFlagsPath},
+					resp:  flagsResponse{flags: map[string]string{"storage.tsdb.retention.time": "1d"}},
+				},
+			},
+		},
+		{
+			description: "for longer than retention",
+			content:     "- alert: foo\n  expr: up == 0\n  for: 2d\n",
+			checker:     newForRetentionCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.ForRetentionCheckName,
+						Text:     forRetentionText("prom", uri, "2d", "1d"),
+						Details:  checks.ForRetentionCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTSDBPath},
+					resp:  tsdbResponse{numSeries: 100},
+				},
+				{
+					conds: []requestCondition{requireFlagsPath},
+					resp:  flagsResponse{flags: map[string]string{"storage.tsdb.retention.time": "1d"}},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
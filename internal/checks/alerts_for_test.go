@@ -12,6 +12,10 @@ func newAlertsForCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
 	return checks.NewAlertsForCheck()
 }
 
+func newAlertsForCheckWithForOrder(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAlertsForCheck().WithEnabledChecks([]string{checks.ForDurationOrderCheckName})
+}
+
 func TestAlertsForCheck(t *testing.T) {
 	testCases := []checkTest{
 		{
@@ -146,6 +150,53 @@ func TestAlertsForCheck(t *testing.T) {
 				}
 			},
 		},
+		{
+			description: "units in the wrong order",
+			content:     "- alert: foo\n  expr: foo\n  for: 30m1h\n",
+			checker:     newAlertsForCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: "alerts/for",
+						Text:     `invalid duration: not a valid duration string: "30m1h"`,
+						Details:  checks.AlertForCheckDurationHelp,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "units in the wrong order with alerts/for_order enabled",
+			content:     "- alert: foo\n  expr: foo\n  for: 30m1h\n",
+			checker:     newAlertsForCheckWithForOrder,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "repeated unit",
+			content:     "- alert: foo\n  expr: foo\n  for: 1h1h\n",
+			checker:     newAlertsForCheckWithForOrder,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: "alerts/for",
+						Text:     `invalid duration: not a valid duration string: "1h1h"`,
+						Details:  checks.AlertForCheckDurationHelp,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
 	}
 	runTests(t, testCases)
 }
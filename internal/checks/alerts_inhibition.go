@@ -0,0 +1,262 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
+)
+
+const (
+	InhibitionCheckName    = "alerts/inhibition"
+	InhibitionCheckDetails = `Alertmanager [inhibition rules](https://prometheus.io/docs/alerting/latest/configuration/#inhibit_rule) will only mute target alerts that have the same value for all labels listed in ` + "`equal`" + ` as the source alert.
+Alerts missing any of these labels will never be inhibited.`
+)
+
+var inhibitMatcherRe = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*(=~|!~|!=|=)\s*(.*?)\s*$`)
+
+type InhibitRuleSettings struct {
+	SourceMatchers []string `hcl:"sourceMatchers,optional" json:"sourceMatchers,omitempty"`
+	TargetMatchers []string `hcl:"targetMatchers,optional" json:"targetMatchers,omitempty"`
+	Equal          []string `hcl:"equal" json:"equal"`
+}
+
+type InhibitionSettings struct {
+	Comment  string                `hcl:"comment,optional" json:"comment,omitempty"`
+	Severity string                `hcl:"severity,optional" json:"severity,omitempty"`
+	Inhibit  []InhibitRuleSettings `hcl:"inhibit,block" json:"inhibit"`
+	rules    []InhibitRule
+	severity Severity
+}
+
+func (s *InhibitionSettings) Validate() (err error) {
+	if len(s.Inhibit) == 0 {
+		return errors.New("at least one inhibit block is required")
+	}
+	s.rules = make([]InhibitRule, 0, len(s.Inhibit))
+	for _, ir := range s.Inhibit {
+		if len(ir.Equal) == 0 {
+			return errors.New("equal cannot be empty")
+		}
+		rule := InhibitRule{Equal: ir.Equal}
+		if rule.Source, err = parseInhibitMatchers(ir.SourceMatchers); err != nil {
+			return fmt.Errorf("invalid sourceMatchers value: %w", err)
+		}
+		if rule.Target, err = parseInhibitMatchers(ir.TargetMatchers); err != nil {
+			return fmt.Errorf("invalid targetMatchers value: %w", err)
+		}
+		s.rules = append(s.rules, rule)
+	}
+	s.severity = Warning
+	if s.Severity != "" {
+		if s.severity, err = ParseSeverity(s.Severity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InhibitionSettings) Rules() []InhibitRule {
+	return s.rules
+}
+
+func (s *InhibitionSettings) ReportSeverity() Severity {
+	return s.severity
+}
+
+// InhibitRule describes a single Alertmanager inhibition rule.
+// Empty Source or Target list matches all alerts, just like in Alertmanager.
+type InhibitRule struct {
+	Source []*labels.Matcher
+	Target []*labels.Matcher
+	Equal  []string
+}
+
+func NewInhibitionCheck(rules []InhibitRule, comment string, severity Severity) InhibitionCheck {
+	return InhibitionCheck{
+		rules:    rules,
+		comment:  comment,
+		severity: severity,
+	}
+}
+
+type InhibitionCheck struct {
+	comment  string
+	rules    []InhibitRule
+	severity Severity
+}
+
+func (c InhibitionCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c InhibitionCheck) String() string {
+	return InhibitionCheckName
+}
+
+func (c InhibitionCheck) Reporter() string {
+	return InhibitionCheckName
+}
+
+func (c InhibitionCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	var equal []string
+	for _, ir := range c.rules {
+		if !alertMatches(rule.AlertingRule, ir.Source) && !alertMatches(rule.AlertingRule, ir.Target) {
+			continue
+		}
+		for _, name := range ir.Equal {
+			if !slices.Contains(equal, name) {
+				equal = append(equal, name)
+			}
+		}
+	}
+	if len(equal) == 0 {
+		return problems
+	}
+
+	aggrs := utils.HasOuterAggregation(rule.AlertingRule.Expr.Query)
+	if len(aggrs) == 0 {
+		return problems
+	}
+
+	var safeLabels []string
+	for _, be := range binaryExprs(rule.AlertingRule.Expr.Query) {
+		if be.VectorMatching != nil {
+			safeLabels = append(safeLabels, be.VectorMatching.Include...)
+		}
+	}
+	for _, cl := range calls(rule.AlertingRule.Expr.Query, "label_replace") {
+		if len(cl.Args) > 1 {
+			if s, ok := cl.Args[1].(*promParser.StringLiteral); ok {
+				safeLabels = append(safeLabels, s.Val)
+			}
+		}
+	}
+
+	details := InhibitionCheckDetails
+	if c.comment != "" {
+		details = fmt.Sprintf("%s\n%s", details, maybeComment(c.comment))
+	}
+
+	for _, name := range equal {
+		if rule.AlertingRule.Labels != nil && rule.AlertingRule.Labels.GetValue(name) != nil {
+			continue
+		}
+		if slices.Contains(safeLabels, name) {
+			continue
+		}
+		for _, aggr := range aggrs {
+			if !isLabelRemoved(aggr, name) {
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines:    rule.AlertingRule.Expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` label is required by inhibition rules but it's removed from query results by `%s`, this alert will never be inhibited.",
+					name, aggrClause(aggr)),
+				Details:  details,
+				Severity: c.severity,
+			})
+			break
+		}
+	}
+
+	return problems
+}
+
+func isLabelRemoved(aggr *promParser.AggregateExpr, name string) bool {
+	if aggr.Without {
+		return slices.Contains(aggr.Grouping, name)
+	}
+	return !slices.Contains(aggr.Grouping, name)
+}
+
+func aggrClause(aggr *promParser.AggregateExpr) string {
+	switch {
+	case aggr.Without:
+		return fmt.Sprintf("without(%s)", strings.Join(aggr.Grouping, ", "))
+	case len(aggr.Grouping) > 0:
+		return fmt.Sprintf("by(%s)", strings.Join(aggr.Grouping, ", "))
+	default:
+		return fmt.Sprintf("%s()", aggr.Op)
+	}
+}
+
+// alertMatches returns true if all alerts generated by this rule will always
+// match all given matchers. Only the alert name and static labels are used,
+// labels returned by the query or using templates can have any value.
+func alertMatches(rule *parser.AlertingRule, matchers []*labels.Matcher) bool {
+	for _, m := range matchers {
+		var value string
+		switch {
+		case m.Name == model.AlertNameLabel:
+			value = rule.Alert.Value
+		case rule.Labels != nil && rule.Labels.GetValue(m.Name) != nil:
+			value = rule.Labels.GetValue(m.Name).Value
+			if strings.Contains(value, "{{") {
+				return false
+			}
+		default:
+			return false
+		}
+		if !m.Matches(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseInhibitMatchers(src []string) (matchers []*labels.Matcher, err error) {
+	for _, s := range src {
+		parts := inhibitMatcherRe.FindStringSubmatch(s)
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("bad matcher format: %s", s)
+		}
+		name, op, value := parts[1], parts[2], parts[3]
+		if strings.HasPrefix(value, `"`) {
+			if value, err = strconv.Unquote(value); err != nil {
+				return nil, fmt.Errorf("bad matcher value: %s", s)
+			}
+		}
+		var mt labels.MatchType
+		switch op {
+		case "=":
+			mt = labels.MatchEqual
+		case "!=":
+			mt = labels.MatchNotEqual
+		case "=~":
+			mt = labels.MatchRegexp
+		case "!~":
+			mt = labels.MatchNotRegexp
+		}
+		m, err := labels.NewMatcher(mt, name, value)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	return matchers, nil
+}
@@ -0,0 +1,320 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newInhibitionCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewInhibitionCheck([]checks.InhibitRule{{Equal: []string{"cluster", "namespace"}}}, "", checks.Warning)
+}
+
+func newInhibitionCheckWithRules(rules ...checks.InhibitRuleSettings) func(*promapi.FailoverGroup) checks.RuleChecker {
+	return func(_ *promapi.FailoverGroup) checks.RuleChecker {
+		s := checks.InhibitionSettings{Inhibit: rules}
+		if err := s.Validate(); err != nil {
+			panic(err)
+		}
+		return checks.NewInhibitionCheck(s.Rules(), s.Comment, s.ReportSeverity())
+	}
+}
+
+func inhibitionText(name, clause string) string {
+	return fmt.Sprintf("`%s` label is required by inhibition rules but it's removed from query results by `%s`, this alert will never be inhibited.", name, clause)
+}
+
+func TestInhibitionCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newInhibitionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: sum(foo) without(\n",
+			checker:     newInhibitionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "no aggregation",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newInhibitionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "all labels preserved by()",
+			content:     "- alert: foo\n  expr: sum(up) by(cluster, namespace, job) == 0\n",
+			checker:     newInhibitionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "labels not removed by without()",
+			content:     "- alert: foo\n  expr: sum(up) without(instance) == 0\n",
+			checker:     newInhibitionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "labels removed by sum()",
+			content:     "- alert: foo\n  expr: sum(up) == 0\n",
+			checker:     newInhibitionCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.InhibitionCheckName,
+						Text:     inhibitionText("cluster", "sum()"),
+						Details:  checks.InhibitionCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.InhibitionCheckName,
+						Text:     inhibitionText("namespace", "sum()"),
+						Details:  checks.InhibitionCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "label removed by by() / other set as static label",
+			content:     "- alert: foo\n  expr: sum(up) by(cluster) == 0\n  labels:\n    namespace: default\n",
+			checker:     newInhibitionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "label removed by by()",
+			content:     "- alert: foo\n  expr: sum(up) by(job, namespace) == 0\n",
+			checker:     newInhibitionCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.InhibitionCheckName,
+						Text:     inhibitionText("cluster", "by(job, namespace)"),
+						Details:  checks.InhibitionCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "label removed by without() / custom comment and severity",
+			content:     "- alert: foo\n  expr: sum(up) without(cluster) == 0\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewInhibitionCheck([]checks.InhibitRule{{Equal: []string{"cluster"}}}, "some text", checks.Bug)
+			},
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.InhibitionCheckName,
+						Text:     inhibitionText("cluster", "without(cluster)"),
+						Details:  checks.InhibitionCheckDetails + "\nRule comment: some text",
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "label added back by label_replace()",
+			content:     "- alert: foo\n  expr: label_replace(sum(up), \"cluster\", \"dev\", \"\", \"\") == 0\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewInhibitionCheck([]checks.InhibitRule{{Equal: []string{"cluster"}}}, "", checks.Warning)
+			},
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "label added back by group_left()",
+			content:     "- alert: foo\n  expr: sum(up) by(job) * on(job) group_left(cluster) job_info\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewInhibitionCheck([]checks.InhibitRule{{Equal: []string{"cluster"}}}, "", checks.Warning)
+			},
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "target matchers / matching static label",
+			content:     "- alert: foo\n  expr: sum(up) by(job) == 0\n  labels:\n    severity: warning\n",
+			checker: newInhibitionCheckWithRules(checks.InhibitRuleSettings{
+				SourceMatchers: []string{"alertname=ClusterDown"},
+				TargetMatchers: []string{`severity="warning"`},
+				Equal:          []string{"cluster"},
+			}),
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.InhibitionCheckName,
+						Text:     inhibitionText("cluster", "by(job)"),
+						Details:  checks.InhibitionCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "target matchers / different static label",
+			content:     "- alert: foo\n  expr: sum(up) by(job) == 0\n  labels:\n    severity: critical\n",
+			checker: newInhibitionCheckWithRules(checks.InhibitRuleSettings{
+				SourceMatchers: []string{"alertname=ClusterDown"},
+				TargetMatchers: []string{`severity="warning"`},
+				Equal:          []string{"cluster"},
+			}),
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "target matchers / label set by query",
+			content:     "- alert: foo\n  expr: sum(up) by(job, severity) == 0\n",
+			checker: newInhibitionCheckWithRules(checks.InhibitRuleSettings{
+				SourceMatchers: []string{"alertname=ClusterDown"},
+				TargetMatchers: []string{`severity="warning"`},
+				Equal:          []string{"cluster"},
+			}),
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "target matchers / templated label",
+			content:     "- alert: foo\n  expr: sum(up) by(job) == 0\n  labels:\n    severity: \"{{ $labels.job }}\"\n",
+			checker: newInhibitionCheckWithRules(checks.InhibitRuleSettings{
+				SourceMatchers: []string{"alertname=ClusterDown"},
+				TargetMatchers: []string{"severity=~.+"},
+				Equal:          []string{"cluster"},
+			}),
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "source matchers / matching alert name",
+			content:     "- alert: ClusterDown\n  expr: sum(up) by(job) == 0\n",
+			checker: newInhibitionCheckWithRules(checks.InhibitRuleSettings{
+				SourceMatchers: []string{"alertname=ClusterDown"},
+				TargetMatchers: []string{`severity="warning"`},
+				Equal:          []string{"cluster"},
+			}),
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.InhibitionCheckName,
+						Text:     inhibitionText("cluster", "by(job)"),
+						Details:  checks.InhibitionCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "multiple rules / same label reported once",
+			content:     "- alert: foo\n  expr: sum(up) by(job) == 0\n  labels:\n    severity: warning\n",
+			checker: newInhibitionCheckWithRules(
+				checks.InhibitRuleSettings{
+					SourceMatchers: []string{"alertname=ClusterDown"},
+					TargetMatchers: []string{"severity!=critical"},
+					Equal:          []string{"cluster"},
+				},
+				checks.InhibitRuleSettings{
+					SourceMatchers: []string{"alertname=ClusterDown"},
+					TargetMatchers: []string{"severity=~warning|info"},
+					Equal:          []string{"cluster"},
+				},
+				checks.InhibitRuleSettings{
+					SourceMatchers: []string{"alertname=ClusterDown"},
+					TargetMatchers: []string{"severity=info"},
+					Equal:          []string{"namespace"},
+				},
+			),
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.InhibitionCheckName,
+						Text:     inhibitionText("cluster", "by(job)"),
+						Details:  checks.InhibitionCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
+
+func TestInhibitionSettings(t *testing.T) {
+	s := checks.InhibitionSettings{}
+	require.EqualError(t, s.Validate(), "at least one inhibit block is required")
+
+	s = checks.InhibitionSettings{Inhibit: []checks.InhibitRuleSettings{{}}}
+	require.EqualError(t, s.Validate(), "equal cannot be empty")
+
+	s = checks.InhibitionSettings{Inhibit: []checks.InhibitRuleSettings{{SourceMatchers: []string{"foo"}, Equal: []string{"cluster"}}}}
+	require.EqualError(t, s.Validate(), "invalid sourceMatchers value: bad matcher format: foo")
+
+	s = checks.InhibitionSettings{Inhibit: []checks.InhibitRuleSettings{{TargetMatchers: []string{`foo="bar`}, Equal: []string{"cluster"}}}}
+	require.EqualError(t, s.Validate(), "invalid targetMatchers value: bad matcher value: foo=\"bar")
+
+	s = checks.InhibitionSettings{Inhibit: []checks.InhibitRuleSettings{{TargetMatchers: []string{"foo=~("}, Equal: []string{"cluster"}}}}
+	require.EqualError(t, s.Validate(), "invalid targetMatchers value: error parsing regexp: missing closing ): `^(?:()$`")
+
+	s = checks.InhibitionSettings{Severity: "foo", Inhibit: []checks.InhibitRuleSettings{{Equal: []string{"cluster"}}}}
+	require.EqualError(t, s.Validate(), "unknown severity: foo")
+
+	s = checks.InhibitionSettings{Inhibit: []checks.InhibitRuleSettings{{
+		SourceMatchers: []string{"alertname = ClusterDown"},
+		TargetMatchers: []string{`severity!="critical"`, "team=~db.+", "env!~dev"},
+		Equal:          []string{"cluster"},
+	}}}
+	require.NoError(t, s.Validate())
+	require.Equal(t, checks.Warning, s.ReportSeverity())
+	require.Len(t, s.Rules(), 1)
+	require.Equal(t, `alertname="ClusterDown"`, s.Rules()[0].Source[0].String())
+	require.Equal(t, `severity!="critical"`, s.Rules()[0].Target[0].String())
+	require.Equal(t, `team=~"db.+"`, s.Rules()[0].Target[1].String())
+	require.Equal(t, `env!~"dev"`, s.Rules()[0].Target[2].String())
+	require.Equal(t, []string{"cluster"}, s.Rules()[0].Equal)
+}
@@ -0,0 +1,90 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	AlertNameCheckName    = "alerts/name"
+	AlertNameCheckDetails = `Alertmanager uses the ` + "`alertname`" + ` label for routing, grouping and silencing alerts.
+Characters other than letters, digits and underscores can break regexp based routes and silence matchers, which can cause alerts to be sent to the wrong receiver or not be silenced.`
+
+	defaultAlertNamePattern = "^[a-zA-Z][a-zA-Z0-9_]*$"
+)
+
+type AlertNameSettings struct {
+	Pattern string `hcl:"pattern,optional" json:"pattern,omitempty"`
+	pattern *regexp.Regexp
+}
+
+func (c *AlertNameSettings) Validate() (err error) {
+	p := defaultAlertNamePattern
+	if c.Pattern != "" {
+		p = c.Pattern
+	}
+	if c.pattern, err = regexp.Compile(p); err != nil {
+		return fmt.Errorf("invalid pattern value: %w", err)
+	}
+	return nil
+}
+
+func (c *AlertNameSettings) Regexp() *regexp.Regexp {
+	return c.pattern
+}
+
+func NewAlertNameCheck(pattern *regexp.Regexp) AlertNameCheck {
+	if pattern == nil {
+		pattern = regexp.MustCompile(defaultAlertNamePattern)
+	}
+	return AlertNameCheck{pattern: pattern}
+}
+
+type AlertNameCheck struct {
+	pattern *regexp.Regexp
+}
+
+func (c AlertNameCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c AlertNameCheck) String() string {
+	return AlertNameCheckName
+}
+
+func (c AlertNameCheck) Reporter() string {
+	return AlertNameCheckName
+}
+
+func (c AlertNameCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil {
+		return problems
+	}
+
+	if c.pattern.MatchString(rule.AlertingRule.Alert.Value) {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.Alert.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("Alert name `%s` doesn't match `%s`, this can break Alertmanager routes and silences using the `alertname` label.",
+			rule.AlertingRule.Alert.Value, c.pattern.String()),
+		Details:  AlertNameCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
@@ -0,0 +1,121 @@
+package checks_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAlertNameCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAlertNameCheck(nil)
+}
+
+func TestAlertNameCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo.bar\n  expr: up == 0\n",
+			checker:     newAlertNameCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "valid alert name",
+			content:     "- alert: Instance_Down2\n  expr: up == 0\n",
+			checker:     newAlertNameCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "alert name with dashes",
+			content:     "- alert: instance-down\n  expr: up == 0\n",
+			checker:     newAlertNameCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.AlertNameCheckName,
+						Text:     "Alert name `instance-down` doesn't match `^[a-zA-Z][a-zA-Z0-9_]*$`, this can break Alertmanager routes and silences using the `alertname` label.",
+						Details:  checks.AlertNameCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "alert name starting with a digit",
+			content:     "- alert: 5xx errors\n  expr: up == 0\n",
+			checker:     newAlertNameCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.AlertNameCheckName,
+						Text:     "Alert name `5xx errors` doesn't match `^[a-zA-Z][a-zA-Z0-9_]*$`, this can break Alertmanager routes and silences using the `alertname` label.",
+						Details:  checks.AlertNameCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "custom pattern / match",
+			content:     "- alert: instance-down\n  expr: up == 0\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewAlertNameCheck(regexp.MustCompile("^[a-z-]+$"))
+			},
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "custom pattern / no match",
+			content:     "- alert: InstanceDown\n  expr: up == 0\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewAlertNameCheck(regexp.MustCompile("^[a-z-]+$"))
+			},
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.AlertNameCheckName,
+						Text:     "Alert name `InstanceDown` doesn't match `^[a-z-]+$`, this can break Alertmanager routes and silences using the `alertname` label.",
+						Details:  checks.AlertNameCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
+
+func TestAlertNameSettings(t *testing.T) {
+	s := checks.AlertNameSettings{}
+	require.NoError(t, s.Validate())
+	require.Equal(t, "^[a-zA-Z][a-zA-Z0-9_]*$", s.Regexp().String())
+
+	s = checks.AlertNameSettings{Pattern: "^[a-z]+$"}
+	require.NoError(t, s.Validate())
+	require.Equal(t, "^[a-z]+$", s.Regexp().String())
+
+	s = checks.AlertNameSettings{Pattern: "(foo"}
+	require.EqualError(t, s.Validate(), "invalid pattern value: error parsing regexp: missing closing ): `(foo`")
+}
@@ -0,0 +1,156 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/alertmanager"
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	AlertmanagerDelayCheckName    = "alerts/notification_delay"
+	AlertmanagerDelayCheckDetails = `Prometheus only sends an alert to Alertmanager after it was firing for the whole ` + "`for`" + ` duration.
+Alertmanager will then wait for [group_wait](https://prometheus.io/docs/alerting/latest/configuration/#route) before sending the first notification for a new group of alerts.
+Together these two values give the minimal delay between the problem starting and the notification being sent.`
+)
+
+type AlertmanagerDelaySettings struct {
+	URI      string `hcl:"uri" json:"uri"`
+	Timeout  string `hcl:"timeout,optional" json:"timeout,omitempty"`
+	MaxDelay string `hcl:"maxDelay" json:"maxDelay"`
+	timeout  time.Duration
+	maxDelay time.Duration
+}
+
+func (s *AlertmanagerDelaySettings) Validate() error {
+	if s.URI == "" {
+		return errors.New("uri cannot be empty")
+	}
+	s.timeout = defaultRoutingTimeout
+	if s.Timeout != "" {
+		dur, err := model.ParseDuration(s.Timeout)
+		if err != nil {
+			return err
+		}
+		s.timeout = time.Duration(dur)
+	}
+	dur, err := model.ParseDuration(s.MaxDelay)
+	if err != nil {
+		return fmt.Errorf("invalid maxDelay value: %w", err)
+	}
+	if dur <= 0 {
+		return errors.New("maxDelay must be greater than zero")
+	}
+	s.maxDelay = time.Duration(dur)
+	return nil
+}
+
+func (s *AlertmanagerDelaySettings) RequestTimeout() time.Duration {
+	return s.timeout
+}
+
+func (s *AlertmanagerDelaySettings) Limit() time.Duration {
+	return s.maxDelay
+}
+
+func NewAlertmanagerDelayCheck(am *alertmanager.Client, maxDelay time.Duration) AlertmanagerDelayCheck {
+	return AlertmanagerDelayCheck{am: am, maxDelay: maxDelay}
+}
+
+type AlertmanagerDelayCheck struct {
+	am       *alertmanager.Client
+	maxDelay time.Duration
+}
+
+func (c AlertmanagerDelayCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c AlertmanagerDelayCheck) String() string {
+	return fmt.Sprintf("%s(%s)", AlertmanagerDelayCheckName, c.am.URI())
+}
+
+func (c AlertmanagerDelayCheck) Reporter() string {
+	return AlertmanagerDelayCheckName
+}
+
+func (c AlertmanagerDelayCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil {
+		return problems
+	}
+
+	root, err := c.am.Routes(ctx)
+	if err != nil {
+		problems = append(problems, Problem{
+			Lines:    rule.AlertingRule.Alert.Lines,
+			Reporter: c.Reporter(),
+			Text:     fmt.Sprintf("Couldn't run %q checks due to Alertmanager at %s error: `%s`.", c.Reporter(), c.am.URI(), err),
+			Severity: Warning,
+		})
+		return problems
+	}
+
+	lset := map[string]string{model.AlertNameLabel: rule.AlertingRule.Alert.Value}
+	if rule.AlertingRule.Labels != nil {
+		for _, l := range rule.AlertingRule.Labels.Items {
+			lset[l.Key.Value] = l.Value.Value
+		}
+	}
+
+	forDur := time.Duration(alertForDuration(rule.AlertingRule))
+	var receivers []string
+	var groupWait time.Duration
+	for _, route := range root.MatchingRoutes(lset) {
+		if forDur+route.GroupWaitDuration() <= c.maxDelay {
+			continue
+		}
+		if route.GroupWaitDuration() > groupWait {
+			groupWait = route.GroupWaitDuration()
+		}
+		receivers = append(receivers, "`"+route.Receiver+"`")
+	}
+	if len(receivers) == 0 {
+		return problems
+	}
+
+	lines := rule.AlertingRule.Alert.Lines
+	if rule.AlertingRule.For != nil {
+		lines = rule.AlertingRule.For.Lines
+	}
+
+	var parts []string
+	if forDur > 0 {
+		parts = append(parts, fmt.Sprintf("`%s` for", output.HumanizeDuration(forDur)))
+	}
+	if groupWait > 0 {
+		parts = append(parts, fmt.Sprintf("`%s` group_wait", output.HumanizeDuration(groupWait)))
+	}
+
+	problems = append(problems, Problem{
+		Lines:    lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("Notifications for this alert will be sent at least `%s` after the problem started (%s for %s receiver on Alertmanager at %s), which is more than the maximum allowed delay of `%s`.",
+			output.HumanizeDuration(forDur+groupWait), strings.Join(parts, " plus "),
+			strings.Join(receivers, ", "), c.am.URI(), output.HumanizeDuration(c.maxDelay)),
+		Details:  AlertmanagerDelayCheckDetails,
+		Severity: Information,
+	})
+
+	return problems
+}
@@ -0,0 +1,174 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/pint/internal/alertmanager"
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAlertmanagerDelayCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAlertmanagerDelayCheck(alertmanager.NewClient(prom.PrimaryURI(), time.Second), time.Minute*15)
+}
+
+func alertmanagerDelayText(total, parts, receivers, uri string) string {
+	return fmt.Sprintf("Notifications for this alert will be sent at least `%s` after the problem started (%s for %s receiver on Alertmanager at %s), which is more than the maximum allowed delay of `15m`.",
+		total, parts, receivers, uri)
+}
+
+const alertmanagerDelayRoutes = `
+route:
+  receiver: default
+  group_wait: 1m
+  routes:
+  - receiver: team-a
+    group_wait: 20m
+    match:
+      team: a
+  - receiver: team-b
+    continue: true
+    match:
+      team: b
+  - receiver: team-c
+    group_wait: 30m
+    match:
+      team: c
+  - receiver: catch-all
+    group_wait: 5m
+    match:
+      team: b
+`
+
+func TestAlertmanagerDelayCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newAlertmanagerDelayCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Alertmanager API",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newAlertmanagerDelayCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.AlertmanagerDelayCheckName,
+						Text:     fmt.Sprintf("Couldn't run %q checks due to Alertmanager at %s error: `failed to query Alertmanager status: 500 Internal Server Error`.", checks.AlertmanagerDelayCheckName, uri),
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "delay below the limit",
+			content:     "- alert: foo\n  expr: up == 0\n  for: 10m\n  labels:\n    team: b\n",
+			checker:     newAlertmanagerDelayCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: alertmanagerDelayRoutes},
+				},
+			},
+		},
+		{
+			description: "group_wait over the limit",
+			content:     "- alert: foo\n  expr: up == 0\n  labels:\n    team: a\n",
+			checker:     newAlertmanagerDelayCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.AlertmanagerDelayCheckName,
+						Text:     alertmanagerDelayText("20m", "`20m` group_wait", "`team-a`", uri),
+						Details:  checks.AlertmanagerDelayCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: alertmanagerDelayRoutes},
+				},
+			},
+		},
+		{
+			description: "for and group_wait over the limit",
+			content:     "- alert: foo\n  expr: up == 0\n  for: 15m\n  labels:\n    team: b\n",
+			checker:     newAlertmanagerDelayCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.AlertmanagerDelayCheckName,
+						Text:     alertmanagerDelayText("20m", "`15m` for plus `5m` group_wait", "`team-b`, `catch-all`", uri),
+						Details:  checks.AlertmanagerDelayCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: alertmanagerDelayRoutes},
+				},
+			},
+		},
+		{
+			description: "unknown label value",
+			content:     "- alert: foo\n  expr: up{team=\"c\"} == 0\n  for: 1m\n",
+			checker:     newAlertmanagerDelayCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.AlertmanagerDelayCheckName,
+						Text:     alertmanagerDelayText("21m", "`1m` for plus `20m` group_wait", "`team-a`", uri),
+						Details:  checks.AlertmanagerDelayCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: alertmanagerDelayRoutes},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
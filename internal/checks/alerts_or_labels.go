@@ -0,0 +1,130 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	OrLabelMismatchCheckName    = "alerts/or_labels"
+	OrLabelMismatchCheckDetails = `Alerts created from a query using ` + "`or`" + ` will have labels of whichever side of the query returned the time series.
+When both sides have different labels then alerts will have different labels depending on which condition triggered them, which can break Alertmanager routing, grouping and inhibition rules that rely on those labels.`
+)
+
+func NewOrLabelMismatchCheck(prom *promapi.FailoverGroup) OrLabelMismatchCheck {
+	return OrLabelMismatchCheck{prom: prom}
+}
+
+type OrLabelMismatchCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c OrLabelMismatchCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c OrLabelMismatchCheck) String() string {
+	return fmt.Sprintf("%s(%s)", OrLabelMismatchCheckName, c.prom.Name())
+}
+
+func (c OrLabelMismatchCheck) Reporter() string {
+	return OrLabelMismatchCheckName
+}
+
+func (c OrLabelMismatchCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	expr := rule.AlertingRule.Expr.Query.Expr
+	for {
+		pe, ok := expr.(*promParser.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = pe.Expr
+	}
+	be, ok := expr.(*promParser.BinaryExpr)
+	if !ok || be.Op != promParser.LOR {
+		return problems
+	}
+
+	var sides [2][]string
+	var uri string
+	for i, side := range []promParser.Expr{be.LHS, be.RHS} {
+		lsets, qURI, err := querySeriesLabels(ctx, c.prom, utils.RemoveConditions(side.String()).String(), model.MetricNameLabel)
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+			problems = append(problems, Problem{
+				Lines:    rule.AlertingRule.Expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			return problems
+		}
+		// No results means that there are no time series at all, other checks will report that.
+		if lsets == nil {
+			return problems
+		}
+		uri = qURI
+		for _, ls := range lsets {
+			for _, name := range ls.names {
+				if !slices.Contains(sides[i], name) {
+					sides[i] = append(sides[i], name)
+				}
+			}
+		}
+		slices.Sort(sides[i])
+	}
+
+	var diffs []string
+	if onlyLeft := missingLabels(sides[0], sides[1]); len(onlyLeft) > 0 {
+		diffs = append(diffs, fmt.Sprintf("the left hand side has %s label(s) not present on the right hand side", quoteLabelNames(onlyLeft)))
+	}
+	if onlyRight := missingLabels(sides[1], sides[0]); len(onlyRight) > 0 {
+		diffs = append(diffs, fmt.Sprintf("the right hand side has %s label(s) not present on the left hand side", quoteLabelNames(onlyRight)))
+	}
+	if len(diffs) == 0 {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.Expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("Alerts from this query will have different labels depending on which side of `or` returned them, %s on %s.",
+			strings.Join(diffs, " and "), promText(c.prom.Name(), uri)),
+		Details:  OrLabelMismatchCheckDetails,
+		Severity: Information,
+	})
+
+	return problems
+}
+
+// missingLabels returns all names from a that are not present in b.
+func missingLabels(a, b []string) (missing []string) {
+	for _, name := range a {
+		if !slices.Contains(b, name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
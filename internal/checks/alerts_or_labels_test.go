@@ -0,0 +1,221 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newOrLabelMismatchCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewOrLabelMismatchCheck(prom)
+}
+
+func orLabelsText(name, uri, diff string) string {
+	return fmt.Sprintf("Alerts from this query will have different labels depending on which side of `or` returned them, %s on `%s` Prometheus server at %s.",
+		diff, name, uri)
+}
+
+func TestOrLabelMismatchCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: foo or bar\n",
+			checker:     newOrLabelMismatchCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: foo or\n",
+			checker:     newOrLabelMismatchCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores nested or",
+			content:     "- alert: foo\n  expr: sum(foo or bar) > 0\n",
+			checker:     newOrLabelMismatchCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- alert: foo\n  expr: (foo > 0) or (bar > 0)\n",
+			checker:     newOrLabelMismatchCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.OrLabelMismatchCheckName,
+						Text:     checkErrorUnableToRun(checks.OrLabelMismatchCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no results on one side",
+			content:     "- alert: foo\n  expr: (foo > 0) or (bar > 0)\n",
+			checker:     newOrLabelMismatchCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(foo) without(__name__)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "a"}),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(bar) without(__name__)"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+		{
+			description: "same labels",
+			content:     "- alert: foo\n  expr: (foo > 0) or (bar > 0)\n",
+			checker:     newOrLabelMismatchCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(foo) without(__name__)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "a", "instance": "1"}),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(bar) without(__name__)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"instance": "2", "job": "b"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "different labels",
+			content:     "- alert: foo\n  expr: (foo > 0) or (bar > 0)\n",
+			checker:     newOrLabelMismatchCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.OrLabelMismatchCheckName,
+						Text:     orLabelsText("prom", uri, "the left hand side has `instance`, `pod` label(s) not present on the right hand side and the right hand side has `cluster` label(s) not present on the left hand side"),
+						Details:  checks.OrLabelMismatchCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(foo) without(__name__)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "a", "instance": "1"}),
+							generateSample(map[string]string{"job": "a", "pod": "1"}),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(bar) without(__name__)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "b", "cluster": "c"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "extra labels on the right hand side",
+			content:     "- alert: foo\n  expr: up == 0 or absent(up)\n",
+			checker:     newOrLabelMismatchCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.OrLabelMismatchCheckName,
+						Text:     orLabelsText("prom", uri, "the left hand side has `instance`, `job` label(s) not present on the right hand side"),
+						Details:  checks.OrLabelMismatchCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(up) without(__name__)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "a", "instance": "1"}),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(absent(up)) without(__name__)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,121 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/alertmanager"
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	RoutingCheckName    = "alerts/routing"
+	RoutingCheckDetails = `Alertmanager sends alerts that don't match any [route](https://prometheus.io/docs/alerting/latest/configuration/#route) to the receiver configured on the top level route.
+This is usually a catch-all receiver that nobody is watching closely, double check the labels set on this alert.`
+
+	defaultRoutingTimeout = time.Minute * 2
+)
+
+type RoutingSettings struct {
+	URI     string `hcl:"uri" json:"uri"`
+	Timeout string `hcl:"timeout,optional" json:"timeout,omitempty"`
+	timeout time.Duration
+}
+
+func (s *RoutingSettings) Validate() error {
+	if s.URI == "" {
+		return errors.New("uri cannot be empty")
+	}
+	s.timeout = defaultRoutingTimeout
+	if s.Timeout != "" {
+		dur, err := model.ParseDuration(s.Timeout)
+		if err != nil {
+			return err
+		}
+		s.timeout = time.Duration(dur)
+	}
+	return nil
+}
+
+func (s *RoutingSettings) RequestTimeout() time.Duration {
+	return s.timeout
+}
+
+func NewRoutingCheck(am *alertmanager.Client) RoutingCheck {
+	return RoutingCheck{am: am}
+}
+
+type RoutingCheck struct {
+	am *alertmanager.Client
+}
+
+func (c RoutingCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c RoutingCheck) String() string {
+	return fmt.Sprintf("%s(%s)", RoutingCheckName, c.am.URI())
+}
+
+func (c RoutingCheck) Reporter() string {
+	return RoutingCheckName
+}
+
+func (c RoutingCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil {
+		return problems
+	}
+
+	root, err := c.am.Routes(ctx)
+	if err != nil {
+		problems = append(problems, Problem{
+			Lines:    rule.AlertingRule.Alert.Lines,
+			Reporter: c.Reporter(),
+			Text:     fmt.Sprintf("Couldn't run %q checks due to Alertmanager at %s error: `%s`.", c.Reporter(), c.am.URI(), err),
+			Severity: Warning,
+		})
+		return problems
+	}
+
+	// All alerts are meant to go to the default receiver.
+	if len(root.Routes) == 0 {
+		return problems
+	}
+
+	lset := map[string]string{model.AlertNameLabel: rule.AlertingRule.Alert.Value}
+	if rule.AlertingRule.Labels != nil {
+		for _, l := range rule.AlertingRule.Labels.Items {
+			lset[l.Key.Value] = l.Value.Value
+		}
+	}
+
+	for _, route := range root.Routes {
+		if route.MightMatch(lset) {
+			return problems
+		}
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.Alert.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("This alert doesn't match any route on Alertmanager at %s and will be sent to the default `%s` receiver.",
+			c.am.URI(), root.Receiver),
+		Details:  RoutingCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
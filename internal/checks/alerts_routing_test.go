@@ -0,0 +1,176 @@
+package checks_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/pint/internal/alertmanager"
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newRoutingCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewRoutingCheck(alertmanager.NewClient(prom.PrimaryURI(), time.Second))
+}
+
+func routingText(uri, receiver string) string {
+	return fmt.Sprintf("This alert doesn't match any route on Alertmanager at %s and will be sent to the default `%s` receiver.", uri, receiver)
+}
+
+var requireAlertmanagerStatusPath = requestPathCond{path: "/api/v2/status"}
+
+type alertmanagerConfigResponse struct {
+	config string
+}
+
+func (ar alertmanagerConfigResponse) respond(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(200)
+	w.Header().Set("Content-Type", "application/json")
+	var result struct {
+		Config struct {
+			Original string `json:"original"`
+		} `json:"config"`
+	}
+	result.Config.Original = ar.config
+	d, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	_, _ = w.Write(d)
+}
+
+const alertmanagerRoutes = `
+route:
+  receiver: default
+  routes:
+  - receiver: team-a
+    match:
+      team: a
+  - receiver: team-b
+    matchers:
+    - team="b"
+    - severity=~"critical|warning"
+  - receiver: watchdog
+    matchers:
+    - alertname = Watchdog
+`
+
+func TestRoutingCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newRoutingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Alertmanager API",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newRoutingCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.RoutingCheckName,
+						Text:     fmt.Sprintf("Couldn't run %q checks due to Alertmanager at %s error: `failed to query Alertmanager status: 500 Internal Server Error`.", checks.RoutingCheckName, uri),
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no child routes",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newRoutingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: "route:\n  receiver: default\n"},
+				},
+			},
+		},
+		{
+			description: "matches route using match",
+			content:     "- alert: foo\n  expr: up == 0\n  labels:\n    team: a\n",
+			checker:     newRoutingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: alertmanagerRoutes},
+				},
+			},
+		},
+		{
+			description: "matches route using alertname",
+			content:     "- alert: Watchdog\n  expr: vector(1)\n",
+			checker:     newRoutingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: alertmanagerRoutes},
+				},
+			},
+		},
+		{
+			description: "might match route using labels from the query",
+			content:     "- alert: foo\n  expr: up == 0\n  labels:\n    severity: critical\n",
+			checker:     newRoutingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: alertmanagerRoutes},
+				},
+			},
+		},
+		{
+			description: "doesn't match any route",
+			content:     "- alert: foo\n  expr: up == 0\n  labels:\n    team: c\n    severity: info\n",
+			checker:     newRoutingCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.RoutingCheckName,
+						Text:     routingText(uri, "default"),
+						Details:  checks.RoutingCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireAlertmanagerStatusPath},
+					resp:  alertmanagerConfigResponse{config: alertmanagerRoutes},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -66,6 +66,26 @@ func TestTemplateCheck(t *testing.T) {
 				}
 			},
 		},
+		{
+			description: "unclosed action in annotations",
+			content:     "- alert: Foo Is Down\n  expr: up{job=\"foo\"} == 0\n  annotations:\n    summary: 'Instance {{ $labels.instance'\n",
+			checker:     newTemplateCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.TemplateCheckName,
+						Text:     "Template failed to parse with this error: `unclosed action`.",
+						Details:  checks.TemplateCheckSyntaxDetails,
+						Severity: checks.Fatal,
+					},
+				}
+			},
+		},
 		{
 			description: "valid syntax in annotations",
 			content:     "- alert: Foo Is Down\n  expr: up{job=\"foo\"} == 0\n  annotations:\n    summary: 'Instance {{ $labels.instance }} down'\n",
@@ -0,0 +1,119 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	ThresholdRecordingRuleCheckName    = "alerts/threshold"
+	ThresholdRecordingRuleCheckDetails = `Multiple alerting rules are comparing the result of the same query against a threshold, so Prometheus will evaluate this query once for every alert.
+Consider adding a [recording rule](https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/) for this query and using the recorded metric in all alerts instead.`
+)
+
+func NewThresholdRecordingRuleCheck() ThresholdRecordingRuleCheck {
+	return ThresholdRecordingRuleCheck{}
+}
+
+type ThresholdRecordingRuleCheck struct{}
+
+func (c ThresholdRecordingRuleCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c ThresholdRecordingRuleCheck) String() string {
+	return ThresholdRecordingRuleCheckName
+}
+
+func (c ThresholdRecordingRuleCheck) Reporter() string {
+	return ThresholdRecordingRuleCheckName
+}
+
+func (c ThresholdRecordingRuleCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	query, others := thresholdAlerts(path, rule, entries)
+	if len(others) == 0 {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.Expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s` query is compared against a threshold in %d other alert(s): `%s`, consider using a recording rule for it.",
+			query, len(others), strings.Join(others, "`, `")),
+		Details:  ThresholdRecordingRuleCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
+
+// thresholdAlerts returns the query compared against a threshold by given
+// alerting rule and sorted names of all other alerts comparing the same query.
+func thresholdAlerts(path discovery.Path, rule parser.Rule, entries []discovery.Entry) (query string, others []string) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return "", nil
+	}
+
+	query, ok := thresholdQuery(rule.AlertingRule.Expr.Query.Expr)
+	if !ok {
+		return "", nil
+	}
+
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if entry.Rule.AlertingRule == nil || entry.Rule.AlertingRule.Expr.SyntaxError != nil {
+			continue
+		}
+		if entry.Path.Name == path.Name && entry.Rule.Lines.First == rule.Lines.First {
+			continue
+		}
+		if q, ok := thresholdQuery(entry.Rule.AlertingRule.Expr.Query.Expr); ok && q == query {
+			if !slices.Contains(others, entry.Rule.AlertingRule.Alert.Value) {
+				others = append(others, entry.Rule.AlertingRule.Alert.Value)
+			}
+		}
+	}
+	slices.Sort(others)
+
+	return query, others
+}
+
+// thresholdQuery returns the query from alerts with `query <op> number` or
+// `number <op> query` expressions, if that query is more than a single selector.
+func thresholdQuery(node promParser.Node) (string, bool) {
+	be, ok := unwrapParens(node).(*promParser.BinaryExpr)
+	if !ok || !be.Op.IsComparisonOperator() || be.ReturnBool {
+		return "", false
+	}
+
+	lhs, rhs := unwrapParens(be.LHS), unwrapParens(be.RHS)
+	if _, ok := rhs.(*promParser.NumberLiteral); !ok {
+		if _, ok := lhs.(*promParser.NumberLiteral); !ok {
+			return "", false
+		}
+		lhs = rhs
+	}
+
+	switch lhs.(type) {
+	case *promParser.NumberLiteral, *promParser.VectorSelector:
+		return "", false
+	}
+	return lhs.String(), true
+}
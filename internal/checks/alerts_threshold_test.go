@@ -0,0 +1,122 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newThresholdRecordingRuleCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewThresholdRecordingRuleCheck()
+}
+
+func TestThresholdRecordingRuleCheck(t *testing.T) {
+	content := "- alert: ErrorRateHigh\n  expr: sum(rate(errors[5m])) / sum(rate(requests[5m])) > 0.05\n"
+
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: sum(rate(errors[5m])) / sum(rate(requests[5m])) > 0.05\n",
+			checker:     newThresholdRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(`
+- alert: other
+  expr: sum(rate(errors[5m])) / sum(rate(requests[5m])) > 0.1
+`),
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: sum(foo) without(\n",
+			checker:     newThresholdRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores self",
+			content:     content,
+			checker:     newThresholdRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent(content),
+		},
+		{
+			description: "ignores different queries",
+			content:     content,
+			checker:     newThresholdRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(`
+- alert: other
+  expr: sum(rate(errors[10m])) / sum(rate(requests[10m])) > 0.05
+- record: foo
+  expr: sum(rate(errors[5m])) / sum(rate(requests[5m])) > 0.05
+`),
+		},
+		{
+			description: "ignores plain selectors",
+			content:     "- alert: foo\n  expr: errors:ratio5m > 0.05\n",
+			checker:     newThresholdRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(`
+- alert: other
+  expr: errors:ratio5m > 0.1
+`),
+		},
+		{
+			description: "ignores comparisons without a number",
+			content:     "- alert: foo\n  expr: sum(errors) > sum(limit)\n",
+			checker:     newThresholdRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(`
+- alert: other
+  expr: sum(errors) > sum(limit)
+`),
+		},
+		{
+			description: "ignores bool comparisons",
+			content:     "- alert: foo\n  expr: sum(errors) > bool 5\n",
+			checker:     newThresholdRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(`
+- alert: other
+  expr: sum(errors) > bool 10
+`),
+		},
+		{
+			description: "same query used in other alerts",
+			content:     content,
+			checker:     newThresholdRecordingRuleCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ThresholdRecordingRuleCheckName,
+						Text:     "`sum(rate(errors[5m])) / sum(rate(requests[5m]))` query is compared against a threshold in 2 other alert(s): `ErrorRateCritical`, `ErrorRateLow`, consider using a recording rule for it.",
+						Details:  checks.ThresholdRecordingRuleCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: mustParseContent(`
+- alert: ErrorRateLow
+  expr: 0.01 < (sum(rate(errors[5m])) / sum(rate(requests[5m])))
+- alert: ErrorRateCritical
+  expr: sum(rate(errors[5m]))/sum(rate(requests[5m])) > 0.5
+- alert: ErrorRateCritical
+  expr: sum(rate(errors[5m])) / sum(rate(requests[5m])) > 0.9
+`),
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,107 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	TwoPhaseAlertCheckName    = "alerts/two_phase"
+	TwoPhaseAlertCheckDetails = `Alerting rules are evaluated as a single query, there's no separate recovery condition.
+When an alert query combines conditions on different metrics using ` + "`and` or `unless`" + ` then the alert will resolve as soon as the combined query stops returning results.
+This means that the alert can resolve because of a change to the secondary metric, even if the primary condition that triggered it is still true.`
+)
+
+func NewTwoPhaseAlertCheck() TwoPhaseAlertCheck {
+	return TwoPhaseAlertCheck{}
+}
+
+type TwoPhaseAlertCheck struct{}
+
+func (c TwoPhaseAlertCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c TwoPhaseAlertCheck) String() string {
+	return TwoPhaseAlertCheckName
+}
+
+func (c TwoPhaseAlertCheck) Reporter() string {
+	return TwoPhaseAlertCheckName
+}
+
+func (c TwoPhaseAlertCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	node := unwrapParenNode(rule.AlertingRule.Expr.Query)
+	be, ok := node.Expr.(*promParser.BinaryExpr)
+	if !ok || (be.Op != promParser.LAND && be.Op != promParser.LUNLESS) || len(node.Children) != 2 {
+		return problems
+	}
+
+	lhs := metricNames(node.Children[0])
+	rhs := metricNames(node.Children[1])
+	if len(lhs) == 0 || len(rhs) == 0 {
+		return problems
+	}
+	for _, name := range rhs {
+		if slices.Contains(lhs, name) {
+			return problems
+		}
+	}
+
+	var behaviour string
+	switch be.Op {
+	case promParser.LAND:
+		behaviour = "this alert will resolve as soon as the right hand side stops returning results"
+	case promParser.LUNLESS:
+		behaviour = "this alert will resolve as soon as the right hand side starts returning results"
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.Expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("Alert query is using `%s` to combine conditions on `%s` with conditions on `%s`, %s, even if the condition on `%s` is still true.",
+			be.Op, strings.Join(lhs, "`, `"), strings.Join(rhs, "`, `"), behaviour, strings.Join(lhs, "`, `")),
+		Details:  TwoPhaseAlertCheckDetails,
+		Severity: Information,
+	})
+
+	return problems
+}
+
+func unwrapParenNode(node *parser.PromQLNode) *parser.PromQLNode {
+	for {
+		if _, ok := node.Expr.(*promParser.ParenExpr); !ok || len(node.Children) != 1 {
+			return node
+		}
+		node = node.Children[0]
+	}
+}
+
+func metricNames(node *parser.PromQLNode) (names []string) {
+	for _, vs := range parser.WalkDownExpr[*promParser.VectorSelector](node) {
+		name := selectorMetricName(vs.Expr.(*promParser.VectorSelector))
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
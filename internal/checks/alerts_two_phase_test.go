@@ -0,0 +1,101 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newTwoPhaseAlertCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewTwoPhaseAlertCheck()
+}
+
+func TestTwoPhaseAlertCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: foo > 10 and bar == 0\n",
+			checker:     newTwoPhaseAlertCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores syntax errors",
+			content:     "- alert: foo\n  expr: foo > 10 and bar == 0 without(\n",
+			checker:     newTwoPhaseAlertCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores simple alerts",
+			content:     "- alert: foo\n  expr: foo > 10\n",
+			checker:     newTwoPhaseAlertCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores other binary operators",
+			content:     "- alert: foo\n  expr: (foo > 10) * on() (bar == 0)\n",
+			checker:     newTwoPhaseAlertCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores conditions on the same metric",
+			content:     "- alert: foo\n  expr: foo > 10 and foo < 100\n",
+			checker:     newTwoPhaseAlertCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores conditions without metrics",
+			content:     "- alert: foo\n  expr: foo > 10 and on() hour() > 8\n",
+			checker:     newTwoPhaseAlertCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "and with different metrics",
+			content:     "- alert: foo\n  expr: (foo > 10) and on(instance) (bar == 0)\n",
+			checker:     newTwoPhaseAlertCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.TwoPhaseAlertCheckName,
+						Text:     "Alert query is using `and` to combine conditions on `foo` with conditions on `bar`, this alert will resolve as soon as the right hand side stops returning results, even if the condition on `foo` is still true.",
+						Details:  checks.TwoPhaseAlertCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "unless with different metrics",
+			content:     "- alert: foo\n  expr: ((rate(errors_total[5m]) > 1) unless (maintenance == 1 or deploying == 1))\n",
+			checker:     newTwoPhaseAlertCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.TwoPhaseAlertCheckName,
+						Text:     "Alert query is using `unless` to combine conditions on `errors_total` with conditions on `maintenance`, `deploying`, this alert will resolve as soon as the right hand side starts returning results, even if the condition on `errors_total` is still true.",
+						Details:  checks.TwoPhaseAlertCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,106 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	VectorLiteralCheckName    = "alerts/vector_literal"
+	VectorLiteralCheckDetails = `[vector()](https://prometheus.io/docs/prometheus/latest/querying/functions/#vector) returns a single time series without any labels.
+An alerting rule that doesn't query any time series and only uses ` + "`vector()`" + ` will either fire all the time or never fire, which is usually a leftover placeholder.
+When ` + "`vector()`" + ` is used in a binary operation it will only match time series without any labels, so if you want to compare against a constant value use a number instead.`
+)
+
+func NewVectorLiteralCheck() VectorLiteralCheck {
+	return VectorLiteralCheck{}
+}
+
+type VectorLiteralCheck struct{}
+
+func (c VectorLiteralCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c VectorLiteralCheck) String() string {
+	return VectorLiteralCheckName
+}
+
+func (c VectorLiteralCheck) Reporter() string {
+	return VectorLiteralCheckName
+}
+
+func (c VectorLiteralCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	expr := rule.AlertingRule.Expr
+	calls := parser.WalkDownExpr[*promParser.Call](expr.Query)
+
+	var vectorCall *promParser.Call
+	for _, node := range calls {
+		if call := node.Expr.(*promParser.Call); call.Func.Name == "vector" {
+			vectorCall = call
+			break
+		}
+	}
+	if vectorCall == nil {
+		return problems
+	}
+
+	if len(parser.WalkDownExpr[*promParser.VectorSelector](expr.Query)) == 0 {
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("This alert is using `%s` and doesn't query any time series, so it will either always fire or never fire.",
+				vectorCall),
+			Details:  VectorLiteralCheckDetails,
+			Severity: Bug,
+		})
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.BinaryExpr](expr.Query) {
+		be := node.Expr.(*promParser.BinaryExpr)
+		if be.Op.IsSetOperator() {
+			continue
+		}
+		if be.VectorMatching != nil && be.VectorMatching.On && len(be.VectorMatching.MatchingLabels) == 0 {
+			continue
+		}
+		for _, side := range []promParser.Node{be.LHS, be.RHS} {
+			call, ok := unwrapParens(side).(*promParser.Call)
+			if !ok || call.Func.Name != "vector" {
+				continue
+			}
+			n, ok := unwrapParens(call.Args[0]).(*promParser.NumberLiteral)
+			if !ok {
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` is only used for its value in `%s`, it will only match time series without any labels, use `%s` instead.",
+					call, be, n),
+				Details:  VectorLiteralCheckDetails,
+				Severity: Warning,
+			})
+		}
+	}
+
+	return problems
+}
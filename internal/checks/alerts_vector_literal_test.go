@@ -0,0 +1,142 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newVectorLiteralCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewVectorLiteralCheck()
+}
+
+func TestVectorLiteralCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: vector(1)\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: vector(1) >\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts without vector()",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores vector() used with or",
+			content:     "- alert: foo\n  expr: (sum(errors) or vector(0)) > 0\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores vector() with on()",
+			content:     "- alert: foo\n  expr: errors > on() group_left vector(5)\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores vector() with non-literal value",
+			content:     "- alert: foo\n  expr: sum(errors) > vector(scalar(sum(limit)))\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "vector(1)",
+			content:     "- alert: foo\n  expr: vector(1)\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.VectorLiteralCheckName,
+						Text:     "This alert is using `vector(1)` and doesn't query any time series, so it will either always fire or never fire.",
+						Details:  checks.VectorLiteralCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "vector(1) > 0",
+			content:     "- alert: foo\n  expr: (vector(1)) > 0\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.VectorLiteralCheckName,
+						Text:     "This alert is using `vector(1)` and doesn't query any time series, so it will either always fire or never fire.",
+						Details:  checks.VectorLiteralCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "vector() in a comparison",
+			content:     "- alert: foo\n  expr: sum(errors) > vector(5)\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.VectorLiteralCheckName,
+						Text:     "`vector(5)` is only used for its value in `sum(errors) > vector(5)`, it will only match time series without any labels, use `5` instead.",
+						Details:  checks.VectorLiteralCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "vector() in arithmetic",
+			content:     "- alert: foo\n  expr: (vector(100) - errors) < 10\n",
+			checker:     newVectorLiteralCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.VectorLiteralCheckName,
+						Text:     "`vector(100)` is only used for its value in `vector(100) - errors`, it will only match time series without any labels, use `100` instead.",
+						Details:  checks.VectorLiteralCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,91 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	WindowForAlignmentCheckName    = "alerts/window_for_alignment"
+	WindowForAlignmentCheckDetails = `Functions like [rate()](https://prometheus.io/docs/prometheus/latest/querying/functions/#rate) or [increase()](https://prometheus.io/docs/prometheus/latest/querying/functions/#increase) calculate values using all samples in the range window.
+A sudden change will only have a full effect on the result once it's present for most of that window, and ` + "`for`" + ` makes Prometheus wait even longer before firing the alert.
+When the range window is longer than ` + "`for`" + ` then it's the window size that mostly decides how long it takes for the alert to fire.`
+)
+
+func NewWindowForAlignmentCheck() WindowForAlignmentCheck {
+	return WindowForAlignmentCheck{}
+}
+
+type WindowForAlignmentCheck struct{}
+
+func (c WindowForAlignmentCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c WindowForAlignmentCheck) String() string {
+	return WindowForAlignmentCheckName
+}
+
+func (c WindowForAlignmentCheck) Reporter() string {
+	return WindowForAlignmentCheckName
+}
+
+func (c WindowForAlignmentCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.AlertingRule == nil || rule.AlertingRule.For == nil || rule.AlertingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	forDur, err := model.ParseDuration(rule.AlertingRule.For.Value)
+	if err != nil || forDur <= 0 {
+		return problems
+	}
+
+	window := maxRangeWindow(rule.AlertingRule.Expr.Query)
+	if window <= time.Duration(forDur) {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.AlertingRule.Expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("The longest range window in this query is `%s` and the alert has `for: %s`, so the effective detection latency can be up to %s, which is more than `for` alone suggests.",
+			output.HumanizeDuration(window), rule.AlertingRule.For.Value, output.HumanizeDuration(window+time.Duration(forDur))),
+		Details:  WindowForAlignmentCheckDetails,
+		Severity: Information,
+	})
+
+	return problems
+}
+
+// maxRangeWindow returns the longest range window used in given query.
+func maxRangeWindow(node *parser.PromQLNode) (window time.Duration) {
+	for _, n := range parser.WalkDownExpr[promParser.Node](node) {
+		var r time.Duration
+		switch e := n.Expr.(type) {
+		case *promParser.MatrixSelector:
+			r = e.Range
+		case *promParser.SubqueryExpr:
+			r = e.Range
+		}
+		if r > window {
+			window = r
+		}
+	}
+	return window
+}
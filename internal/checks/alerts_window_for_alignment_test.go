@@ -0,0 +1,102 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newWindowForAlignmentCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewWindowForAlignmentCheck()
+}
+
+func TestWindowForAlignmentCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores recording rules",
+			content:     "- record: foo\n  expr: rate(foo[1h])\n",
+			checker:     newWindowForAlignmentCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: rate(foo[1h]) >\n  for: 5m\n",
+			checker:     newWindowForAlignmentCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts without for",
+			content:     "- alert: foo\n  expr: rate(foo[1h]) > 100\n",
+			checker:     newWindowForAlignmentCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerts with for: 0",
+			content:     "- alert: foo\n  expr: rate(foo[1h]) > 100\n  for: 0s\n",
+			checker:     newWindowForAlignmentCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores window shorter than for",
+			content:     "- alert: foo\n  expr: rate(foo[5m]) > 100\n  for: 10m\n",
+			checker:     newWindowForAlignmentCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores window equal to for",
+			content:     "- alert: foo\n  expr: rate(foo[10m]) > 100\n  for: 10m\n",
+			checker:     newWindowForAlignmentCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "window longer than for",
+			content:     "- alert: foo\n  expr: rate(foo[1h]) > 100\n  for: 5m\n",
+			checker:     newWindowForAlignmentCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.WindowForAlignmentCheckName,
+						Text:     "The longest range window in this query is `1h` and the alert has `for: 5m`, so the effective detection latency can be up to 1h5m, which is more than `for` alone suggests.",
+						Details:  checks.WindowForAlignmentCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "multiple windows",
+			content:     "- alert: foo\n  expr: rate(foo[5m]) / max_over_time(rate(bar[5m])[2h:1m]) > 2\n  for: 15m\n",
+			checker:     newWindowForAlignmentCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.WindowForAlignmentCheckName,
+						Text:     "The longest range window in this query is `2h` and the alert has `for: 15m`, so the effective detection latency can be up to 2h15m, which is more than `for` alone suggests.",
+						Details:  checks.WindowForAlignmentCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
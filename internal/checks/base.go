@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
 	"github.com/cloudflare/pint/internal/discovery"
 	"github.com/cloudflare/pint/internal/parser"
 	"github.com/cloudflare/pint/internal/promapi"
@@ -16,37 +18,152 @@ var (
 		AlertsCheckName,
 		AlertsExternalLabelsCheckName,
 		AlertForCheckName,
+		AlertsForFormatCheckName,
+		ForDurationOrderCheckName,
+		InhibitionCheckName,
+		ThresholdRecordingRuleCheckName,
+		TwoPhaseAlertCheckName,
 		TemplateCheckName,
 		LabelsConflictCheckName,
+		LabelsConsistencyCheckName,
 		AggregationCheckName,
 		ComparisonCheckName,
 		FragileCheckName,
+		PrometheusInternalMetricCheckName,
 		RangeQueryCheckName,
 		RateCheckName,
 		RegexpCheckName,
+		ScalarComparisonCheckName,
+		SortCheckName,
+		TimestampCheckName,
 		SyntaxCheckName,
 		VectorMatchingCheckName,
 		CostCheckName,
 		CounterCheckName,
+		DerivCheckName,
+		SumOverTimeCheckName,
+		MaxOverTimeCheckName,
 		SeriesCheckName,
 		RuleDependencyCheckName,
 		RuleDuplicateCheckName,
+		NamingConflictCheckName,
 		RuleForCheckName,
 		LabelCheckName,
 		RuleLinkCheckName,
 		RejectCheckName,
+		ScalarCheckName,
+		ClampCheckName,
+		AbsentAlwaysPresentCheckName,
+		QuantileCheckName,
+		UnlessCheckName,
+		CrossServerCheckName,
+		AlertNameCheckName,
+		RecordNameLengthCheckName,
+		DuplicateSelectorCheckName,
+		RateWindowCheckName,
+		ForOffsetCheckName,
+		CountValuesCheckName,
+		ResetsCheckName,
+		AvgOverTimeCheckName,
+		VectorLiteralCheckName,
+		RoundingCheckName,
+		ExpressionDuplicateCheckName,
+		ForRetentionCheckName,
+		LabelJoinCheckName,
+		CardinalityDeltaCheckName,
+		StddevCheckName,
+		UnusedRecordingRuleCheckName,
+		RoutingCheckName,
+		EvalOrderCheckName,
+		CountAlwaysPositiveCheckName,
+		EvalDurationCheckName,
+		UpProxyCheckName,
+		RateExactIntervalCheckName,
+		HistogramTypeCheckName,
+		ResetsWindowCheckName,
+		AvgCounterCheckName,
+		GroupIntervalCheckName,
+		AnnotationLabelCheckName,
+		VersionCompatibilityCheckName,
+		IncreaseIntervalCheckName,
+		AvgOverTimeResetCheckName,
+		SumOverTimeWindowCheckName,
+		WithoutLabelCheckName,
+		EmptyMatcherCheckName,
+		OrLabelMismatchCheckName,
+		OverTimeSingleSampleCheckName,
+		LogExpCheckName,
+		HighCardinalityCheckName,
+		BusinessHoursCheckName,
+		RegexEfficiencyCheckName,
+		TimeCheckName,
+		ComplementSelectorCheckName,
+		HistogramCompletenessCheckName,
+		HoltWintersCheckName,
+		IdeltaCheckName,
+		EmptyOnCheckName,
+		WindowForAlignmentCheckName,
+		IdentityRecordCheckName,
+		DateTimeFunctionCheckName,
+		AlertDuplicateConditionCheckName,
+		SameGroupRecordCheckName,
+		SeriesDisableCheckName,
+		ExtractOpportunityCheckName,
+		RecordingNameConventionCheckName,
+		DetectionLatencyCheckName,
+		GroupsWrapperCheckName,
+		AlertmanagerDelayCheckName,
+		RecordingLabelCheckName,
 	}
 	OnlineChecks = []string{
 		AlertsCheckName,
 		AlertsExternalLabelsCheckName,
+		AlertsForFormatCheckName,
 		LabelsConflictCheckName,
+		LabelsConsistencyCheckName,
 		RangeQueryCheckName,
 		RateCheckName,
 		VectorMatchingCheckName,
 		CostCheckName,
 		CounterCheckName,
+		DerivCheckName,
+		SumOverTimeCheckName,
+		MaxOverTimeCheckName,
 		SeriesCheckName,
 		RuleLinkCheckName,
+		NamingConflictCheckName,
+		ScalarCheckName,
+		ClampCheckName,
+		AbsentAlwaysPresentCheckName,
+		RateWindowCheckName,
+		ResetsCheckName,
+		AvgOverTimeCheckName,
+		RoundingCheckName,
+		ForRetentionCheckName,
+		LabelJoinCheckName,
+		CardinalityDeltaCheckName,
+		StddevCheckName,
+		CountAlwaysPositiveCheckName,
+		RoutingCheckName,
+		EvalDurationCheckName,
+		RateExactIntervalCheckName,
+		HistogramTypeCheckName,
+		ResetsWindowCheckName,
+		AvgCounterCheckName,
+		GroupIntervalCheckName,
+		AnnotationLabelCheckName,
+		VersionCompatibilityCheckName,
+		IncreaseIntervalCheckName,
+		AvgOverTimeResetCheckName,
+		SumOverTimeWindowCheckName,
+		WithoutLabelCheckName,
+		EmptyMatcherCheckName,
+		OrLabelMismatchCheckName,
+		OverTimeSingleSampleCheckName,
+		LogExpCheckName,
+		HighCardinalityCheckName,
+		BusinessHoursCheckName,
+		AlertmanagerDelayCheckName,
 	}
 )
 
@@ -109,9 +226,12 @@ type Problem struct {
 	Reporter string
 	Text     string
 	Details  string
-	Lines    parser.LineRange
-	Severity Severity
-	Anchor   Anchor
+	// SuppressedBy is the reason given in a `# pint ignore` comment
+	// for this problem, empty if the problem wasn't ignored.
+	SuppressedBy string `json:",omitempty"`
+	Lines        parser.LineRange
+	Severity     Severity
+	Anchor       Anchor
 }
 
 type CheckMeta struct {
@@ -126,6 +246,15 @@ type RuleChecker interface {
 	Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) []Problem
 }
 
+// DependentChecker is implemented by checks that skip problems reported
+// by other, more specific, checks. WithEnabledChecks is called with the
+// names of all checks enabled for a rule, so these problems are only
+// skipped when the more specific check is going to report them.
+type DependentChecker interface {
+	RuleChecker
+	WithEnabledChecks(names []string) RuleChecker
+}
+
 type exprProblem struct {
 	expr     string
 	text     string
@@ -164,3 +293,15 @@ func textAndSeverityFromError(err error, reporter, prom string, s Severity) (tex
 func promText(name, uri string) string {
 	return fmt.Sprintf("`%s` Prometheus server at %s", name, uri)
 }
+
+// unwrapParens returns the first node that's not wrapped in parentheses,
+// so `((foo))` will return `foo`.
+func unwrapParens(node promParser.Node) promParser.Node {
+	for {
+		pe, ok := node.(*promParser.ParenExpr)
+		if !ok {
+			return node
+		}
+		node = pe.Expr
+	}
+}
@@ -64,6 +64,17 @@ func TestParseSeverity(t *testing.T) {
 	}
 }
 
+func TestProblemSuppressedByMarshalJSON(t *testing.T) {
+	out, err := json.Marshal(checks.Problem{
+		Reporter:     "foo",
+		Lines:        parser.LineRange{First: 1, Last: 2},
+		Severity:     checks.Bug,
+		SuppressedBy: "not scraped yet",
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{"Reporter":"foo","Text":"","Details":"","SuppressedBy":"not scraped yet","Lines":{"First":1,"Last":2},"Severity":2,"Anchor":0}`, string(out))
+}
+
 func simpleProm(name, uri string, timeout time.Duration, required bool) *promapi.FailoverGroup {
 	return promapi.NewFailoverGroup(
 		name,
@@ -276,6 +287,10 @@ var (
 	requireQueryPath      = requestPathCond{path: "/api/v1/query"}
 	requireRangeQueryPath = requestPathCond{path: "/api/v1/query_range"}
 	requireMetadataPath   = requestPathCond{path: "/api/v1/metadata"}
+	requireBuildInfoPath  = requestPathCond{path: "/api/v1/status/buildinfo"}
+	requireNamesPath      = requestPathCond{path: "/api/v1/label/__name__/values"}
+	requireTargetsPath    = requestPathCond{path: "/api/v1/targets"}
+	requireTSDBPath       = requestPathCond{path: "/api/v1/status/tsdb"}
 )
 
 type promError struct {
@@ -432,6 +447,104 @@ func (fg flagsResponse) respond(w http.ResponseWriter, _ *http.Request) {
 	_, _ = w.Write(d)
 }
 
+type tsdbResponse struct {
+	numSeries int
+}
+
+func (tr tsdbResponse) respond(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(200)
+	w.Header().Set("Content-Type", "application/json")
+	result := struct {
+		Status string `json:"status"`
+		Data   struct {
+			HeadStats struct {
+				NumSeries int `json:"numSeries"`
+			} `json:"headStats"`
+		} `json:"data"`
+	}{
+		Status: "success",
+	}
+	result.Data.HeadStats.NumSeries = tr.numSeries
+	d, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	_, _ = w.Write(d)
+}
+
+type buildInfoResponse struct {
+	version string
+}
+
+func (bi buildInfoResponse) respond(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(200)
+	w.Header().Set("Content-Type", "application/json")
+	result := struct {
+		Data   v1.BuildinfoResult `json:"data"`
+		Status string             `json:"status"`
+	}{
+		Status: "success",
+		Data:   v1.BuildinfoResult{Version: bi.version},
+	}
+	d, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	_, _ = w.Write(d)
+}
+
+type scrapeTarget struct {
+	Labels         map[string]string `json:"labels"`
+	ScrapePool     string            `json:"scrapePool"`
+	ScrapeInterval string            `json:"scrapeInterval"`
+}
+
+type targetsResponse struct {
+	targets []scrapeTarget
+}
+
+func (tr targetsResponse) respond(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(200)
+	w.Header().Set("Content-Type", "application/json")
+	result := struct {
+		Data struct {
+			ActiveTargets  []scrapeTarget `json:"activeTargets"`
+			DroppedTargets []scrapeTarget `json:"droppedTargets"`
+		} `json:"data"`
+		Status string `json:"status"`
+	}{
+		Status: "success",
+	}
+	result.Data.ActiveTargets = tr.targets
+	result.Data.DroppedTargets = []scrapeTarget{}
+	d, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	_, _ = w.Write(d)
+}
+
+type labelValuesResponse struct {
+	values []string
+}
+
+func (lv labelValuesResponse) respond(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(200)
+	w.Header().Set("Content-Type", "application/json")
+	result := struct {
+		Status string   `json:"status"`
+		Data   []string `json:"data"`
+	}{
+		Status: "success",
+		Data:   lv.values,
+	}
+	d, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	_, _ = w.Write(d)
+}
+
 type metadataResponse struct {
 	metadata map[string][]v1.Metadata
 }
@@ -3,14 +3,22 @@ package checks
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
 
 	"github.com/cloudflare/pint/internal/discovery"
 	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
 	"github.com/cloudflare/pint/internal/promapi"
 )
 
 const (
 	LabelsConflictCheckName = "labels/conflict"
+
+	LabelsConflictQueryDetails = `Labels set on alerting rules are added to all alerts and will replace any label with the same name returned by the query.
+If you want to keep the value from the query use a different name for this label.`
 )
 
 func NewLabelsConflictCheck(prom *promapi.FailoverGroup) LabelsConflictCheck {
@@ -65,9 +73,12 @@ func (c LabelsConflictCheck) Check(ctx context.Context, _ discovery.Path, rule p
 		return problems
 	}
 
+	queryLabels := make([]*parser.YamlKeyValue, 0, len(labels.Items))
 	for _, label := range labels.Items {
+		var isExternal bool
 		for k, v := range cfg.Config.Global.ExternalLabels {
 			if label.Key.Value == k {
+				isExternal = true
 				problems = append(problems, Problem{
 					Lines: parser.LineRange{
 						First: label.Key.Lines.First,
@@ -80,6 +91,64 @@ func (c LabelsConflictCheck) Check(ctx context.Context, _ discovery.Path, rule p
 				})
 			}
 		}
+		if isExternal {
+			continue
+		}
+		if slices.Contains(getTemplateLabels(label.Key.Value, label.Value.Value), label.Key.Value) {
+			// Label value is using the value returned by the query, like `foo: "{{ $labels.foo }}-bar"`.
+			continue
+		}
+		queryLabels = append(queryLabels, label)
+	}
+	if rule.AlertingRule != nil && len(queryLabels) > 0 {
+		problems = append(problems, c.checkQueryLabels(ctx, rule.AlertingRule.Expr, labels, queryLabels)...)
+	}
+
+	return problems
+}
+
+func (c LabelsConflictCheck) checkQueryLabels(ctx context.Context, expr parser.PromQLExpr, labels *parser.YamlMap, queryLabels []*parser.YamlKeyValue) (problems []Problem) {
+	node := utils.RemoveConditions(expr.Value.Value)
+	if vs, ok := node.(*promParser.VectorSelector); ok && vs.Name == "" {
+		return problems
+	}
+
+	names := make([]string, 0, len(queryLabels))
+	for _, label := range queryLabels {
+		names = append(names, label.Key.Value)
+	}
+
+	qr, err := c.prom.Query(ctx, fmt.Sprintf("count(%s) by (%s)", node.String(), strings.Join(names, ", ")))
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+		problems = append(problems, Problem{
+			Lines:    labels.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	for _, label := range queryLabels {
+		for _, s := range qr.Series {
+			if v := s.Labels.Get(label.Key.Value); v == "" || v == label.Value.Value {
+				// Either there's no such label on the returned time series or it has the same value.
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines: parser.LineRange{
+					First: label.Key.Lines.First,
+					Last:  label.Value.Lines.Last,
+				},
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("Time series returned by this query on %s already have the `%s` label, setting it here will override the value from the query.",
+					promText(c.prom.Name(), qr.URI), label.Key.Value),
+				Details:  LabelsConflictQueryDetails,
+				Severity: Warning,
+			})
+			break
+		}
 	}
 
 	return problems
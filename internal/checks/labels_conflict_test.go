@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/common/model"
+
 	"github.com/cloudflare/pint/internal/checks"
 	"github.com/cloudflare/pint/internal/parser"
 	"github.com/cloudflare/pint/internal/promapi"
@@ -18,6 +20,10 @@ func textExternalLabelsAR(name, uri, k, v string) string {
 	return fmt.Sprintf("This label is redundant. `%s` Prometheus server at %s external_labels already has %s=%q label set and it will be automatically added to all alerts, there's no need to set it manually.", name, uri, k, v)
 }
 
+func textQueryLabels(name, uri, k string) string {
+	return fmt.Sprintf("Time series returned by this query on `%s` Prometheus server at %s already have the `%s` label, setting it here will override the value from the query.", name, uri, k)
+}
+
 func newLabelsConflict(prom *promapi.FailoverGroup) checks.RuleChecker {
 	return checks.NewLabelsConflictCheck(prom)
 }
@@ -175,6 +181,216 @@ func TestLabelsConflictCheck(t *testing.T) {
 					conds: []requestCondition{requireConfigPath},
 					resp:  configResponse{yaml: "global:\n  external_labels:\n    bob: bob\n"},
 				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(up) by (foo)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "query error / alerting",
+			content:     "- alert: foo\n  expr: up == 0\n  labels:\n    foo: bar\n",
+			checker:     newLabelsConflict,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  4,
+						},
+						Reporter: checks.LabelsConflictCheckName,
+						Text:     checkErrorUnableToRun(checks.LabelsConflictCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  external_labels:\n    bob: bob\n"},
+				},
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "conflict / alerting / query labels",
+			content:     "- alert: foo\n  expr: sum(errors_total) by(job, severity) > 0\n  labels:\n    severity: critical\n    team: bob\n",
+			checker:     newLabelsConflict,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.LabelsConflictCheckName,
+						Text:     textQueryLabels("prom", uri, "severity"),
+						Details:  checks.LabelsConflictQueryDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  external_labels:\n    bob: bob\n"},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum by (job, severity) (errors_total)) by (severity, team)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+							generateSample(map[string]string{"severity": "warning"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "conflict / alerting / multiple query labels",
+			content:     "- alert: foo\n  expr: sum(errors_total) by(job, severity, team) > 0\n  labels:\n    severity: critical\n    team: bob\n    bob: bob\n",
+			checker:     newLabelsConflict,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 6,
+							Last:  6,
+						},
+						Reporter: checks.LabelsConflictCheckName,
+						Text:     textExternalLabelsAR("prom", uri, "bob", "bob"),
+						Details:  alertsExternalLabelsDetails("prom", uri),
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.LabelsConflictCheckName,
+						Text:     textQueryLabels("prom", uri, "severity"),
+						Details:  checks.LabelsConflictQueryDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 5,
+							Last:  5,
+						},
+						Reporter: checks.LabelsConflictCheckName,
+						Text:     textQueryLabels("prom", uri, "team"),
+						Details:  checks.LabelsConflictQueryDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  external_labels:\n    bob: bob\n"},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum by (job, severity, team) (errors_total)) by (severity, team)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"severity": "warning"}),
+							generateSample(map[string]string{"team": "alice"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "no conflict / alerting / templated label",
+			content:     "- alert: foo\n  expr: sum(errors_total) by(job, severity) > 0\n  labels:\n    severity: '{{ $labels.severity }}-page'\n    job: '{{ .Labels.job }}'\n",
+			checker:     newLabelsConflict,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  external_labels:\n    bob: bob\n"},
+				},
+			},
+		},
+		{
+			description: "no conflict / alerting / same value",
+			content:     "- alert: foo\n  expr: sum(errors_total{severity=\"critical\"}) by(job, severity) > 0\n  labels:\n    severity: critical\n",
+			checker:     newLabelsConflict,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  external_labels:\n    bob: bob\n"},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(sum by (job, severity) (errors_total{severity="critical"})) by (severity)`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"severity": "critical"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "conflict / alerting / templated label using other label",
+			content:     "- alert: foo\n  expr: sum(errors_total) by(job, severity) > 0\n  labels:\n    severity: '{{ $labels.job }}'\n",
+			checker:     newLabelsConflict,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.LabelsConflictCheckName,
+						Text:     textQueryLabels("prom", uri, "severity"),
+						Details:  checks.LabelsConflictQueryDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  external_labels:\n    bob: bob\n"},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum by (job, severity) (errors_total)) by (severity)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"severity": "warning"}),
+						},
+					},
+				},
 			},
 		},
 	}
@@ -0,0 +1,246 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	LabelsConsistencyCheckName    = "labels/consistency"
+	LabelsConsistencyCheckDetails = `Time series produced by a recording rule should always have the same set of labels.
+When labels depend on which part of the query returned results (for example when using ` + "`or`" + ` or ` + "`label_replace()`" + `) the recorded metric will have different labels over time.
+This makes it hard to query and aggregate and it's usually a sign that the query needs to be adjusted, for example by adding ` + "`by(...)`" + ` or ` + "`without(...)`" + ` to all aggregations.`
+
+	defaultLabelsConsistencyRange = time.Hour * 24 * 7
+	defaultLabelsConsistencyStep  = time.Minute * 5
+)
+
+type LabelsConsistencySettings struct {
+	Range    string `hcl:"range,optional" json:"range,omitempty"`
+	Step     string `hcl:"step,optional" json:"step,omitempty"`
+	Comment  string `hcl:"comment,optional" json:"comment,omitempty"`
+	Severity string `hcl:"severity,optional" json:"severity,omitempty"`
+	lookBack time.Duration
+	step     time.Duration
+	severity Severity
+}
+
+func (s *LabelsConsistencySettings) Validate() error {
+	s.lookBack = defaultLabelsConsistencyRange
+	if s.Range != "" {
+		dur, err := model.ParseDuration(s.Range)
+		if err != nil {
+			return fmt.Errorf("invalid range value: %w", err)
+		}
+		s.lookBack = time.Duration(dur)
+	}
+	s.step = defaultLabelsConsistencyStep
+	if s.Step != "" {
+		dur, err := model.ParseDuration(s.Step)
+		if err != nil {
+			return fmt.Errorf("invalid step value: %w", err)
+		}
+		s.step = time.Duration(dur)
+	}
+	s.severity = Warning
+	if s.Severity != "" {
+		sev, err := ParseSeverity(s.Severity)
+		if err != nil {
+			return err
+		}
+		s.severity = sev
+	}
+	return nil
+}
+
+func (s *LabelsConsistencySettings) LookBack() time.Duration {
+	return s.lookBack
+}
+
+func (s *LabelsConsistencySettings) Resolution() time.Duration {
+	return s.step
+}
+
+func (s *LabelsConsistencySettings) ReportSeverity() Severity {
+	return s.severity
+}
+
+func NewLabelsConsistencyCheck(prom *promapi.FailoverGroup, lookBack, step time.Duration, comment string, severity Severity) LabelsConsistencyCheck {
+	return LabelsConsistencyCheck{
+		prom:     prom,
+		lookBack: lookBack,
+		step:     step,
+		comment:  comment,
+		severity: severity,
+	}
+}
+
+type LabelsConsistencyCheck struct {
+	prom     *promapi.FailoverGroup
+	comment  string
+	lookBack time.Duration
+	step     time.Duration
+	severity Severity
+}
+
+func (c LabelsConsistencyCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c LabelsConsistencyCheck) String() string {
+	return fmt.Sprintf("%s(%s)", LabelsConsistencyCheckName, c.prom.Name())
+}
+
+func (c LabelsConsistencyCheck) Reporter() string {
+	return LabelsConsistencyCheckName
+}
+
+func (c LabelsConsistencyCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	params := promapi.NewRelativeRange(c.lookBack, c.step)
+	qr, err := c.prom.RangeQuery(ctx, fmt.Sprintf("group without() (%s)", rule.RecordingRule.Record.Value), params)
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+		problems = append(problems, Problem{
+			Lines:    rule.RecordingRule.Record.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Details:  maybeComment(c.comment),
+			Severity: severity,
+		})
+		return problems
+	}
+
+	keys := changedLabelSets(qr.Series.Ranges)
+	if len(keys) < 2 {
+		return problems
+	}
+
+	details := LabelsConsistencyCheckDetails
+	if c.comment != "" {
+		details = fmt.Sprintf("%s\n%s", details, maybeComment(c.comment))
+	}
+
+	delta := qr.Series.Until.Sub(qr.Series.From).Round(time.Minute)
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Record.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s` recording rule produced time series with labels changing over time on %s in the last %s, found %d different sets of labels: %s.",
+			rule.RecordingRule.Record.Value, promText(c.prom.Name(), qr.URI), output.HumanizeDuration(delta), len(keys), strings.Join(keys, ", ")),
+		Details:  details,
+		Severity: c.severity,
+	})
+	return problems
+}
+
+// changedLabelSets returns sorted sets of label names used by time series
+// that had their labels changed over time.
+// Labels present on all time series are used to tell which time series
+// are the same, so time series that always have a different set of labels,
+// for example an extra label only present for some jobs, are not reported.
+func changedLabelSets(ranges promapi.MetricTimeRanges) []string {
+	var common []string
+	for i, r := range ranges {
+		names := labelNames(r.Labels)
+		if i == 0 {
+			common = names
+			continue
+		}
+		common = slices.DeleteFunc(common, func(name string) bool {
+			return !slices.Contains(names, name)
+		})
+	}
+
+	series := map[string][]promapi.MetricTimeRange{}
+	for _, r := range ranges {
+		key := r.Labels.MatchLabels(true, common...).String()
+		series[key] = append(series[key], r)
+	}
+
+	sets := map[string]struct{}{}
+	for _, sr := range series {
+		if !hasLabelsChange(sr) {
+			continue
+		}
+		for _, r := range sr {
+			sets[labelNamesKey(r.Labels)] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(sets))
+	for k := range sets {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// hasLabelsChange returns true if the sets of label names used by given
+// ranges are not the same at the start and end of every range.
+func hasLabelsChange(ranges []promapi.MetricTimeRange) bool {
+	var last string
+	for i, ts := range rangeEdges(ranges) {
+		var keys []string
+		for _, r := range ranges {
+			if r.Start.After(ts) || r.End.Before(ts) {
+				continue
+			}
+			if key := labelNamesKey(r.Labels); !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+		current := strings.Join(keys, ", ")
+		if i > 0 && current != last {
+			return true
+		}
+		last = current
+	}
+	return false
+}
+
+func rangeEdges(ranges []promapi.MetricTimeRange) []time.Time {
+	edges := make([]time.Time, 0, len(ranges)*2)
+	for _, r := range ranges {
+		edges = append(edges, r.Start, r.End)
+	}
+	return edges
+}
+
+func labelNames(ls labels.Labels) []string {
+	names := make([]string, 0, ls.Len())
+	ls.Range(func(l labels.Label) {
+		if l.Name == labels.MetricName {
+			return
+		}
+		names = append(names, l.Name)
+	})
+	slices.Sort(names)
+	return names
+}
+
+func labelNamesKey(ls labels.Labels) string {
+	return "`[" + strings.Join(labelNames(ls), ", ") + "]`"
+}
@@ -0,0 +1,348 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newLabelsConsistencyCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewLabelsConsistencyCheck(prom, time.Hour*24, time.Minute*5, "", checks.Warning)
+}
+
+func labelsConsistencyText(name, uri, metric string, count int, since, sets string) string {
+	return fmt.Sprintf("`%s` recording rule produced time series with labels changing over time on `%s` Prometheus server at %s in the last %s, found %d different sets of labels: %s.", metric, name, uri, since, count, sets)
+}
+
+func TestLabelsConsistencyCheck(t *testing.T) {
+	content := "- record: job:up:sum\n  expr: sum(up) by(job) or vector(0)\n"
+
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "bad request",
+			content:     content,
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.LabelsConsistencyCheckName,
+						Text:     checkErrorBadData("prom", uri, "bad_data: bad input data"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithBadData(),
+				},
+			},
+		},
+		{
+			description: "no results",
+			content:     content,
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "group without() (job:up:sum)"},
+					},
+					resp: matrixResponse{samples: []*model.SampleStream{}},
+				},
+			},
+		},
+		{
+			description: "consistent labels",
+			content:     content,
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "group without() (job:up:sum)"},
+					},
+					resp: matrixResponse{
+						samples: []*model.SampleStream{
+							generateSampleStream(
+								map[string]string{"job": "foo"},
+								time.Now().Add(time.Hour*-20),
+								time.Now().Add(time.Hour*-10),
+								time.Minute*5,
+							),
+							generateSampleStream(
+								map[string]string{"job": "bar"},
+								time.Now().Add(time.Hour*-5),
+								time.Now(),
+								time.Minute*5,
+							),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "inconsistent labels",
+			content:     content,
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.LabelsConsistencyCheckName,
+						Text:     labelsConsistencyText("prom", uri, "job:up:sum", 3, "1d", "`[]`, `[instance, job]`, `[job]`"),
+						Details:  checks.LabelsConsistencyCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "group without() (job:up:sum)"},
+					},
+					resp: matrixResponse{
+						samples: []*model.SampleStream{
+							generateSampleStream(
+								map[string]string{"job": "foo"},
+								time.Now().Add(time.Hour*-20),
+								time.Now().Add(time.Hour*-10),
+								time.Minute*5,
+							),
+							generateSampleStream(
+								map[string]string{},
+								time.Now().Add(time.Hour*-10),
+								time.Now().Add(time.Hour*-5),
+								time.Minute*5,
+							),
+							generateSampleStream(
+								map[string]string{"job": "foo", "instance": "a"},
+								time.Now().Add(time.Hour*-5),
+								time.Now(),
+								time.Minute*5,
+							),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "different labels per job",
+			content:     content,
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "group without() (job:up:sum)"},
+					},
+					resp: matrixResponse{
+						samples: []*model.SampleStream{
+							generateSampleStream(
+								map[string]string{"job": "foo", "instance": "a"},
+								time.Now().Add(time.Hour*-20),
+								time.Now(),
+								time.Minute*5,
+							),
+							generateSampleStream(
+								map[string]string{"job": "bar"},
+								time.Now().Add(time.Hour*-20),
+								time.Now(),
+								time.Minute*5,
+							),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "different labels at the same time",
+			content:     content,
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "group without() (job:up:sum)"},
+					},
+					resp: matrixResponse{
+						samples: []*model.SampleStream{
+							generateSampleStream(
+								map[string]string{"job": "foo"},
+								time.Now().Add(time.Hour*-20),
+								time.Now(),
+								time.Minute*5,
+							),
+							generateSampleStream(
+								map[string]string{},
+								time.Now().Add(time.Hour*-20),
+								time.Now(),
+								time.Minute*5,
+							),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "labels changing over time for one job",
+			content:     content,
+			checker:     newLabelsConsistencyCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.LabelsConsistencyCheckName,
+						Text:     labelsConsistencyText("prom", uri, "job:up:sum", 2, "1d", "`[instance, job]`, `[job]`"),
+						Details:  checks.LabelsConsistencyCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "group without() (job:up:sum)"},
+					},
+					resp: matrixResponse{
+						samples: []*model.SampleStream{
+							generateSampleStream(
+								map[string]string{"job": "foo", "instance": "a"},
+								time.Now().Add(time.Hour*-20),
+								time.Now().Add(time.Hour*-10),
+								time.Minute*5,
+							),
+							generateSampleStream(
+								map[string]string{"job": "foo"},
+								time.Now().Add(time.Hour*-10),
+								time.Now(),
+								time.Minute*5,
+							),
+							generateSampleStream(
+								map[string]string{"job": "bar", "env": "prod"},
+								time.Now().Add(time.Hour*-20),
+								time.Now(),
+								time.Minute*5,
+							),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "inconsistent labels / custom severity and comment",
+			content:     content,
+			checker: func(prom *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewLabelsConsistencyCheck(prom, time.Hour*24, time.Minute*5, "some text", checks.Bug)
+			},
+			prometheus: newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.LabelsConsistencyCheckName,
+						Text:     labelsConsistencyText("prom", uri, "job:up:sum", 2, "1d", "`[]`, `[job]`"),
+						Details:  checks.LabelsConsistencyCheckDetails + "\nRule comment: some text",
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "group without() (job:up:sum)"},
+					},
+					resp: matrixResponse{
+						samples: []*model.SampleStream{
+							generateSampleStream(
+								map[string]string{"job": "foo"},
+								time.Now().Add(time.Hour*-20),
+								time.Now().Add(time.Hour*-10),
+								time.Minute*5,
+							),
+							generateSampleStream(
+								map[string]string{},
+								time.Now().Add(time.Hour*-10),
+								time.Now(),
+								time.Minute*5,
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
+
+func TestLabelsConsistencySettings(t *testing.T) {
+	s := checks.LabelsConsistencySettings{}
+	require.NoError(t, s.Validate())
+	require.Equal(t, time.Hour*24*7, s.LookBack())
+	require.Equal(t, time.Minute*5, s.Resolution())
+	require.Equal(t, checks.Warning, s.ReportSeverity())
+
+	s = checks.LabelsConsistencySettings{Range: "3d", Step: "10m", Severity: "bug"}
+	require.NoError(t, s.Validate())
+	require.Equal(t, time.Hour*24*3, s.LookBack())
+	require.Equal(t, time.Minute*10, s.Resolution())
+	require.Equal(t, checks.Bug, s.ReportSeverity())
+
+	s = checks.LabelsConsistencySettings{Range: "foo"}
+	require.EqualError(t, s.Validate(), `invalid range value: not a valid duration string: "foo"`)
+
+	s = checks.LabelsConsistencySettings{Step: "foo"}
+	require.EqualError(t, s.Validate(), `invalid step value: not a valid duration string: "foo"`)
+
+	s = checks.LabelsConsistencySettings{Severity: "foo"}
+	require.EqualError(t, s.Validate(), "unknown severity: foo")
+}
@@ -0,0 +1,113 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	AbsentAlwaysPresentCheckName    = "promql/absent"
+	AbsentAlwaysPresentCheckDetails = `[absent()](https://prometheus.io/docs/prometheus/latest/querying/functions/#absent) only returns a result when the query passed to it doesn't match any time series.
+If the metric was always present then ` + "`absent()`" + ` will never return anything and any alert using it will never fire.
+Check that the selector passed to ` + "`absent()`" + ` is correct, it might need more specific label matchers.`
+
+	absentAlwaysPresentLookback = time.Hour * 24 * 7
+)
+
+type AbsentAlwaysPresentSettings struct{}
+
+func (s *AbsentAlwaysPresentSettings) Validate() error {
+	return nil
+}
+
+func NewAbsentAlwaysPresentCheck(prom *promapi.FailoverGroup) AbsentAlwaysPresentCheck {
+	return AbsentAlwaysPresentCheck{prom: prom}
+}
+
+type AbsentAlwaysPresentCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c AbsentAlwaysPresentCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c AbsentAlwaysPresentCheck) String() string {
+	return fmt.Sprintf("%s(%s)", AbsentAlwaysPresentCheckName, c.prom.Name())
+}
+
+func (c AbsentAlwaysPresentCheck) Reporter() string {
+	return AbsentAlwaysPresentCheckName
+}
+
+func (c AbsentAlwaysPresentCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "absent" {
+			continue
+		}
+		vs, ok := unwrapParens(call.Args[0]).(*promParser.VectorSelector)
+		if !ok {
+			continue
+		}
+
+		selector := promParser.VectorSelector{
+			Name:          vs.Name,
+			LabelMatchers: vs.LabelMatchers,
+		}
+		if _, ok := done[selector.String()]; ok {
+			continue
+		}
+		done[selector.String()] = struct{}{}
+
+		// absent() returns a sample for every step where the selector didn't match
+		// anything, so no results here means that the metric was always present.
+		qr, err := c.prom.Query(ctx, fmt.Sprintf("count_over_time(absent(%s)[%s:])", selector.String(), output.HumanizeDuration(absentAlwaysPresentLookback)))
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			return problems
+		}
+		if len(qr.Series) > 0 {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` will never return anything because `%s` was present for the entire last %s according to %s.",
+				call.String(), selector.String(), output.HumanizeDuration(absentAlwaysPresentLookback), promText(c.prom.Name(), qr.URI)),
+			Details:  AbsentAlwaysPresentCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
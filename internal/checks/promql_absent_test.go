@@ -0,0 +1,123 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAbsentAlwaysPresentCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAbsentAlwaysPresentCheck(prom)
+}
+
+func absentAlwaysPresentText(call, selector, name, uri string) string {
+	return fmt.Sprintf("`%s` will never return anything because `%s` was present for the entire last 1w according to `%s` Prometheus server at %s.",
+		call, selector, name, uri)
+}
+
+func TestAbsentAlwaysPresentCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: absent(foo\n",
+			checker:     newAbsentAlwaysPresentCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without absent()",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newAbsentAlwaysPresentCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores non-selector arguments",
+			content:     "- alert: foo\n  expr: absent(sum(up))\n",
+			checker:     newAbsentAlwaysPresentCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- alert: foo\n  expr: absent(up)\n",
+			checker:     newAbsentAlwaysPresentCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AbsentAlwaysPresentCheckName,
+						Text:     checkErrorUnableToRun(checks.AbsentAlwaysPresentCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "metric was missing",
+			content:     "- alert: foo\n  expr: absent(up{job=\"foo\"})\n",
+			checker:     newAbsentAlwaysPresentCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count_over_time(absent(up{job="foo"})[1w:])`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{"job": "foo"}, 5),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "metric was always present",
+			content:     "- alert: foo\n  expr: absent(up{job=\"foo\"} offset 5m) or absent((up{job=\"foo\"}))\n",
+			checker:     newAbsentAlwaysPresentCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AbsentAlwaysPresentCheckName,
+						Text:     absentAlwaysPresentText(`absent(up{job="foo"} offset 5m)`, `up{job="foo"}`, "prom", uri),
+						Details:  checks.AbsentAlwaysPresentCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count_over_time(absent(up{job="foo"})[1w:])`},
+					},
+					resp: vectorResponse{samples: []*model.Sample{}},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,114 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	AvgCounterCheckName    = "promql/avg_counter"
+	AvgCounterCheckDetails = `[Counters](https://prometheus.io/docs/concepts/metric_types/#counter) only ever grow and reset to zero when your application restarts, so the value of each counter depends on how long it has been running.
+Averaging raw counter values across multiple time series with [avg()](https://prometheus.io/docs/prometheus/latest/querying/operators/#aggregation-operators) mixes counters that started at different times and the result doesn't tell you anything about the number of events.
+Calculate the rate of events first with [rate()](https://prometheus.io/docs/prometheus/latest/querying/functions/#rate) and then aggregate the result, for example ` + "`avg(rate(errors_total[5m]))`" + `, or use ` + "`sum()`" + ` if you need the total.`
+)
+
+func NewAvgCounterCheck(prom *promapi.FailoverGroup) AvgCounterCheck {
+	return AvgCounterCheck{prom: prom}
+}
+
+type AvgCounterCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c AvgCounterCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c AvgCounterCheck) String() string {
+	return fmt.Sprintf("%s(%s)", AvgCounterCheckName, c.prom.Name())
+}
+
+func (c AvgCounterCheck) Reporter() string {
+	return AvgCounterCheckName
+}
+
+func (c AvgCounterCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.AggregateExpr](expr.Query) {
+		aggr := node.Expr.(*promParser.AggregateExpr)
+		if aggr.Op != promParser.AVG {
+			continue
+		}
+
+		vs, ok := unwrapParens(aggr.Expr).(*promParser.VectorSelector)
+		if !ok || vs.Name == "" {
+			continue
+		}
+
+		if _, ok := done[vs.Name]; ok {
+			continue
+		}
+		done[vs.Name] = struct{}{}
+
+		metadata, err := c.prom.Metadata(ctx, vs.Name)
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+		if !isCounterMetadata(metadata.Metadata) {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`avg()` is used directly on `%s` which is a counter according to metrics metadata from %s, averaging raw counter values isn't meaningful, use `avg(rate(%s[...]))` instead.",
+				vs.Name, promText(c.prom.Name(), metadata.URI), vs.Name),
+			Details:  AvgCounterCheckDetails,
+			Severity: Bug,
+		})
+	}
+
+	return problems
+}
+
+// isAvgOfSelector returns true if given vector selector node is passed
+// directly to avg().
+func isAvgOfSelector(node *parser.PromQLNode) bool {
+	for p := node.Parent; p != nil; p = p.Parent {
+		switch n := p.Expr.(type) {
+		case *promParser.ParenExpr:
+			continue
+		case *promParser.AggregateExpr:
+			return n.Op == promParser.AVG
+		}
+		return false
+	}
+	return false
+}
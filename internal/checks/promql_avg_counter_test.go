@@ -0,0 +1,129 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAvgCounterCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAvgCounterCheck(prom)
+}
+
+func avgCounterText(name, uri, metric string) string {
+	return fmt.Sprintf("`avg()` is used directly on `%s` which is a counter according to metrics metadata from `%s` Prometheus server at %s, averaging raw counter values isn't meaningful, use `avg(rate(%s[...]))` instead.", metric, name, uri, metric)
+}
+
+func TestAvgCounterCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: avg(foo) without(\n",
+			checker:     newAvgCounterCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without avg()",
+			content:     "- record: foo\n  expr: sum(http_requests_total)\n",
+			checker:     newAvgCounterCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores avg(rate())",
+			content:     "- record: foo\n  expr: avg(rate(http_requests_total[5m]))\n",
+			checker:     newAvgCounterCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: avg(http_requests_total)\n",
+			checker:     newAvgCounterCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgCounterCheckName,
+						Text:     checkErrorUnableToRun(checks.AvgCounterCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "avg(gauge)",
+			content:     "- record: foo\n  expr: avg(temperature) by (instance)\n",
+			checker:     newAvgCounterCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "avg(counter) / no metadata",
+			content:     "- record: foo\n  expr: avg(http_requests_total)\n",
+			checker:     newAvgCounterCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  metadataResponse{metadata: map[string][]v1.Metadata{}},
+				},
+			},
+		},
+		{
+			description: "avg(counter)",
+			content:     "- record: foo\n  expr: avg((http_requests_total{job=\"foo\"})) by (instance) + avg(http_requests_total)\n",
+			checker:     newAvgCounterCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgCounterCheckName,
+						Text:     avgCounterText("prom", uri, "http_requests_total"),
+						Details:  checks.AvgCounterCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
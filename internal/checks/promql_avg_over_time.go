@@ -0,0 +1,110 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	AvgOverTimeCheckName    = "promql/avg_over_time"
+	AvgOverTimeCheckDetails = `[avg_over_time()](https://prometheus.io/docs/prometheus/latest/querying/functions/#aggregation_over_time) calculates the arithmetic mean of all samples in the provided time range.
+[Histogram](https://prometheus.io/docs/concepts/metric_types/#histogram) buckets are cumulative counters, so averaging their values over time doesn't produce any meaningful result.
+To calculate the average value of observations use ` + "`rate(foo_sum[5m]) / rate(foo_count[5m])`" + ` and to calculate quantiles use ` + "`histogram_quantile()`" + `.`
+)
+
+func NewAvgOverTimeCheck(prom *promapi.FailoverGroup) AvgOverTimeCheck {
+	return AvgOverTimeCheck{prom: prom}
+}
+
+type AvgOverTimeCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c AvgOverTimeCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c AvgOverTimeCheck) String() string {
+	return fmt.Sprintf("%s(%s)", AvgOverTimeCheckName, c.prom.Name())
+}
+
+func (c AvgOverTimeCheck) Reporter() string {
+	return AvgOverTimeCheckName
+}
+
+func (c AvgOverTimeCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "avg_over_time" {
+			continue
+		}
+
+		for _, arg := range call.Args {
+			ms, ok := arg.(*promParser.MatrixSelector)
+			if !ok {
+				continue
+			}
+			vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+			if !ok || vs.Name == "" {
+				continue
+			}
+
+			if _, ok := done[vs.Name]; ok {
+				continue
+			}
+			done[vs.Name] = struct{}{}
+
+			metadata, err := c.prom.Metadata(ctx, vs.Name)
+			if err == nil && len(metadata.Metadata) == 0 && strings.HasSuffix(vs.Name, "_bucket") {
+				// Metadata for classic histograms is stored under the metric family name.
+				metadata, err = c.prom.Metadata(ctx, strings.TrimSuffix(vs.Name, "_bucket"))
+			}
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				continue
+			}
+			if typ, ok := metadataType(metadata.Metadata); !ok || typ != v1.MetricTypeHistogram {
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`avg_over_time()` is used with `%s` which is a histogram according to metrics metadata from %s, averaging histogram buckets over time doesn't produce meaningful results.",
+					vs.Name, promText(c.prom.Name(), metadata.URI)),
+				Details:  AvgOverTimeCheckDetails,
+				Severity: Bug,
+			})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,149 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	AvgOverTimeResetCheckName    = "promql/avg_over_time_reset"
+	AvgOverTimeResetCheckDetails = `[avg_over_time()](https://prometheus.io/docs/prometheus/latest/querying/functions/#aggregation_over_time) calculates the arithmetic mean of all raw sample values in the provided time range.
+When a counter resets all samples after the reset start from zero again, so any time window that includes a reset will return a much lower average.
+If you want to know how fast the counter is growing use [rate()](https://prometheus.io/docs/prometheus/latest/querying/functions/#rate) instead, it handles counter resets correctly.`
+
+	avgOverTimeResetLookback  = time.Hour * 24 * 7
+	avgOverTimeResetThreshold = 0.1
+)
+
+type AvgOverTimeResetSettings struct{}
+
+func (s *AvgOverTimeResetSettings) Validate() error {
+	return nil
+}
+
+func NewAvgOverTimeResetCheck(prom *promapi.FailoverGroup) AvgOverTimeResetCheck {
+	return AvgOverTimeResetCheck{prom: prom}
+}
+
+type AvgOverTimeResetCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c AvgOverTimeResetCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c AvgOverTimeResetCheck) String() string {
+	return fmt.Sprintf("%s(%s)", AvgOverTimeResetCheckName, c.prom.Name())
+}
+
+func (c AvgOverTimeResetCheck) Reporter() string {
+	return AvgOverTimeResetCheckName
+}
+
+func (c AvgOverTimeResetCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "avg_over_time" {
+			continue
+		}
+
+		ms, ok := call.Args[0].(*promParser.MatrixSelector)
+		if !ok {
+			continue
+		}
+		vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+		if !ok || vs.Name == "" {
+			continue
+		}
+
+		if _, ok := done[ms.String()]; ok {
+			continue
+		}
+		done[ms.String()] = struct{}{}
+
+		if ms.Range >= avgOverTimeResetLookback {
+			continue
+		}
+
+		metadata, err := c.prom.Metadata(ctx, vs.Name)
+		if err != nil {
+			problems = append(problems, c.queryProblem(err, expr))
+			continue
+		}
+		// resets() counts every decrease in value, so it's only meaningful for counters.
+		if !isCounterMetadata(metadata.Metadata) {
+			continue
+		}
+
+		selector := promParser.VectorSelector{
+			Name:          vs.Name,
+			LabelMatchers: vs.LabelMatchers,
+		}
+		qr, err := c.prom.Query(ctx, fmt.Sprintf("avg(resets(%s[%s]))", selector.String(), output.HumanizeDuration(avgOverTimeResetLookback)))
+		if err != nil {
+			problems = append(problems, c.queryProblem(err, expr))
+			continue
+		}
+		if len(qr.Series) == 0 {
+			continue
+		}
+
+		resets := float64(qr.Series[0].Value)
+		if resets <= 0 {
+			continue
+		}
+
+		// Estimate how many time windows will include at least one reset.
+		ratio := resets * float64(ms.Range) / float64(avgOverTimeResetLookback)
+		if ratio < avgOverTimeResetThreshold {
+			continue
+		}
+
+		interval := time.Duration(float64(avgOverTimeResetLookback) / resets).Round(time.Second)
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is using `%s` range but `%s` was reset every %s on average in the last %s according to %s, the average will drop every time a reset happens inside the time window.",
+				call.String(), output.HumanizeDuration(ms.Range), selector.String(), output.HumanizeDuration(interval),
+				output.HumanizeDuration(avgOverTimeResetLookback), promText(c.prom.Name(), qr.URI)),
+			Details:  AvgOverTimeResetCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
+
+func (c AvgOverTimeResetCheck) queryProblem(err error, expr parser.PromQLExpr) Problem {
+	text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+	return Problem{
+		Lines:    expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text:     text,
+		Severity: severity,
+	}
+}
@@ -0,0 +1,219 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAvgOverTimeResetCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAvgOverTimeResetCheck(prom)
+}
+
+func avgOverTimeResetText(call, rng, selector, interval, name, uri string) string {
+	return fmt.Sprintf("`%s` is using `%s` range but `%s` was reset every %s on average in the last 1w according to `%s` Prometheus server at %s, the average will drop every time a reset happens inside the time window.",
+		call, rng, selector, interval, name, uri)
+}
+
+func TestAvgOverTimeResetCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without avg_over_time()",
+			content:     "- record: foo\n  expr: max_over_time(foo[5m])\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores avg_over_time() on subqueries",
+			content:     "- record: foo\n  expr: avg_over_time(sum(foo)[5m:])\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores windows longer than lookback",
+			content:     "- record: foo\n  expr: avg_over_time(foo[2w])\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from metadata API",
+			content:     "- record: foo\n  expr: avg_over_time(foo[5m])\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgOverTimeResetCheckName,
+						Text:     checkErrorUnableToRun(checks.AvgOverTimeResetCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "ignores gauges",
+			content:     "- record: foo\n  expr: avg_over_time(foo[5m])\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "500 error from query API",
+			content:     "- record: foo\n  expr: avg_over_time(foo[5m])\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgOverTimeResetCheckName,
+						Text:     checkErrorUnableToRun(checks.AvgOverTimeResetCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "counter"}},
+					}},
+				},
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no resets",
+			content:     "- record: foo\n  expr: avg_over_time(foo[5m])\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "counter"}},
+					}},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "avg(resets(foo[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 0),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "rare resets",
+			content:     "- record: foo\n  expr: avg_over_time(foo[5m])\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "counter"}},
+					}},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "avg(resets(foo[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 7),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "frequent resets",
+			content:     "- alert: foo\n  expr: avg_over_time(foo{job=\"bar\"}[5m]) > 0\n",
+			checker:     newAvgOverTimeResetCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgOverTimeResetCheckName,
+						Text:     avgOverTimeResetText(`avg_over_time(foo{job="bar"}[5m])`, "5m", `foo{job="bar"}`, "15m", "prom", uri),
+						Details:  checks.AvgOverTimeResetCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "counter"}},
+					}},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `avg(resets(foo{job="bar"}[1w]))`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 672),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
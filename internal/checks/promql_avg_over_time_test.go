@@ -0,0 +1,214 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newAvgOverTimeCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewAvgOverTimeCheck(prom)
+}
+
+func avgOverTimeText(name, uri, metric string) string {
+	return fmt.Sprintf("`avg_over_time()` is used with `%s` which is a histogram according to metrics metadata from `%s` Prometheus server at %s, averaging histogram buckets over time doesn't produce meaningful results.", metric, name, uri)
+}
+
+func TestAvgOverTimeCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without avg_over_time()",
+			content:     "- record: foo\n  expr: max_over_time(http_request_duration_seconds_bucket[5m])\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores avg_over_time() on subqueries",
+			content:     "- record: foo\n  expr: avg_over_time(sum(http_request_duration_seconds_bucket)[5m:])\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: avg_over_time(temperature[5m])\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgOverTimeCheckName,
+						Text:     checkErrorUnableToRun(checks.AvgOverTimeCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "avg_over_time(gauge)",
+			content:     "- record: foo\n  expr: avg_over_time(temperature[5m])\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "avg_over_time(histogram)",
+			content:     "- alert: foo\n  expr: avg_over_time(http_request_duration_seconds[5m]) > 0\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgOverTimeCheckName,
+						Text:     avgOverTimeText("prom", uri, "http_request_duration_seconds"),
+						Details:  checks.AvgOverTimeCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_request_duration_seconds": {{Type: "histogram"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "avg_over_time(histogram_bucket)",
+			content:     "- record: foo\n  expr: avg_over_time(http_request_duration_seconds_bucket[5m]) / avg_over_time(http_request_duration_seconds_bucket[10m])\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgOverTimeCheckName,
+						Text:     avgOverTimeText("prom", uri, "http_request_duration_seconds_bucket"),
+						Details:  checks.AvgOverTimeCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireMetadataPath,
+						formCond{key: "metric", value: "http_request_duration_seconds_bucket"},
+					},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{}},
+				},
+				{
+					conds: []requestCondition{
+						requireMetadataPath,
+						formCond{key: "metric", value: "http_request_duration_seconds"},
+					},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_request_duration_seconds": {{Type: "histogram"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "avg_over_time(gauge_bucket)",
+			content:     "- record: foo\n  expr: avg_over_time(disk_bucket[5m])\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireMetadataPath,
+						formCond{key: "metric", value: "disk_bucket"},
+					},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"disk_bucket": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "avg_over_time(histogram) with multiple metadata entries",
+			content:     "- alert: foo\n  expr: avg_over_time(http_request_duration_seconds[5m]) > 0\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.AvgOverTimeCheckName,
+						Text:     avgOverTimeText("prom", uri, "http_request_duration_seconds"),
+						Details:  checks.AvgOverTimeCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_request_duration_seconds": {{Type: "histogram", Help: "Request duration."}, {Type: "histogram", Help: "HTTP request duration."}},
+					}},
+				},
+			},
+		},
+		{
+			description: "avg_over_time(histogram or gauge)",
+			content:     "- alert: foo\n  expr: avg_over_time(http_request_duration_seconds[5m]) > 0\n",
+			checker:     newAvgOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_request_duration_seconds": {{Type: "histogram"}, {Type: "gauge"}},
+					}},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
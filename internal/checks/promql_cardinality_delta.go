@@ -0,0 +1,144 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	CardinalityDeltaCheckName    = "promql/cardinality_delta"
+	CardinalityDeltaCheckDetails = `This rule was modified in a way that makes it return many more results than before, usually because more labels are now preserved by aggregations.
+Every extra time series uses more memory and makes queries slower, double check that this increase in cardinality is expected.`
+
+	defaultCardinalityMaxIncrease = 10
+)
+
+type CardinalityDeltaSettings struct {
+	MaxIncrease float64 `hcl:"maxIncrease,optional" json:"maxIncrease,omitempty"`
+}
+
+func (s *CardinalityDeltaSettings) Validate() error {
+	if s.MaxIncrease < 0 {
+		return errors.New("maxIncrease cannot be negative")
+	}
+	if s.MaxIncrease == 0 {
+		s.MaxIncrease = defaultCardinalityMaxIncrease
+	}
+	return nil
+}
+
+func NewCardinalityDeltaCheck(prom *promapi.FailoverGroup) CardinalityDeltaCheck {
+	return CardinalityDeltaCheck{prom: prom}
+}
+
+type CardinalityDeltaCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c CardinalityDeltaCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Modified,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c CardinalityDeltaCheck) String() string {
+	return fmt.Sprintf("%s(%s)", CardinalityDeltaCheckName, c.prom.Name())
+}
+
+func (c CardinalityDeltaCheck) Reporter() string {
+	return CardinalityDeltaCheckName
+}
+
+func (c CardinalityDeltaCheck) Check(ctx context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	var settings *CardinalityDeltaSettings
+	if s := ctx.Value(SettingsKey(c.Reporter())); s != nil {
+		settings = s.(*CardinalityDeltaSettings)
+	}
+	if settings == nil {
+		settings = &CardinalityDeltaSettings{}
+		_ = settings.Validate()
+	}
+
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	var before *parser.Rule
+	for _, entry := range entries {
+		if entry.State == discovery.Modified && entry.RuleBefore != nil &&
+			entry.Path.Name == path.Name && entry.Rule.Lines.First == rule.Lines.First {
+			before = entry.RuleBefore
+			break
+		}
+	}
+	if before == nil || before.Expr().SyntaxError != nil {
+		return problems
+	}
+	if before.Expr().Query.Expr.String() == expr.Query.Expr.String() {
+		return problems
+	}
+
+	seriesBefore, _, err := c.countSeries(ctx, before.Expr().Value.Value)
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+	// Nothing to compare with if the old query didn't return anything.
+	if seriesBefore == 0 {
+		return problems
+	}
+
+	seriesAfter, uri, err := c.countSeries(ctx, expr.Value.Value)
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	increase := float64(seriesAfter) / float64(seriesBefore)
+	if increase <= settings.MaxIncrease {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("This query returns %d result(s) on %s, the previous version of it returned %d result(s), which is a %.1fx increase, more than the %sx limit.",
+			seriesAfter, promText(c.prom.Name(), uri), seriesBefore, increase, formatFloat(settings.MaxIncrease)),
+		Details:  CardinalityDeltaCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
+
+func (c CardinalityDeltaCheck) countSeries(ctx context.Context, query string) (series int, uri string, err error) {
+	qr, err := c.prom.Query(ctx, fmt.Sprintf("count(%s)", query))
+	if err != nil {
+		return 0, "", err
+	}
+	for _, s := range qr.Series {
+		series += int(s.Value)
+	}
+	return series, qr.URI, nil
+}
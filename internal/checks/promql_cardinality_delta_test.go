@@ -0,0 +1,219 @@
+package checks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newCardinalityDeltaCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewCardinalityDeltaCheck(prom)
+}
+
+func cardinalityDeltaText(name, uri string, after, before int, increase, limit string) string {
+	return fmt.Sprintf("This query returns %d result(s) on `%s` Prometheus server at %s, the previous version of it returned %d result(s), which is a %sx increase, more than the %sx limit.", after, name, uri, before, increase, limit)
+}
+
+func modifiedEntries(before, after string) []discovery.Entry {
+	entries := mustParseContent(after)
+	old := mustParseContent(before)
+	for i := range entries {
+		entries[i].State = discovery.Modified
+		entries[i].RuleBefore = &old[i].Rule
+	}
+	return entries
+}
+
+func countResponse(value float64) responseWriter {
+	return vectorResponse{
+		samples: []*model.Sample{
+			generateSampleWithValue(map[string]string{}, value),
+		},
+	}
+}
+
+func TestCardinalityDeltaCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) by(\n",
+			checker:     newCardinalityDeltaCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			entries:     modifiedEntries("- record: foo\n  expr: sum(foo)\n", "- record: foo\n  expr: sum(foo) by(\n"),
+		},
+		{
+			description: "ignores rules without previous version",
+			content:     "- record: foo\n  expr: sum(foo) by(job, instance)\n",
+			checker:     newCardinalityDeltaCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- record: foo\n  expr: sum(foo) by(job, instance)\n"),
+		},
+		{
+			description: "ignores rules with unchanged query",
+			content:     "- record: foo\n  expr: sum(foo) by(job)\n",
+			checker:     newCardinalityDeltaCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			entries:     modifiedEntries("- record: foo\n  expr: sum(foo)   by (job)\n  labels:\n    foo: bar\n", "- record: foo\n  expr: sum(foo) by(job)\n"),
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: sum(foo) by(job, instance)\n",
+			checker:     newCardinalityDeltaCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CardinalityDeltaCheckName,
+						Text:     checkErrorUnableToRun(checks.CardinalityDeltaCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			entries: modifiedEntries("- record: foo\n  expr: sum(foo) by(job)\n", "- record: foo\n  expr: sum(foo) by(job, instance)\n"),
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "old query returned nothing",
+			content:     "- record: foo\n  expr: sum(foo) by(job, instance)\n",
+			checker:     newCardinalityDeltaCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			entries:     modifiedEntries("- record: foo\n  expr: sum(foo) by(job)\n", "- record: foo\n  expr: sum(foo) by(job, instance)\n"),
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum(foo) by(job))"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+		{
+			description: "small increase",
+			content:     "- record: foo\n  expr: sum(foo) by(job, instance)\n",
+			checker:     newCardinalityDeltaCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			entries:     modifiedEntries("- record: foo\n  expr: sum(foo) by(job)\n", "- record: foo\n  expr: sum(foo) by(job, instance)\n"),
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum(foo) by(job))"},
+					},
+					resp: countResponse(10),
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum(foo) by(job, instance))"},
+					},
+					resp: countResponse(100),
+				},
+			},
+		},
+		{
+			description: "big increase",
+			content:     "- record: foo\n  expr: sum(foo) by(job, instance)\n",
+			checker:     newCardinalityDeltaCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CardinalityDeltaCheckName,
+						Text:     cardinalityDeltaText("prom", uri, 1000, 3, "333.3", "10"),
+						Details:  checks.CardinalityDeltaCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: modifiedEntries("- record: foo\n  expr: sum(foo) by(job)\n", "- record: foo\n  expr: sum(foo) by(job, instance)\n"),
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum(foo) by(job))"},
+					},
+					resp: countResponse(3),
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum(foo) by(job, instance))"},
+					},
+					resp: countResponse(1000),
+				},
+			},
+		},
+		{
+			description: "custom maxIncrease",
+			content:     "- record: foo\n  expr: sum(foo) by(job, instance)\n",
+			checker:     newCardinalityDeltaCheck,
+			prometheus:  newSimpleProm,
+			ctx: func() context.Context {
+				s := checks.CardinalityDeltaSettings{MaxIncrease: 2.5}
+				if err := s.Validate(); err != nil {
+					t.Error(err)
+					t.FailNow()
+				}
+				return context.WithValue(context.Background(), checks.SettingsKey(checks.CardinalityDeltaCheckName), &s)
+			},
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CardinalityDeltaCheckName,
+						Text:     cardinalityDeltaText("prom", uri, 30, 10, "3.0", "2.5"),
+						Details:  checks.CardinalityDeltaCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: modifiedEntries("- record: foo\n  expr: sum(foo) by(job)\n", "- record: foo\n  expr: sum(foo) by(job, instance)\n"),
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum(foo) by(job))"},
+					},
+					resp: countResponse(10),
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum(foo) by(job, instance))"},
+					},
+					resp: countResponse(30),
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
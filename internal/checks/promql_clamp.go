@@ -0,0 +1,151 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	ClampCheckName    = "promql/clamp"
+	ClampCheckDetails = `[clamp()](https://prometheus.io/docs/prometheus/latest/querying/functions/#clamp), [clamp_min()](https://prometheus.io/docs/prometheus/latest/querying/functions/#clamp_min) and [clamp_max()](https://prometheus.io/docs/prometheus/latest/querying/functions/#clamp_max) only change values that are outside of the given bounds.
+If the metric never had values outside of those bounds then the clamp has no effect, which might indicate that the bound is using the wrong value.`
+)
+
+type ClampSettings struct{}
+
+func (s *ClampSettings) Validate() error {
+	return nil
+}
+
+func NewClampCheck(prom *promapi.FailoverGroup) ClampCheck {
+	return ClampCheck{prom: prom}
+}
+
+type ClampCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c ClampCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c ClampCheck) String() string {
+	return fmt.Sprintf("%s(%s)", ClampCheckName, c.prom.Name())
+}
+
+func (c ClampCheck) Reporter() string {
+	return ClampCheckName
+}
+
+func (c ClampCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+
+		var minArg, maxArg promParser.Node
+		switch call.Func.Name {
+		case "clamp_min":
+			minArg = call.Args[1]
+		case "clamp_max":
+			maxArg = call.Args[1]
+		case "clamp":
+			minArg, maxArg = call.Args[1], call.Args[2]
+		default:
+			continue
+		}
+
+		vs, ok := unwrapParens(call.Args[0]).(*promParser.VectorSelector)
+		if !ok {
+			continue
+		}
+
+		if bound, ok := clampBound(minArg); ok {
+			if problem, found := c.checkBound(ctx, expr, call.Func.Name, vs, "min", bound); found {
+				problems = append(problems, problem)
+			}
+		}
+		if bound, ok := clampBound(maxArg); ok {
+			if problem, found := c.checkBound(ctx, expr, call.Func.Name, vs, "max", bound); found {
+				problems = append(problems, problem)
+			}
+		}
+	}
+
+	return problems
+}
+
+func (c ClampCheck) checkBound(ctx context.Context, expr parser.PromQLExpr, fn string, vs *promParser.VectorSelector, op string, bound float64) (problem Problem, found bool) {
+	selector := promParser.VectorSelector{
+		Name:          vs.Name,
+		LabelMatchers: vs.LabelMatchers,
+	}
+	qr, err := c.prom.Query(ctx, fmt.Sprintf("%s(%s_over_time(%s[1w]))", op, op, selector.String()))
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+		return Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		}, true
+	}
+	if len(qr.Series) == 0 {
+		return problem, false
+	}
+
+	value := qr.Series[0].Value
+	var text string
+	switch {
+	case op == "min" && value >= bound:
+		text = fmt.Sprintf("`%s()` lower bound is %s but `%s` was never lower than %s in the last week according to %s, this bound has no effect.",
+			fn, formatFloat(bound), selector.String(), formatFloat(value), promText(c.prom.Name(), qr.URI))
+	case op == "max" && value <= bound:
+		text = fmt.Sprintf("`%s()` upper bound is %s but `%s` was never higher than %s in the last week according to %s, this bound has no effect.",
+			fn, formatFloat(bound), selector.String(), formatFloat(value), promText(c.prom.Name(), qr.URI))
+	default:
+		return problem, false
+	}
+
+	return Problem{
+		Lines:    expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text:     text,
+		Details:  ClampCheckDetails,
+		Severity: Information,
+	}, true
+}
+
+func clampBound(node promParser.Node) (float64, bool) {
+	if node == nil {
+		return 0, false
+	}
+	n, ok := unwrapParens(node).(*promParser.NumberLiteral)
+	if !ok {
+		return 0, false
+	}
+	return n.Val, true
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
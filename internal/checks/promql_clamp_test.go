@@ -0,0 +1,262 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newClampCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewClampCheck(prom)
+}
+
+func clampMinText(name, uri, fn, bound, metric, value string) string {
+	return fmt.Sprintf("`%s()` lower bound is %s but `%s` was never lower than %s in the last week according to `%s` Prometheus server at %s, this bound has no effect.", fn, bound, metric, value, name, uri)
+}
+
+func clampMaxText(name, uri, fn, bound, metric, value string) string {
+	return fmt.Sprintf("`%s()` upper bound is %s but `%s` was never higher than %s in the last week according to `%s` Prometheus server at %s, this bound has no effect.", fn, bound, metric, value, name, uri)
+}
+
+func TestClampCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: clamp_min(foo, 1\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without clamp",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores clamp on expressions",
+			content:     "- record: foo\n  expr: clamp_min(sum(foo), 1)\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores clamp with non-literal bounds",
+			content:     "- record: foo\n  expr: clamp_max(foo, scalar(bar))\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: clamp_min(foo, 100)\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ClampCheckName,
+						Text:     checkErrorUnableToRun(checks.ClampCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no data",
+			content:     "- record: foo\n  expr: clamp_min(foo, 100)\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(foo[1w]))"},
+					},
+					resp: vectorResponse{samples: []*model.Sample{}},
+				},
+			},
+		},
+		{
+			description: "clamp_min with effect",
+			content:     "- record: foo\n  expr: clamp_min(foo, 100)\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(foo[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 10),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "clamp_min without effect",
+			content:     "- record: foo\n  expr: clamp_min(foo{job=\"bar\"}, 100)\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ClampCheckName,
+						Text:     clampMinText("prom", uri, "clamp_min", "100", `foo{job="bar"}`, "200.5"),
+						Details:  checks.ClampCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `min(min_over_time(foo{job="bar"}[1w]))`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 200.5),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "clamp_min with offset",
+			content:     "- record: foo\n  expr: clamp_min(foo{job=\"bar\"} offset 5m, 100)\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ClampCheckName,
+						Text:     clampMinText("prom", uri, "clamp_min", "100", `foo{job="bar"}`, "200.5"),
+						Details:  checks.ClampCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `min(min_over_time(foo{job="bar"}[1w]))`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 200.5),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "clamp_max without effect",
+			content:     "- record: foo\n  expr: clamp_max((foo), (1))\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ClampCheckName,
+						Text:     clampMaxText("prom", uri, "clamp_max", "1", "foo", "0.5"),
+						Details:  checks.ClampCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "max(max_over_time(foo[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 0.5),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "clamp with both bounds",
+			content:     "- record: foo\n  expr: clamp(foo, 0, 100)\n",
+			checker:     newClampCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ClampCheckName,
+						Text:     clampMinText("prom", uri, "clamp", "0", "foo", "5"),
+						Details:  checks.ClampCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(foo[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 5),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "max(max_over_time(foo[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 150),
+						},
+					},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,149 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/prometheus/prometheus/model/labels"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	ComplementSelectorCheckName    = "promql/complement_selector"
+	ComplementSelectorCheckDetails = `Two rules in the same file are using selectors for the same metric with opposite label matchers, like ` + "`env=\"prod\"`" + ` and ` + "`env!=\"prod\"`" + `.
+Together these rules will cover all time series of that metric, while each rule on its own will only see a part of them.
+This is often intentional, for example when using different thresholds for different environments, but it's worth checking that this split is what you want.`
+)
+
+func NewComplementSelectorCheck() ComplementSelectorCheck {
+	return ComplementSelectorCheck{}
+}
+
+type ComplementSelectorCheck struct{}
+
+func (c ComplementSelectorCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c ComplementSelectorCheck) String() string {
+	return ComplementSelectorCheckName
+}
+
+func (c ComplementSelectorCheck) Reporter() string {
+	return ComplementSelectorCheckName
+}
+
+func (c ComplementSelectorCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	selectors := getSelectors(expr.Query)
+	if len(selectors) == 0 {
+		return problems
+	}
+
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if entry.Path.Name != path.Name {
+			continue
+		}
+		if entry.Rule.Lines.First == rule.Lines.First {
+			continue
+		}
+		if entry.Rule.Expr().SyntaxError != nil {
+			continue
+		}
+		for _, other := range getSelectors(entry.Rule.Expr().Query) {
+			for _, selector := range selectors {
+				lm, ok := complementMatcher(selector, other)
+				if !ok {
+					continue
+				}
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text: fmt.Sprintf("`%s` is using `%s` while `%s` rule at %s:%d is using `%s` on the same metric, together both rules cover all `%s` series, make sure this split is intentional.",
+						selector.String(), lm, entry.Rule.Name(), entry.Path.SymlinkTarget, entry.Rule.Lines.First, complementOf(lm), selector.Name),
+					Details:  ComplementSelectorCheckDetails,
+					Severity: Information,
+				})
+				break
+			}
+		}
+	}
+
+	return problems
+}
+
+// complementMatcher returns the matcher from a that is the opposite of one
+// of the matchers on b, if both selectors are for the same metric and all
+// other matchers are identical.
+func complementMatcher(a, b promParser.VectorSelector) (*labels.Matcher, bool) {
+	if a.Name == "" || a.Name != b.Name {
+		return nil, false
+	}
+
+	am := nonNameMatchers(a)
+	bm := nonNameMatchers(b)
+	if len(am) != len(bm) {
+		return nil, false
+	}
+
+	for _, lm := range a.LabelMatchers {
+		if lm.Name == labels.MetricName {
+			continue
+		}
+		inv := complementOf(lm).String()
+		if !slices.Contains(bm, inv) {
+			continue
+		}
+		ar := slices.DeleteFunc(slices.Clone(am), func(s string) bool { return s == lm.String() })
+		br := slices.DeleteFunc(slices.Clone(bm), func(s string) bool { return s == inv })
+		if slices.Equal(ar, br) {
+			return lm, true
+		}
+	}
+	return nil, false
+}
+
+func nonNameMatchers(vs promParser.VectorSelector) (ms []string) {
+	for _, lm := range vs.LabelMatchers {
+		if lm.Name == labels.MetricName {
+			continue
+		}
+		ms = append(ms, lm.String())
+	}
+	slices.Sort(ms)
+	return ms
+}
+
+func complementOf(lm *labels.Matcher) *labels.Matcher {
+	var mt labels.MatchType
+	switch lm.Type {
+	case labels.MatchEqual:
+		mt = labels.MatchNotEqual
+	case labels.MatchNotEqual:
+		mt = labels.MatchEqual
+	case labels.MatchRegexp:
+		mt = labels.MatchNotRegexp
+	case labels.MatchNotRegexp:
+		mt = labels.MatchRegexp
+	}
+	return labels.MustNewMatcher(mt, lm.Name, lm.Value)
+}
@@ -0,0 +1,116 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newComplementSelectorCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewComplementSelectorCheck()
+}
+
+func TestComplementSelectorCheck(t *testing.T) {
+	otherFile := mustParseContent("- alert: foo\n  expr: foo{env=\"prod\"} > 0\n\n- alert: bar\n  expr: foo{env!=\"prod\"} > 10\n")
+	for i := range otherFile {
+		otherFile[i].Path.Name = "other.yml"
+		otherFile[i].Path.SymlinkTarget = "other.yml"
+	}
+
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: sum(foo) without(\n",
+			checker:     newComplementSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- alert: foo\n  expr: sum(foo) without(\n\n- alert: bar\n  expr: foo{env!=\"prod\"} > 10\n"),
+		},
+		{
+			description: "ignores self",
+			content:     "- alert: foo\n  expr: foo{env=\"prod\"} > 0\n",
+			checker:     newComplementSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- alert: foo\n  expr: foo{env=\"prod\"} > 0\n"),
+		},
+		{
+			description: "ignores rules from other files",
+			content:     "- alert: foo\n  expr: foo{env=\"prod\"} > 0\n",
+			checker:     newComplementSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherFile,
+		},
+		{
+			description: "ignores different metrics",
+			content:     "- alert: foo\n  expr: foo{env=\"prod\"} > 0\n",
+			checker:     newComplementSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- alert: foo\n  expr: foo{env=\"prod\"} > 0\n\n- alert: bar\n  expr: bar{env!=\"prod\"} > 10\n"),
+		},
+		{
+			description: "ignores different values",
+			content:     "- alert: foo\n  expr: foo{env=\"prod\"} > 0\n",
+			checker:     newComplementSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- alert: foo\n  expr: foo{env=\"prod\"} > 0\n\n- alert: bar\n  expr: foo{env!=\"dev\"} > 10\n"),
+		},
+		{
+			description: "ignores selectors with other different matchers",
+			content:     "- alert: foo\n  expr: foo{env=\"prod\", job=\"a\"} > 0\n",
+			checker:     newComplementSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- alert: foo\n  expr: foo{env=\"prod\", job=\"a\"} > 0\n\n- alert: bar\n  expr: foo{env!=\"prod\", job=\"b\"} > 10\n"),
+		},
+		{
+			description: "complementary equality matchers",
+			content:     "- alert: foo\n  expr: foo{env=\"prod\"} > 0\n",
+			checker:     newComplementSelectorCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ComplementSelectorCheckName,
+						Text:     "`foo{env=\"prod\"}` is using `env=\"prod\"` while `bar` rule at fake.yml:4 is using `env!=\"prod\"` on the same metric, together both rules cover all `foo` series, make sure this split is intentional.",
+						Details:  checks.ComplementSelectorCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			entries: mustParseContent("- alert: foo\n  expr: foo{env=\"prod\"} > 0\n\n- alert: bar\n  expr: foo{env!=\"prod\"} > 10\n"),
+		},
+		{
+			description: "complementary regexp matchers",
+			content:     "- record: foo:sum\n  expr: sum(foo{job=\"a\", env!~\"dev|test\"})\n",
+			checker:     newComplementSelectorCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ComplementSelectorCheckName,
+						Text:     "`foo{env!~\"dev|test\",job=\"a\"}` is using `env!~\"dev|test\"` while `foo:test` rule at fake.yml:4 is using `env=~\"dev|test\"` on the same metric, together both rules cover all `foo` series, make sure this split is intentional.",
+						Details:  checks.ComplementSelectorCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			entries: mustParseContent("- record: foo:sum\n  expr: sum(foo{job=\"a\", env!~\"dev|test\"})\n\n- record: foo:test\n  expr: sum(foo{env=~\"dev|test\", job=\"a\"})\n"),
+		},
+	}
+
+	runTests(t, testCases)
+}
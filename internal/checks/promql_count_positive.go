@@ -0,0 +1,130 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	CountAlwaysPositiveCheckName    = "promql/count_positive"
+	CountAlwaysPositiveCheckDetails = `[count()](https://prometheus.io/docs/prometheus/latest/querying/operators/#aggregation-operators) never returns ` + "`0`" + `, if there are no matching time series it will return no results instead.
+This means that comparing ` + "`count(...)`" + ` with ` + "`0`" + ` will never be true.
+If you want to know when a metric is missing use [absent()](https://prometheus.io/docs/prometheus/latest/querying/functions/#absent) instead.`
+
+	countPositiveLookback = time.Hour * 24 * 7
+	countPositiveStep     = time.Minute * 5
+)
+
+type CountAlwaysPositiveSettings struct{}
+
+func (s *CountAlwaysPositiveSettings) Validate() error {
+	return nil
+}
+
+func NewCountAlwaysPositiveCheck(prom *promapi.FailoverGroup) CountAlwaysPositiveCheck {
+	return CountAlwaysPositiveCheck{prom: prom}
+}
+
+type CountAlwaysPositiveCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c CountAlwaysPositiveCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c CountAlwaysPositiveCheck) String() string {
+	return fmt.Sprintf("%s(%s)", CountAlwaysPositiveCheckName, c.prom.Name())
+}
+
+func (c CountAlwaysPositiveCheck) Reporter() string {
+	return CountAlwaysPositiveCheckName
+}
+
+func (c CountAlwaysPositiveCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	params := promapi.NewRelativeRange(countPositiveLookback, countPositiveStep)
+
+	for _, node := range parser.WalkDownExpr[*promParser.BinaryExpr](expr.Query) {
+		binExpr := node.Expr.(*promParser.BinaryExpr)
+		if binExpr.Op != promParser.EQLC {
+			continue
+		}
+
+		vs, ok := countedSelector(binExpr.LHS, binExpr.RHS)
+		if !ok {
+			vs, ok = countedSelector(binExpr.RHS, binExpr.LHS)
+		}
+		if !ok {
+			continue
+		}
+		selector := promParser.VectorSelector{Name: vs.Name, LabelMatchers: vs.LabelMatchers}
+
+		slog.Debug("Checking if counted selector was always present", slog.String("check", c.Reporter()), slog.String("selector", selector.String()))
+		trs, err := c.prom.RangeQuery(ctx, fmt.Sprintf("count(%s)", selector.String()), params)
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+
+		if len(trs.Series.Ranges) != 1 ||
+			oldest(trs.Series.Ranges).After(trs.Series.From.Add(params.Step())) ||
+			newest(trs.Series.Ranges).Before(trs.Series.Until.Add(params.Step()*-1)) {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` will never be true because `count()` never returns `0` and `%s` was present on %s for the whole last %s.",
+				binExpr, selector.String(), promText(c.prom.Name(), trs.URI), output.HumanizeDuration(params.Dur())),
+			Details:  CountAlwaysPositiveCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
+
+// countedSelector returns the selector passed to count() if expr is
+// a count() call on a plain selector and other is a literal zero.
+func countedSelector(expr, other promParser.Expr) (*promParser.VectorSelector, bool) {
+	n, ok := unwrapParens(other).(*promParser.NumberLiteral)
+	if !ok || n.Val != 0 {
+		return nil, false
+	}
+	agg, ok := unwrapParens(expr).(*promParser.AggregateExpr)
+	if !ok || agg.Op != promParser.COUNT {
+		return nil, false
+	}
+	vs, ok := unwrapParens(agg.Expr).(*promParser.VectorSelector)
+	return vs, ok
+}
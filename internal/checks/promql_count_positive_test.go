@@ -0,0 +1,129 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newCountAlwaysPositiveCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewCountAlwaysPositiveCheck(prom)
+}
+
+func countPositiveText(name, uri, expr, selector string) string {
+	return fmt.Sprintf("`%s` will never be true because `count()` never returns `0` and `%s` was present on `%s` Prometheus server at %s for the whole last 1w.", expr, selector, name, uri)
+}
+
+func TestCountAlwaysPositiveCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: count(up) ==\n",
+			checker:     newCountAlwaysPositiveCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores other comparisons",
+			content:     "- alert: foo\n  expr: count(up) < 1\n",
+			checker:     newCountAlwaysPositiveCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores comparison with other numbers",
+			content:     "- alert: foo\n  expr: count(up) == 1\n",
+			checker:     newCountAlwaysPositiveCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores other aggregations",
+			content:     "- alert: foo\n  expr: sum(up) == 0\n",
+			checker:     newCountAlwaysPositiveCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores count() with complex queries",
+			content:     "- alert: foo\n  expr: count(up == 0) == 0\n",
+			checker:     newCountAlwaysPositiveCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- alert: foo\n  expr: count(up{job=\"foo\"}) == 0\n",
+			checker:     newCountAlwaysPositiveCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CountAlwaysPositiveCheckName,
+						Text:     checkErrorUnableToRun(checks.CountAlwaysPositiveCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "metric sometimes present",
+			content:     "- alert: foo\n  expr: count(up{job=\"foo\"}) == 0\n",
+			checker:     newCountAlwaysPositiveCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: `count(up{job="foo"})`},
+					},
+					resp: respondWithSingleRangeVector1D(),
+				},
+			},
+		},
+		{
+			description: "metric always present",
+			content:     "- alert: foo\n  expr: 0 == count(up{job=\"foo\"}) by (instance)\n",
+			checker:     newCountAlwaysPositiveCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CountAlwaysPositiveCheckName,
+						Text:     countPositiveText("prom", uri, `0 == count by (instance) (up{job="foo"})`, `up{job="foo"}`),
+						Details:  checks.CountAlwaysPositiveCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: `count(up{job="foo"})`},
+					},
+					resp: respondWithSingleRangeVector1W(),
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,82 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	CountValuesCheckName    = "promql/count_values"
+	CountValuesCheckDetails = `The first argument of [count_values()](https://prometheus.io/docs/prometheus/latest/querying/operators/#aggregation-operators) is the name of the label that will store sample values.
+It must be a valid label name, otherwise the query will fail when evaluated.`
+)
+
+var labelNameRe = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
+
+func NewCountValuesCheck() CountValuesCheck {
+	return CountValuesCheck{}
+}
+
+type CountValuesCheck struct{}
+
+func (c CountValuesCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c CountValuesCheck) String() string {
+	return CountValuesCheckName
+}
+
+func (c CountValuesCheck) Reporter() string {
+	return CountValuesCheckName
+}
+
+func (c CountValuesCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.AggregateExpr](expr.Query) {
+		aggr := node.Expr.(*promParser.AggregateExpr)
+		if aggr.Op != promParser.COUNT_VALUES {
+			continue
+		}
+
+		s, ok := unwrapParens(aggr.Param).(*promParser.StringLiteral)
+		if !ok || labelNameRe.MatchString(s.Val) {
+			continue
+		}
+
+		var text string
+		if s.Val == "" {
+			text = "`count_values()` is using an empty string as the label name, this query will fail when evaluated."
+		} else {
+			text = fmt.Sprintf("`count_values()` is using `%s` as the label name, which is not a valid label name, this query will fail when evaluated.", s.Val)
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Details:  CountValuesCheckDetails,
+			Severity: Bug,
+		})
+	}
+
+	return problems
+}
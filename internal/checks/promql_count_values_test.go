@@ -0,0 +1,108 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newCountValuesCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewCountValuesCheck()
+}
+
+func TestCountValuesCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: count_values(\"\", foo) without(\n",
+			checker:     newCountValuesCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores other aggregations",
+			content:     "- record: foo\n  expr: topk(5, foo)\n",
+			checker:     newCountValuesCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "valid label name",
+			content:     "- record: foo\n  expr: count_values(\"version\", build_info)\n",
+			checker:     newCountValuesCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "valid label name with underscore",
+			content:     "- record: foo\n  expr: count_values(\"_build_version2\", build_info)\n",
+			checker:     newCountValuesCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "empty label name",
+			content:     "- record: foo\n  expr: count_values(\"\", build_info)\n",
+			checker:     newCountValuesCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CountValuesCheckName,
+						Text:     "`count_values()` is using an empty string as the label name, this query will fail when evaluated.",
+						Details:  checks.CountValuesCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "invalid label name",
+			content:     "- alert: foo\n  expr: sum(count_values(\"build-version\", build_info)) > 1\n",
+			checker:     newCountValuesCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CountValuesCheckName,
+						Text:     "`count_values()` is using `build-version` as the label name, which is not a valid label name, this query will fail when evaluated.",
+						Details:  checks.CountValuesCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "label name starting with a digit",
+			content:     "- record: foo\n  expr: count_values(\"1version\", build_info)\n",
+			checker:     newCountValuesCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CountValuesCheckName,
+						Text:     "`count_values()` is using `1version` as the label name, which is not a valid label name, this query will fail when evaluated.",
+						Details:  checks.CountValuesCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -3,6 +3,7 @@ package checks
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	promParser "github.com/prometheus/prometheus/promql/parser"
@@ -25,7 +26,13 @@ func NewCounterCheck(prom *promapi.FailoverGroup) CounterCheck {
 }
 
 type CounterCheck struct {
-	prom *promapi.FailoverGroup
+	prom          *promapi.FailoverGroup
+	enabledChecks []string
+}
+
+func (c CounterCheck) WithEnabledChecks(names []string) RuleChecker {
+	c.enabledChecks = names
+	return c
 }
 
 func (c CounterCheck) Meta() CheckMeta {
@@ -65,12 +72,23 @@ LOOP:
 			continue LOOP
 		}
 
-		for _, call := range parser.WalkUpExpr[*promParser.Call](vs.Parent) {
-			if fn := call.Expr.(*promParser.Call); c.isSafeFunc(fn.Func.Name) {
+		for i, call := range parser.WalkUpExpr[*promParser.Call](vs.Parent) {
+			fn := call.Expr.(*promParser.Call)
+			if c.isSafeFunc(fn.Func.Name) {
 				// This might be a counter but it's wrapped in one of the functions that make it
 				// safe to use.
 				continue LOOP
 			}
+			if i == 0 && c.hasDedicatedCheck(fn.Func.Name) {
+				// This might be a counter passed directly to a function that has its own check
+				// reporting counter usage enabled, don't report it twice.
+				continue LOOP
+			}
+		}
+
+		if isAvgOfSelector(vs) && c.isEnabled(AvgCounterCheckName) {
+			// This might be a counter passed directly to avg(), promql/avg_counter will report it.
+			continue LOOP
 		}
 
 		for _, aggr := range parser.WalkUpExpr[*promParser.AggregateExpr](vs.Parent) {
@@ -107,16 +125,9 @@ LOOP:
 			})
 			continue LOOP
 		}
-		if len(metadata.Metadata) == 0 {
-			// No metadata so we don't know what type it uses.
+		if !isCounterMetadata(metadata.Metadata) {
 			continue LOOP
 		}
-		for _, m := range metadata.Metadata {
-			if m.Type != v1.MetricTypeCounter {
-				// There's metadata with non-counter type, so it's not always a counter.
-				continue LOOP
-			}
-		}
 		problems = append(problems, Problem{
 			Lines:    expr.Value.Lines,
 			Reporter: c.Reporter(),
@@ -152,3 +163,45 @@ func (c CounterCheck) isSafeFunc(name string) bool {
 		return false
 	}
 }
+
+func (c CounterCheck) hasDedicatedCheck(name string) bool {
+	switch name {
+	case "deriv":
+		return c.isEnabled(DerivCheckName)
+	case "sum_over_time":
+		return c.isEnabled(SumOverTimeCheckName)
+	case "max_over_time", "min_over_time":
+		return c.isEnabled(MaxOverTimeCheckName)
+	default:
+		return false
+	}
+}
+
+// isEnabled returns true if given check is enabled for the same
+// Prometheus server as this check.
+func (c CounterCheck) isEnabled(name string) bool {
+	return slices.Contains(c.enabledChecks, fmt.Sprintf("%s(%s)", name, c.prom.Name()))
+}
+
+// isCounterMetadata returns true only if all metadata entries are using
+// the counter type.
+func isCounterMetadata(metadata []v1.Metadata) bool {
+	typ, ok := metadataType(metadata)
+	return ok && typ == v1.MetricTypeCounter
+}
+
+// metadataType returns the metric type if all metadata entries agree on it.
+func metadataType(metadata []v1.Metadata) (v1.MetricType, bool) {
+	if len(metadata) == 0 {
+		// No metadata so we don't know what type it uses.
+		return "", false
+	}
+	typ := metadata[0].Type
+	for _, m := range metadata[1:] {
+		if m.Type != typ {
+			// There's metadata with different types, so we can't tell which one is used.
+			return "", false
+		}
+	}
+	return typ, true
+}
@@ -15,6 +15,12 @@ func newCounterCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
 	return checks.NewCounterCheck(prom)
 }
 
+func newCounterCheckWithEnabled(names ...string) func(*promapi.FailoverGroup) checks.RuleChecker {
+	return func(prom *promapi.FailoverGroup) checks.RuleChecker {
+		return checks.NewCounterCheck(prom).WithEnabledChecks(names)
+	}
+}
+
 func counterText(name, uri, metric string) string {
 	return fmt.Sprintf("`%s` is a counter according to metrics metadata from `%s` Prometheus server at %s, you can't use its value directly.", metric, name, uri)
 }
@@ -28,6 +34,41 @@ func TestCounterCheck(t *testing.T) {
 			prometheus:  newSimpleProm,
 			problems:    noProblems,
 		},
+		{
+			description: "avg(counter)",
+			content:     "- record: foo\n  expr: avg((http_requests_total)) by (job)\n",
+			checker:     newCounterCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CounterCheckName,
+						Text:     counterText("prom", uri, "http_requests_total"),
+						Details:  checks.CounterCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "ignores avg(counter) when promql/avg_counter is enabled",
+			content:     "- record: foo\n  expr: avg((http_requests_total)) by (job)\n",
+			checker:     newCounterCheckWithEnabled("promql/avg_counter(prom)"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
 		{
 			description: "500 error from Prometheus API",
 			content:     "- record: foo\n  expr: http_requests_total > 1\n",
@@ -352,6 +393,189 @@ func TestCounterCheck(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "deriv(counter)",
+			content:     "- alert: my alert\n  expr: deriv(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CounterCheckName,
+						Text:     counterText("prom", uri, "http_requests_total"),
+						Details:  checks.CounterCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "ignores deriv(counter) when promql/deriv is enabled",
+			content:     "- alert: my alert\n  expr: deriv(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheckWithEnabled("promql/deriv(prom)"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "deriv(counter) with promql/deriv enabled for another server",
+			content:     "- alert: my alert\n  expr: deriv(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheckWithEnabled("promql/deriv(other)"),
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CounterCheckName,
+						Text:     counterText("prom", uri, "http_requests_total"),
+						Details:  checks.CounterCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "sum_over_time(counter)",
+			content:     "- alert: my alert\n  expr: sum_over_time(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CounterCheckName,
+						Text:     counterText("prom", uri, "http_requests_total"),
+						Details:  checks.CounterCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "ignores sum_over_time(counter) when promql/sum_over_time is enabled",
+			content:     "- alert: my alert\n  expr: sum_over_time(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheckWithEnabled("promql/sum_over_time(prom)"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "sum_over_time(gauge)",
+			content:     "- record: foo\n  expr: sum_over_time(temperature[5m])\n",
+			checker:     newCounterCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "max_over_time(counter)",
+			content:     "- alert: my alert\n  expr: max_over_time(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CounterCheckName,
+						Text:     counterText("prom", uri, "http_requests_total"),
+						Details:  checks.CounterCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "min_over_time(counter)",
+			content:     "- alert: my alert\n  expr: min_over_time(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CounterCheckName,
+						Text:     counterText("prom", uri, "http_requests_total"),
+						Details:  checks.CounterCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "ignores max_over_time(counter) when promql/max_over_time is enabled",
+			content:     "- alert: my alert\n  expr: max_over_time(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheckWithEnabled("promql/max_over_time(prom)"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores min_over_time(counter) when promql/max_over_time is enabled",
+			content:     "- alert: my alert\n  expr: min_over_time(http_requests_total[2m]) > 0\n",
+			checker:     newCounterCheckWithEnabled("promql/max_over_time(prom)"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
 		{
 			description: "counter > 1 / no metadata",
 			content: `
@@ -0,0 +1,136 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	DateTimeFunctionCheckName    = "promql/date_time"
+	DateTimeFunctionCheckDetails = `Date and time functions like [hour()](https://prometheus.io/docs/prometheus/latest/querying/functions/#hour) called without any argument return a single time series with no labels.
+Binary operations between two instant vectors will only match time series with identical labels, so joining a metric with such function result will never match anything unless the operation uses ` + "`on()`" + `.
+Example: ` + "`foo > 0 and on() hour() > 8`" + `.`
+)
+
+var dateTimeFunctions = []string{
+	"minute",
+	"hour",
+	"day_of_week",
+	"day_of_month",
+	"day_of_year",
+	"days_in_month",
+	"month",
+	"year",
+}
+
+func NewDateTimeFunctionCheck() DateTimeFunctionCheck {
+	return DateTimeFunctionCheck{}
+}
+
+type DateTimeFunctionCheck struct{}
+
+func (c DateTimeFunctionCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c DateTimeFunctionCheck) String() string {
+	return DateTimeFunctionCheckName
+}
+
+func (c DateTimeFunctionCheck) Reporter() string {
+	return DateTimeFunctionCheckName
+}
+
+func (c DateTimeFunctionCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.BinaryExpr](expr.Query) {
+		binExp := node.Expr.(*promParser.BinaryExpr)
+		if binExp.VectorMatching == nil {
+			// One side is a scalar, no label matching is done.
+			continue
+		}
+		if binExp.VectorMatching.On && len(binExp.VectorMatching.MatchingLabels) == 0 {
+			continue
+		}
+
+		var call, other promParser.Expr
+		switch {
+		case dateTimeCall(binExp.LHS) != nil:
+			call, other = dateTimeCall(binExp.LHS), binExp.RHS
+		case dateTimeCall(binExp.RHS) != nil:
+			call, other = dateTimeCall(binExp.RHS), binExp.LHS
+		default:
+			continue
+		}
+		if hasNoLabels(other) {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` returns a time series with no labels, joining it with `%s` in `%s` will never match anything without `on()`.",
+				call, other, binExp),
+			Details:  DateTimeFunctionCheckDetails,
+			Severity: Bug,
+		})
+	}
+
+	return problems
+}
+
+// dateTimeCall returns a date/time function call without arguments
+// if given expression is only using it together with scalars.
+func dateTimeCall(expr promParser.Expr) promParser.Expr {
+	switch n := expr.(type) {
+	case *promParser.ParenExpr:
+		return dateTimeCall(n.Expr)
+	case *promParser.Call:
+		if len(n.Args) == 0 && slices.Contains(dateTimeFunctions, n.Func.Name) {
+			return n
+		}
+	case *promParser.BinaryExpr:
+		if n.LHS.Type() == promParser.ValueTypeScalar {
+			return dateTimeCall(n.RHS)
+		}
+		if n.RHS.Type() == promParser.ValueTypeScalar {
+			return dateTimeCall(n.LHS)
+		}
+	}
+	return nil
+}
+
+// hasNoLabels returns true if given expression will always return
+// time series without any labels.
+func hasNoLabels(expr promParser.Expr) bool {
+	switch n := expr.(type) {
+	case *promParser.ParenExpr:
+		return hasNoLabels(n.Expr)
+	case *promParser.BinaryExpr:
+		if n.LHS.Type() == promParser.ValueTypeScalar {
+			return hasNoLabels(n.RHS)
+		}
+		if n.RHS.Type() == promParser.ValueTypeScalar {
+			return hasNoLabels(n.LHS)
+		}
+	}
+	return dateTimeCall(expr) != nil || isSingleSeries(expr)
+}
@@ -0,0 +1,102 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newDateTimeFunctionCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewDateTimeFunctionCheck()
+}
+
+func TestDateTimeFunctionCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: foo * hour(\n",
+			checker:     newDateTimeFunctionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores comparison with scalar",
+			content:     "- alert: foo\n  expr: hour() > 8\n",
+			checker:     newDateTimeFunctionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores empty on()",
+			content:     "- alert: foo\n  expr: foo > 0 and on() hour() > 8\n",
+			checker:     newDateTimeFunctionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores date functions with arguments",
+			content:     "- record: foo\n  expr: foo * hour(timestamp(foo))\n",
+			checker:     newDateTimeFunctionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores joins between date functions",
+			content:     "- alert: foo\n  expr: hour() > 8 and day_of_week() < 6\n",
+			checker:     newDateTimeFunctionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores aggregations without grouping",
+			content:     "- alert: foo\n  expr: sum(foo) > 0 and hour() > 8\n",
+			checker:     newDateTimeFunctionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "multiplication without on()",
+			content:     "- record: foo\n  expr: foo * hour()\n",
+			checker:     newDateTimeFunctionCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DateTimeFunctionCheckName,
+						Text:     "`hour()` returns a time series with no labels, joining it with `foo` in `foo * hour()` will never match anything without `on()`.",
+						Details:  checks.DateTimeFunctionCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "and with on(labels)",
+			content:     "- alert: foo\n  expr: sum(foo) by(job) > 0 and on(job) (day_of_week() < 6)\n",
+			checker:     newDateTimeFunctionCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DateTimeFunctionCheckName,
+						Text:     "`day_of_week()` returns a time series with no labels, joining it with `sum by (job) (foo) > 0` in `sum by (job) (foo) > 0 and on (job) (day_of_week() < 6)` will never match anything without `on()`.",
+						Details:  checks.DateTimeFunctionCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
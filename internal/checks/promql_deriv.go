@@ -0,0 +1,105 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	DerivCheckName    = "promql/deriv"
+	DerivCheckDetails = `[deriv()](https://prometheus.io/docs/prometheus/latest/querying/functions/#deriv) calculates the per-second derivative of the time series using simple linear regression and should only be used with gauges.
+[Counters](https://prometheus.io/docs/concepts/metric_types/#counter) can reset to zero when your application restarts and [deriv()](https://prometheus.io/docs/prometheus/latest/querying/functions/#deriv) will see every reset as a large negative change.
+To calculate how fast a counter is growing use the [rate()](https://prometheus.io/docs/prometheus/latest/querying/functions/#rate) function instead, it handles counter resets correctly.`
+)
+
+func NewDerivCheck(prom *promapi.FailoverGroup) DerivCheck {
+	return DerivCheck{prom: prom}
+}
+
+type DerivCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c DerivCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c DerivCheck) String() string {
+	return fmt.Sprintf("%s(%s)", DerivCheckName, c.prom.Name())
+}
+
+func (c DerivCheck) Reporter() string {
+	return DerivCheckName
+}
+
+func (c DerivCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "deriv" {
+			continue
+		}
+
+		for _, arg := range call.Args {
+			ms, ok := arg.(*promParser.MatrixSelector)
+			if !ok {
+				continue
+			}
+			vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+			if !ok || vs.Name == "" {
+				continue
+			}
+
+			if _, ok := done[vs.Name]; ok {
+				continue
+			}
+			done[vs.Name] = struct{}{}
+
+			metadata, err := c.prom.Metadata(ctx, vs.Name)
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				continue
+			}
+			if !isCounterMetadata(metadata.Metadata) {
+				continue
+			}
+
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`deriv()` should only be used with gauges but `%s` is a counter according to metrics metadata from %s, use `rate()` instead.",
+					vs.Name, promText(c.prom.Name(), metadata.URI)),
+				Details:  DerivCheckDetails,
+				Severity: Bug,
+			})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,171 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newDerivCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewDerivCheck(prom)
+}
+
+func derivText(name, uri, metric string) string {
+	return fmt.Sprintf("`deriv()` should only be used with gauges but `%s` is a counter according to metrics metadata from `%s` Prometheus server at %s, use `rate()` instead.", metric, name, uri)
+}
+
+func TestDerivCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without deriv()",
+			content:     "- record: foo\n  expr: rate(http_requests_total[5m])\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores deriv() on subqueries",
+			content:     "- record: foo\n  expr: deriv(sum(temperature)[5m:])\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: deriv(http_requests_total[5m])\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DerivCheckName,
+						Text:     checkErrorUnableToRun(checks.DerivCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "deriv(gauge)",
+			content:     "- record: foo\n  expr: deriv(temperature[5m])\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "deriv(counter) / no metadata",
+			content:     "- record: foo\n  expr: deriv(http_requests_total[5m])\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  metadataResponse{metadata: map[string][]v1.Metadata{}},
+				},
+			},
+		},
+		{
+			description: "deriv(counter) / mixed metadata",
+			content:     "- record: foo\n  expr: deriv(http_requests_total[5m])\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}, {Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "deriv(counter)",
+			content:     "- alert: foo\n  expr: deriv(http_requests_total[5m]) > 0\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DerivCheckName,
+						Text:     derivText("prom", uri, "http_requests_total"),
+						Details:  checks.DerivCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "deriv(counter) used twice",
+			content:     "- record: foo\n  expr: deriv(http_requests_total[5m]) / deriv(http_requests_total[10m])\n",
+			checker:     newDerivCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DerivCheckName,
+						Text:     derivText("prom", uri, "http_requests_total"),
+						Details:  checks.DerivCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
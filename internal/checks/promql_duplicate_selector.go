@@ -0,0 +1,106 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	DuplicateSelectorCheckName    = "promql/duplicate_selector"
+	DuplicateSelectorCheckDetails = `Every range vector selector in a query is evaluated separately, so using the same selector multiple times will make Prometheus fetch and process the same samples more than once.
+If the same range selector is needed in multiple places consider using a recording rule for it and querying the result of that rule instead.`
+)
+
+func NewDuplicateSelectorCheck() DuplicateSelectorCheck {
+	return DuplicateSelectorCheck{}
+}
+
+type DuplicateSelectorCheck struct{}
+
+func (c DuplicateSelectorCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c DuplicateSelectorCheck) String() string {
+	return DuplicateSelectorCheckName
+}
+
+func (c DuplicateSelectorCheck) Reporter() string {
+	return DuplicateSelectorCheckName
+}
+
+func (c DuplicateSelectorCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	keys := []string{}
+	selectors := map[string]string{}
+	counts := map[string]int{}
+	for _, node := range parser.WalkDownExpr[*promParser.MatrixSelector](expr.Query) {
+		ms := node.Expr.(*promParser.MatrixSelector)
+		key := matrixSelectorKey(ms)
+		if _, ok := counts[key]; !ok {
+			keys = append(keys, key)
+			selectors[key] = ms.String()
+		}
+		counts[key]++
+	}
+
+	for _, key := range keys {
+		if counts[key] < 2 {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` selector is used %d times in this query, each copy will be evaluated separately, consider using a recording rule to avoid that.",
+				selectors[key], counts[key]),
+			Details:  DuplicateSelectorCheckDetails,
+			Severity: Information,
+		})
+	}
+
+	return problems
+}
+
+// matrixSelectorKey returns a string identifying given range selector
+// that doesn't depend on the order of label matchers.
+func matrixSelectorKey(ms *promParser.MatrixSelector) string {
+	vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+	if !ok {
+		return ms.String()
+	}
+
+	matchers := make([]string, 0, len(vs.LabelMatchers))
+	for _, lm := range vs.LabelMatchers {
+		matchers = append(matchers, lm.String())
+	}
+	slices.Sort(matchers)
+
+	var at string
+	if vs.Timestamp != nil {
+		at = fmt.Sprintf("%d", *vs.Timestamp)
+	} else if vs.StartOrEnd != 0 {
+		at = vs.StartOrEnd.String()
+	}
+
+	return fmt.Sprintf("{%s}[%s] offset %s @ %s", strings.Join(matchers, ","), ms.Range, vs.OriginalOffset, at)
+}
@@ -0,0 +1,132 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newDuplicateSelectorCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewDuplicateSelectorCheck()
+}
+
+func TestDuplicateSelectorCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: rate(foo[5m]) / rate(foo[5m]\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "single selector",
+			content:     "- record: foo\n  expr: rate(foo[5m])\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores instant selectors",
+			content:     "- alert: foo\n  expr: foo > 1 and foo < 10\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "different ranges",
+			content:     "- record: foo\n  expr: rate(foo[5m]) / rate(foo[1h])\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "different offsets",
+			content:     "- record: foo\n  expr: rate(foo[5m]) / rate(foo[5m] offset 1d)\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "different matchers",
+			content:     "- record: foo\n  expr: rate(foo{code=\"500\"}[5m]) / rate(foo[5m])\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "same selector twice",
+			content:     "- record: foo\n  expr: rate(foo[5m]) / rate(foo[5m])\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DuplicateSelectorCheckName,
+						Text:     "`foo[5m]` selector is used 2 times in this query, each copy will be evaluated separately, consider using a recording rule to avoid that.",
+						Details:  checks.DuplicateSelectorCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "same selector with matchers in different order",
+			content:     "- record: foo\n  expr: sum(increase(foo{job=\"a\", code=\"500\"}[5m])) / sum(rate(foo{code=\"500\", job=\"a\"}[5m])) > sum_over_time(foo{job=\"a\",code=\"500\"}[5m])\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DuplicateSelectorCheckName,
+						Text:     "`foo{code=\"500\",job=\"a\"}[5m]` selector is used 3 times in this query, each copy will be evaluated separately, consider using a recording rule to avoid that.",
+						Details:  checks.DuplicateSelectorCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "multiple duplicated selectors",
+			content:     "- record: foo\n  expr: (rate(foo[5m]) + rate(bar[5m])) / (rate(foo[5m]) + rate(bar[5m]))\n",
+			checker:     newDuplicateSelectorCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DuplicateSelectorCheckName,
+						Text:     "`foo[5m]` selector is used 2 times in this query, each copy will be evaluated separately, consider using a recording rule to avoid that.",
+						Details:  checks.DuplicateSelectorCheckDetails,
+						Severity: checks.Information,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.DuplicateSelectorCheckName,
+						Text:     "`bar[5m]` selector is used 2 times in this query, each copy will be evaluated separately, consider using a recording rule to avoid that.",
+						Details:  checks.DuplicateSelectorCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
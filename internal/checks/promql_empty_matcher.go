@@ -0,0 +1,111 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	EmptyMatcherCheckName    = "promql/empty_matcher"
+	EmptyMatcherCheckDetails = `A label matcher with an empty value, like ` + "`{job=\"\"}`" + `, only selects time series that don't have that label at all.
+If the label is always set on all time series, for example because it's added by the scrape configuration, then this matcher will never select anything and the query will always return empty results.`
+)
+
+func NewEmptyMatcherCheck(prom *promapi.FailoverGroup) EmptyMatcherCheck {
+	return EmptyMatcherCheck{prom: prom}
+}
+
+type EmptyMatcherCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c EmptyMatcherCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c EmptyMatcherCheck) String() string {
+	return fmt.Sprintf("%s(%s)", EmptyMatcherCheckName, c.prom.Name())
+}
+
+func (c EmptyMatcherCheck) Reporter() string {
+	return EmptyMatcherCheckName
+}
+
+func (c EmptyMatcherCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, vs := range utils.HasVectorSelector(expr.Query) {
+		metric := selectorMetricName(vs)
+		if metric == "" {
+			continue
+		}
+		for _, lm := range vs.LabelMatchers {
+			if lm.Type != labels.MatchEqual || lm.Value != "" || lm.Name == labels.MetricName {
+				continue
+			}
+
+			query := fmt.Sprintf("count(%s) by (%s)", metric, lm.Name)
+			if _, ok := done[query]; ok {
+				continue
+			}
+			done[query] = struct{}{}
+
+			qr, err := c.prom.Query(ctx, query)
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				return problems
+			}
+			// No results means that there are no time series at all, other checks will report that.
+			if len(qr.Series) == 0 {
+				continue
+			}
+
+			var missing bool
+			for _, s := range qr.Series {
+				if s.Labels.Get(lm.Name) == "" {
+					missing = true
+					break
+				}
+			}
+			if missing {
+				continue
+			}
+
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` will never match anything because all `%s` time series on %s have a non-empty `%s` label.",
+					vs.String(), metric, promText(c.prom.Name(), qr.URI), lm.Name),
+				Details:  EmptyMatcherCheckDetails,
+				Severity: Warning,
+			})
+		}
+	}
+
+	return problems
+}
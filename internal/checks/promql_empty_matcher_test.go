@@ -0,0 +1,145 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newEmptyMatcherCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewEmptyMatcherCheck(prom)
+}
+
+func emptyMatcherText(selector, metric, name, uri, label string) string {
+	return fmt.Sprintf("`%s` will never match anything because all `%s` time series on `%s` Prometheus server at %s have a non-empty `%s` label.",
+		selector, metric, name, uri, label)
+}
+
+func TestEmptyMatcherCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo{job=\"\"}\n",
+			checker:     newEmptyMatcherCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores non-empty matchers",
+			content:     "- record: foo\n  expr: sum(foo{job=\"bar\", instance!=\"\"})\n",
+			checker:     newEmptyMatcherCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores selectors without metric name",
+			content:     "- record: foo\n  expr: sum({__name__=~\"foo.*\", job=\"\"})\n",
+			checker:     newEmptyMatcherCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: sum(foo{job=\"\"})\n",
+			checker:     newEmptyMatcherCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.EmptyMatcherCheckName,
+						Text:     checkErrorUnableToRun(checks.EmptyMatcherCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no results",
+			content:     "- record: foo\n  expr: sum(foo{job=\"\"})\n",
+			checker:     newEmptyMatcherCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(foo) by (job)"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+		{
+			description: "label sometimes missing",
+			content:     "- record: foo\n  expr: sum(foo{job=\"\"})\n",
+			checker:     newEmptyMatcherCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(foo) by (job)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "a"}),
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "label always set",
+			content:     "- alert: foo\n  expr: sum(foo{job=\"\", env=\"prod\"}) / sum(foo{job=\"\"}) > 0\n",
+			checker:     newEmptyMatcherCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.EmptyMatcherCheckName,
+						Text:     emptyMatcherText(`foo{env="prod",job=""}`, "foo", "prom", uri, "job"),
+						Details:  checks.EmptyMatcherCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(foo) by (job)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "a"}),
+							generateSample(map[string]string{"job": "b"}),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,98 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	EmptyOnCheckName    = "promql/empty_on"
+	EmptyOnCheckDetails = `Using [on()](https://prometheus.io/docs/prometheus/latest/querying/operators/#vector-matching) with an empty label list means that all time series from both sides will match each other.
+This only works if the other side of the operation returns a single time series, otherwise the query will fail with a matching error once there's more than one time series returned.
+If you want to match on specific labels then list them inside the on() clause.`
+)
+
+func NewEmptyOnCheck() EmptyOnCheck {
+	return EmptyOnCheck{}
+}
+
+type EmptyOnCheck struct{}
+
+func (c EmptyOnCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c EmptyOnCheck) String() string {
+	return EmptyOnCheckName
+}
+
+func (c EmptyOnCheck) Reporter() string {
+	return EmptyOnCheckName
+}
+
+func (c EmptyOnCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.BinaryExpr](expr.Query) {
+		binExp := node.Expr.(*promParser.BinaryExpr)
+		if binExp.VectorMatching == nil || !binExp.VectorMatching.On || len(binExp.VectorMatching.MatchingLabels) > 0 {
+			continue
+		}
+		// Set operators don't pair time series, so empty on() is valid there.
+		if binExp.Op.IsSetOperator() {
+			continue
+		}
+		if isSingleSeries(binExp.LHS) || isSingleSeries(binExp.RHS) {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is using `on()` with an empty label list, this will only work if one side of the operation returns a single time series.",
+				binExp),
+			Details:  EmptyOnCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
+
+// isSingleSeries returns true if given expression will always return
+// at most one time series (or a scalar).
+func isSingleSeries(expr promParser.Expr) bool {
+	switch n := expr.(type) {
+	case *promParser.NumberLiteral:
+		return true
+	case *promParser.ParenExpr:
+		return isSingleSeries(n.Expr)
+	case *promParser.Call:
+		switch n.Func.Name {
+		case "vector", "time", "scalar":
+			return true
+		}
+	case *promParser.AggregateExpr:
+		switch n.Op {
+		case promParser.TOPK, promParser.BOTTOMK, promParser.COUNT_VALUES:
+			return false
+		}
+		return !n.Without && len(n.Grouping) == 0
+	}
+	return expr.Type() == promParser.ValueTypeScalar
+}
@@ -0,0 +1,95 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newEmptyOnCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewEmptyOnCheck()
+}
+
+func TestEmptyOnCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: foo * on() bar(\n",
+			checker:     newEmptyOnCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores on() with labels",
+			content:     "- record: foo\n  expr: foo * on(job) bar\n",
+			checker:     newEmptyOnCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores set operators",
+			content:     "- alert: foo\n  expr: foo > 0 and on() hour() > 8\n",
+			checker:     newEmptyOnCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores vector()",
+			content:     "- record: foo\n  expr: foo * on() group_left() vector(1)\n",
+			checker:     newEmptyOnCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores aggregation without grouping",
+			content:     "- record: foo\n  expr: foo / on() group_left() sum(bar)\n",
+			checker:     newEmptyOnCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "empty on() between vectors",
+			content:     "- record: foo\n  expr: foo * on() bar\n",
+			checker:     newEmptyOnCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.EmptyOnCheckName,
+						Text:     "`foo * on () bar` is using `on()` with an empty label list, this will only work if one side of the operation returns a single time series.",
+						Details:  checks.EmptyOnCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "empty on() with aggregation by",
+			content:     "- alert: foo\n  expr: sum(foo) by(job) / on() group_left() sum(bar) by(job) > 0\n",
+			checker:     newEmptyOnCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.EmptyOnCheckName,
+						Text:     "`sum by (job) (foo) / on () group_left () sum by (job) (bar)` is using `on()` with an empty label list, this will only work if one side of the operation returns a single time series.",
+						Details:  checks.EmptyOnCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,108 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	EvalDurationCheckName    = "promql/eval_duration"
+	EvalDurationCheckDetails = `Prometheus evaluates all rules in a group sequentially, slow recording rules will delay evaluation of all rules that come after them.
+If a rule group takes longer to evaluate than its evaluation interval then Prometheus will skip some evaluations.
+Try to simplify this query or split it into multiple recording rules.`
+)
+
+type EvalDurationSettings struct {
+	Threshold string `hcl:"threshold" json:"threshold"`
+	threshold time.Duration
+}
+
+func (s *EvalDurationSettings) Validate() error {
+	dur, err := model.ParseDuration(s.Threshold)
+	if err != nil {
+		return err
+	}
+	if dur <= 0 {
+		return errors.New("threshold must be greater than zero")
+	}
+	s.threshold = time.Duration(dur)
+	return nil
+}
+
+func (s *EvalDurationSettings) Duration() time.Duration {
+	return s.threshold
+}
+
+func NewEvalDurationCheck(prom *promapi.FailoverGroup, threshold time.Duration) EvalDurationCheck {
+	return EvalDurationCheck{prom: prom, threshold: threshold}
+}
+
+type EvalDurationCheck struct {
+	prom      *promapi.FailoverGroup
+	threshold time.Duration
+}
+
+func (c EvalDurationCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c EvalDurationCheck) String() string {
+	return fmt.Sprintf("%s(%s)", EvalDurationCheckName, c.prom.Name())
+}
+
+func (c EvalDurationCheck) Reporter() string {
+	return EvalDurationCheckName
+}
+
+func (c EvalDurationCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	expr := rule.RecordingRule.Expr
+	// Use the same query as promql/cost so both checks can share the cached result.
+	qr, err := c.prom.Query(ctx, fmt.Sprintf("count(%s)", expr.Value.Value))
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	evalDur := time.Duration(qr.Stats.Timings.EvalTotalTime * float64(time.Second))
+	if evalDur <= c.threshold {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("%s took %s to evaluate this query, which is more than the %s threshold.",
+			promText(c.prom.Name(), qr.URI), output.HumanizeDuration(evalDur), output.HumanizeDuration(c.threshold)),
+		Details:  EvalDurationCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
@@ -0,0 +1,125 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newEvalDurationCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewEvalDurationCheck(prom, time.Second*5)
+}
+
+func evalDurationText(name, uri, took string) string {
+	return fmt.Sprintf("`%s` Prometheus server at %s took %s to evaluate this query, which is more than the 5s threshold.", name, uri, took)
+}
+
+func TestEvalDurationCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newEvalDurationCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: sum(foo) > 0\n",
+			checker:     newEvalDurationCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newEvalDurationCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.EvalDurationCheckName,
+						Text:     checkErrorUnableToRun(checks.EvalDurationCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "fast query",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newEvalDurationCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(sum(foo))`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{generateSample(map[string]string{})},
+						stats: promapi.QueryStats{
+							Timings: promapi.QueryTimings{
+								EvalTotalTime: 5,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "slow query",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newEvalDurationCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.EvalDurationCheckName,
+						Text:     evalDurationText("prom", uri, "7s500ms"),
+						Details:  checks.EvalDurationCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(sum(foo))`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{generateSample(map[string]string{})},
+						stats: promapi.QueryStats{
+							Timings: promapi.QueryTimings{
+								EvalTotalTime: 7.5,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
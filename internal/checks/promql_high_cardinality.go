@@ -0,0 +1,190 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	HighCardinalityCheckName    = "promql/high_cardinality"
+	HighCardinalityCheckDetails = `Some labels, like ` + "`pod`" + ` or ` + "`container`" + `, have a very high number of unique values and should be removed when aggregating.
+Keeping them in the results of an aggregation means that the aggregation won't reduce the number of time series much.
+Remove these labels from ` + "`by(...)`" + ` or add them to ` + "`without(...)`" + `.`
+)
+
+var defaultHighCardinalityLabels = []string{"pod", "container", "request_id"}
+
+type HighCardinalitySettings struct {
+	Labels []string `hcl:"labels,optional" json:"labels,omitempty"`
+	labels []string
+}
+
+func (c *HighCardinalitySettings) Validate() error {
+	for _, name := range c.Labels {
+		if name == "" {
+			return errors.New("labels cannot contain empty values")
+		}
+	}
+	c.labels = defaultHighCardinalityLabels
+	if len(c.Labels) > 0 {
+		c.labels = c.Labels
+	}
+	return nil
+}
+
+func (c *HighCardinalitySettings) LabelNames() []string {
+	return c.labels
+}
+
+func NewHighCardinalityCheck(prom *promapi.FailoverGroup, highCardLabels []string) HighCardinalityCheck {
+	return HighCardinalityCheck{prom: prom, labels: highCardLabels}
+}
+
+type HighCardinalityCheck struct {
+	prom   *promapi.FailoverGroup
+	labels []string
+}
+
+func (c HighCardinalityCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c HighCardinalityCheck) String() string {
+	return fmt.Sprintf("%s(%s)", HighCardinalityCheckName, c.prom.Name())
+}
+
+func (c HighCardinalityCheck) Reporter() string {
+	return HighCardinalityCheckName
+}
+
+func (c HighCardinalityCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.AggregateExpr](expr.Query) {
+		aggr := node.Expr.(*promParser.AggregateExpr)
+		if !isReducingAggregation(aggr) || hasReducingAggregationParent(node) {
+			continue
+		}
+
+		if !aggr.Without {
+			var kept []string
+			for _, name := range aggr.Grouping {
+				if slices.Contains(c.labels, name) {
+					kept = append(kept, name)
+				}
+			}
+			if len(kept) > 0 {
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text: fmt.Sprintf("`%s` aggregation is keeping high cardinality labels in `by(...)`: %s.",
+						aggr.String(), quoteLabelNames(kept)),
+					Details:  HighCardinalityCheckDetails,
+					Severity: Warning,
+				})
+			}
+			continue
+		}
+
+		var kept []string
+		for _, name := range c.labels {
+			if slices.Contains(aggr.Grouping, name) {
+				continue
+			}
+			found, err := c.hasLabel(ctx, fmt.Sprintf("count(%s) by (%s)", aggr.String(), name), name)
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				return problems
+			}
+			if found {
+				kept = append(kept, name)
+			}
+		}
+		if len(kept) > 0 {
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` aggregation is keeping high cardinality labels on %s: %s, add them to `without(...)`.",
+					aggr.String(), promText(c.prom.Name(), c.prom.PublicURI()), quoteLabelNames(kept)),
+				Details:  HighCardinalityCheckDetails,
+				Severity: Warning,
+			})
+		}
+	}
+
+	return problems
+}
+
+// hasLabel returns true if any series returned by the query has given label.
+// Results are streamed since queries keeping high cardinality labels can return
+// a lot of series, the query is cancelled as soon as a matching series is found.
+func (c HighCardinalityCheck) hasLabel(ctx context.Context, query, name string) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	samples, errs := c.prom.QueryStream(ctx, query, time.Time{})
+	for s := range samples {
+		if s.Labels.Get(name) != "" {
+			return true, nil
+		}
+	}
+	if err := <-errs; err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// isReducingAggregation returns true for aggregations that can remove labels.
+// topk() and similar functions always keep all labels of the series they return.
+func isReducingAggregation(aggr *promParser.AggregateExpr) bool {
+	switch aggr.Op {
+	case promParser.TOPK, promParser.BOTTOMK:
+		return false
+	default:
+		return true
+	}
+}
+
+func hasReducingAggregationParent(node *parser.PromQLNode) bool {
+	for p := node.Parent; p != nil; p = p.Parent {
+		if aggr, ok := p.Expr.(*promParser.AggregateExpr); ok && isReducingAggregation(aggr) {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteLabelNames(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, "`"+name+"`")
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,204 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newHighCardinalityCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewHighCardinalityCheck(prom, []string{"pod", "container"})
+}
+
+func highCardinalityByText(aggr, labels string) string {
+	return fmt.Sprintf("`%s` aggregation is keeping high cardinality labels in `by(...)`: %s.", aggr, labels)
+}
+
+func highCardinalityWithoutText(aggr, prom, uri, labels string) string {
+	return fmt.Sprintf("`%s` aggregation is keeping high cardinality labels on `%s` Prometheus server at %s: %s, add them to `without(...)`.",
+		aggr, prom, uri, labels)
+}
+
+func TestHighCardinalityCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores queries without aggregations",
+			content:     "- record: foo\n  expr: rate(foo[5m])\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores aggregations without grouping",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores topk",
+			content:     "- record: foo\n  expr: topk(5, foo)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "by without high cardinality labels",
+			content:     "- record: foo\n  expr: sum(foo) by (job, instance)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "by with high cardinality labels",
+			content:     "- record: foo\n  expr: sum(foo) by (job, pod, container)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HighCardinalityCheckName,
+						Text:     highCardinalityByText("sum by (job, pod, container) (foo)", "`pod`, `container`"),
+						Details:  checks.HighCardinalityCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "only checks outer aggregation",
+			content:     "- record: foo\n  expr: sum(sum(foo) by (job, pod)) by (job)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "without removing all high cardinality labels",
+			content:     "- record: foo\n  expr: sum(foo) without (pod, container)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: sum(foo) without (pod)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HighCardinalityCheckName,
+						Text:     checkErrorUnableToRun(checks.HighCardinalityCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum without (pod) (foo)) by (container)"},
+					},
+					resp: respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "without removing some high cardinality labels / label not present",
+			content:     "- record: foo\n  expr: sum(foo) without (pod)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum without (pod) (foo)) by (container)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "without removing some high cardinality labels / label present",
+			content:     "- record: foo\n  expr: sum(foo) without (instance)\n",
+			checker:     newHighCardinalityCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HighCardinalityCheckName,
+						Text:     highCardinalityWithoutText("sum without (instance) (foo)", "prom", uri, "`pod`"),
+						Details:  checks.HighCardinalityCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum without (instance) (foo)) by (pod)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"pod": "foo-1"}),
+							generateSample(map[string]string{"pod": "foo-2"}),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(sum without (instance) (foo)) by (container)"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
+
+func TestHighCardinalitySettings(t *testing.T) {
+	s := checks.HighCardinalitySettings{}
+	require.NoError(t, s.Validate())
+	require.Equal(t, []string{"pod", "container", "request_id"}, s.LabelNames())
+
+	s = checks.HighCardinalitySettings{Labels: []string{"pod", "instance"}}
+	require.NoError(t, s.Validate())
+	require.Equal(t, []string{"pod", "instance"}, s.LabelNames())
+
+	s = checks.HighCardinalitySettings{Labels: []string{"pod", ""}}
+	require.EqualError(t, s.Validate(), "labels cannot contain empty values")
+}
@@ -0,0 +1,126 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	HistogramTypeCheckName    = "promql/histogram_type"
+	HistogramTypeCheckDetails = `[histogram_quantile()](https://prometheus.io/docs/prometheus/latest/querying/functions/#histogram_quantile) only works with [histograms](https://prometheus.io/docs/concepts/metric_types/#histogram).
+Classic histograms expose a series of buckets with the ` + "`le`" + ` label that are needed to calculate quantiles, other metric types don't have them and so the result will always be empty or ` + "`NaN`" + `.
+If you're using a [summary](https://prometheus.io/docs/concepts/metric_types/#summary) then quantiles are already calculated by the client and exported with the ` + "`quantile`" + ` label.`
+)
+
+func NewHistogramTypeCheck(prom *promapi.FailoverGroup) HistogramTypeCheck {
+	return HistogramTypeCheck{prom: prom}
+}
+
+type HistogramTypeCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c HistogramTypeCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c HistogramTypeCheck) String() string {
+	return fmt.Sprintf("%s(%s)", HistogramTypeCheckName, c.prom.Name())
+}
+
+func (c HistogramTypeCheck) Reporter() string {
+	return HistogramTypeCheckName
+}
+
+func (c HistogramTypeCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "histogram_quantile" || len(node.Children) != 2 {
+			continue
+		}
+
+		for _, vs := range parser.WalkDownExpr[*promParser.VectorSelector](node.Children[1]) {
+			selector := vs.Expr.(*promParser.VectorSelector)
+			name := histogramBaseName(selector.Name)
+			if name == "" {
+				continue
+			}
+			if _, ok := done[name]; ok {
+				continue
+			}
+			done[name] = struct{}{}
+
+			metadata, err := c.prom.Metadata(ctx, name)
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				continue
+			}
+
+			if len(metadata.Metadata) == 0 || hasHistogramMetadata(metadata.Metadata) {
+				continue
+			}
+
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` is passed to `histogram_quantile()` but `%s` is a %s according to metrics metadata from %s, not a histogram.",
+					selector.Name, name, metadata.Metadata[0].Type, promText(c.prom.Name(), metadata.URI)),
+				Details:  HistogramTypeCheckDetails,
+				Severity: Bug,
+			})
+		}
+	}
+
+	return problems
+}
+
+// histogramBaseName returns the name under which metadata for given
+// time series would be exposed, with any histogram suffix removed.
+func histogramBaseName(name string) string {
+	for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+	return name
+}
+
+// hasHistogramMetadata returns true if any metadata entry is using
+// one of the histogram types.
+func hasHistogramMetadata(metadata []v1.Metadata) bool {
+	for _, m := range metadata {
+		if m.Type == v1.MetricTypeHistogram || m.Type == v1.MetricTypeGaugeHistogram {
+			return true
+		}
+	}
+	return false
+}
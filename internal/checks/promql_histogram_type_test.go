@@ -0,0 +1,210 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newHistogramTypeCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewHistogramTypeCheck(prom)
+}
+
+func histogramTypeText(name, uri, selector, metric, typ string) string {
+	return fmt.Sprintf("`%s` is passed to `histogram_quantile()` but `%s` is a %s according to metrics metadata from `%s` Prometheus server at %s, not a histogram.", selector, metric, typ, name, uri)
+}
+
+func TestHistogramTypeCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without histogram_quantile()",
+			content:     "- record: foo\n  expr: sum(rate(foo_bucket[5m])) by (le)\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: histogram_quantile(0.95, sum(rate(foo_bucket[5m])) by (le))\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HistogramTypeCheckName,
+						Text:     checkErrorUnableToRun(checks.HistogramTypeCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no metadata",
+			content:     "- record: foo\n  expr: histogram_quantile(0.95, sum(rate(foo_bucket[5m])) by (le))\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  metadataResponse{metadata: map[string][]v1.Metadata{}},
+				},
+			},
+		},
+		{
+			description: "histogram",
+			content:     "- record: foo\n  expr: histogram_quantile(0.95, sum(rate(foo_bucket[5m])) by (le))\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireMetadataPath,
+						formCond{key: "metric", value: "foo"},
+					},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "histogram"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "native histogram",
+			content:     "- record: foo\n  expr: histogram_quantile(0.95, sum(rate(foo[5m])))\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireMetadataPath,
+						formCond{key: "metric", value: "foo"},
+					},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "histogram"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "summary",
+			content:     "- record: foo\n  expr: histogram_quantile(0.95, sum(rate(foo_bucket[5m])) by (le))\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HistogramTypeCheckName,
+						Text:     histogramTypeText("prom", uri, "foo_bucket", "foo", "summary"),
+						Details:  checks.HistogramTypeCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireMetadataPath,
+						formCond{key: "metric", value: "foo"},
+					},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "summary"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "gauge",
+			content:     "- alert: foo\n  expr: histogram_quantile(0.9, rate(foo_seconds[5m])) > 1\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HistogramTypeCheckName,
+						Text:     histogramTypeText("prom", uri, "foo_seconds", "foo_seconds", "gauge"),
+						Details:  checks.HistogramTypeCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireMetadataPath,
+						formCond{key: "metric", value: "foo_seconds"},
+					},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo_seconds": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "mixed metadata",
+			content:     "- record: foo\n  expr: histogram_quantile(0.95, sum(rate(foo_bucket[5m])) by (le))\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "summary"}, {Type: "histogram"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "only checks histogram_quantile() arguments",
+			content:     "- record: foo\n  expr: histogram_quantile(0.95, sum(rate(foo_bucket[5m])) by (le)) / on() bar\n",
+			checker:     newHistogramTypeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireMetadataPath,
+						formCond{key: "metric", value: "foo"},
+					},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"foo": {{Type: "histogram"}},
+					}},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,77 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	HoltWintersCheckName    = "promql/holt_winters"
+	HoltWintersCheckDetails = `[holt_winters()](https://prometheus.io/docs/prometheus/latest/querying/functions/#holt_winters) takes a smoothing factor ` + "`sf`" + ` and a trend factor ` + "`tf`" + ` as the second and third argument.
+Both of these must be greater than 0 and lower than 1, otherwise Prometheus will fail to evaluate the query.`
+)
+
+func NewHoltWintersCheck() HoltWintersCheck {
+	return HoltWintersCheck{}
+}
+
+type HoltWintersCheck struct{}
+
+func (c HoltWintersCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c HoltWintersCheck) String() string {
+	return HoltWintersCheckName
+}
+
+func (c HoltWintersCheck) Reporter() string {
+	return HoltWintersCheckName
+}
+
+func (c HoltWintersCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "holt_winters" || len(call.Args) != 3 {
+			continue
+		}
+
+		for i, name := range []string{"smoothing factor", "trend factor"} {
+			// Only number literals can be validated, any other expression is evaluated at query time.
+			nl, ok := unwrapParens(call.Args[i+1]).(*promParser.NumberLiteral)
+			if !ok {
+				continue
+			}
+			if nl.Val > 0 && nl.Val < 1 {
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     fmt.Sprintf("`%s` is using `%s` as the %s but it must be greater than 0 and lower than 1.", call, nl, name),
+				Details:  HoltWintersCheckDetails,
+				Severity: Bug,
+			})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,111 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newHoltWintersCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewHoltWintersCheck()
+}
+
+func TestHoltWintersCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: holt_winters(foo[10m], 0.5\n",
+			checker:     newHoltWintersCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "valid factors",
+			content:     "- record: foo\n  expr: holt_winters(foo[10m], 0.5, (0.1))\n",
+			checker:     newHoltWintersCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores non-literal factors",
+			content:     "- record: foo\n  expr: holt_winters(foo[10m], scalar(bar), 0.5)\n",
+			checker:     newHoltWintersCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "invalid smoothing factor",
+			content:     "- record: foo\n  expr: holt_winters(foo[10m], 1, 0.5)\n",
+			checker:     newHoltWintersCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HoltWintersCheckName,
+						Text:     "`holt_winters(foo[10m], 1, 0.5)` is using `1` as the smoothing factor but it must be greater than 0 and lower than 1.",
+						Details:  checks.HoltWintersCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "both factors invalid",
+			content:     "- alert: foo\n  expr: holt_winters(foo[10m], 0, 2) > 1\n",
+			checker:     newHoltWintersCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HoltWintersCheckName,
+						Text:     "`holt_winters(foo[10m], 0, 2)` is using `0` as the smoothing factor but it must be greater than 0 and lower than 1.",
+						Details:  checks.HoltWintersCheckDetails,
+						Severity: checks.Bug,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HoltWintersCheckName,
+						Text:     "`holt_winters(foo[10m], 0, 2)` is using `2` as the trend factor but it must be greater than 0 and lower than 1.",
+						Details:  checks.HoltWintersCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "negative trend factor",
+			content:     "- record: foo\n  expr: holt_winters(foo[10m], 0.5, -0.5)\n",
+			checker:     newHoltWintersCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.HoltWintersCheckName,
+						Text:     "`holt_winters(foo[10m], 0.5, -0.5)` is using `-0.5` as the trend factor but it must be greater than 0 and lower than 1.",
+						Details:  checks.HoltWintersCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,68 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	IdeltaCheckName    = "promql/idelta"
+	IdeltaCheckDetails = `The [idelta()](https://prometheus.io/docs/prometheus/latest/querying/functions/#idelta) function only uses the last two samples in the time window, all other samples are ignored.
+This makes the result very noisy, especially when recorded and later graphed or aggregated over longer periods of time.
+The [delta()](https://prometheus.io/docs/prometheus/latest/querying/functions/#delta) function uses all samples in the time window and is usually a better fit for recording rules.`
+)
+
+func NewIdeltaCheck() IdeltaCheck {
+	return IdeltaCheck{}
+}
+
+type IdeltaCheck struct{}
+
+func (c IdeltaCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c IdeltaCheck) String() string {
+	return IdeltaCheckName
+}
+
+func (c IdeltaCheck) Reporter() string {
+	return IdeltaCheckName
+}
+
+func (c IdeltaCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	expr := rule.Expr()
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "idelta" {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is only using the last two samples in the time window, consider using `delta()` instead unless high-frequency noise is intentional.",
+				call),
+			Details:  IdeltaCheckDetails,
+			Severity: Information,
+		})
+	}
+
+	return problems
+}
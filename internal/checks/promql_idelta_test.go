@@ -0,0 +1,71 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newIdeltaCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewIdeltaCheck()
+}
+
+func TestIdeltaCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: idelta(foo[5m]\n",
+			checker:     newIdeltaCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: idelta(foo[5m]) > 0\n",
+			checker:     newIdeltaCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores delta()",
+			content:     "- record: foo\n  expr: delta(foo[5m])\n",
+			checker:     newIdeltaCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "idelta() in recording rule",
+			content:     "- record: foo\n  expr: sum(idelta(foo[5m])) by(job) / sum(idelta(bar[5m])) by(job)\n",
+			checker:     newIdeltaCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.IdeltaCheckName,
+						Text:     "`idelta(foo[5m])` is only using the last two samples in the time window, consider using `delta()` instead unless high-frequency noise is intentional.",
+						Details:  checks.IdeltaCheckDetails,
+						Severity: checks.Information,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.IdeltaCheckName,
+						Text:     "`idelta(bar[5m])` is only using the last two samples in the time window, consider using `delta()` instead unless high-frequency noise is intentional.",
+						Details:  checks.IdeltaCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,114 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	IncreaseIntervalCheckName    = "promql/increase_interval"
+	IncreaseIntervalCheckDetails = `When the time window used by [increase](https://prometheus.io/docs/prometheus/latest/querying/functions/#increase) is not longer than the evaluation interval then each evaluation will only see the last few samples.
+The calculated increase will be very sensitive to scrape timing and any delayed or missed scrape will cause large jumps or gaps in the results.
+Use a time window that covers multiple evaluation intervals.`
+)
+
+func NewIncreaseIntervalCheck(prom *promapi.FailoverGroup) IncreaseIntervalCheck {
+	return IncreaseIntervalCheck{prom: prom}
+}
+
+type IncreaseIntervalCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c IncreaseIntervalCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c IncreaseIntervalCheck) String() string {
+	return fmt.Sprintf("%s(%s)", IncreaseIntervalCheckName, c.prom.Name())
+}
+
+func (c IncreaseIntervalCheck) Reporter() string {
+	return IncreaseIntervalCheckName
+}
+
+func (c IncreaseIntervalCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	var interval time.Duration
+	var source string
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "increase" {
+			continue
+		}
+		ms, ok := call.Args[0].(*promParser.MatrixSelector)
+		if !ok {
+			continue
+		}
+
+		if interval == 0 {
+			var err error
+			if interval, source, err = ruleEvaluationInterval(ctx, c.prom, rule); err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				return problems
+			}
+		}
+
+		if ms.Range > interval {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is using `%s` range which is not longer than the evaluation interval of `%s` %s, the result will only be based on the last few samples.",
+				call.String(), output.HumanizeDuration(ms.Range), output.HumanizeDuration(interval), source),
+			Details:  IncreaseIntervalCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
+
+// ruleEvaluationInterval returns the interval used by the rule group, or the global
+// evaluation_interval from Prometheus config if the group doesn't set it.
+func ruleEvaluationInterval(ctx context.Context, prom *promapi.FailoverGroup, rule parser.Rule) (time.Duration, string, error) {
+	if rule.GroupInterval != nil {
+		if interval, err := model.ParseDuration(rule.GroupInterval.Value); err == nil {
+			return time.Duration(interval), "set on the rule group", nil
+		}
+	}
+
+	cfg, err := prom.Config(ctx, 0)
+	if err != nil {
+		return 0, "", err
+	}
+	return cfg.Config.Global.EvaluationInterval, "configured on " + promText(prom.Name(), cfg.URI), nil
+}
@@ -0,0 +1,148 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newIncreaseIntervalCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewIncreaseIntervalCheck(prom)
+}
+
+func increaseIntervalText(call, window, interval, source string) string {
+	return fmt.Sprintf("`%s` is using `%s` range which is not longer than the evaluation interval of `%s` %s, the result will only be based on the last few samples.",
+		call, window, interval, source)
+}
+
+func TestIncreaseIntervalCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: increase(foo[1m]\n",
+			checker:     newIncreaseIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without increase",
+			content:     "- record: foo\n  expr: rate(foo[1m])\n",
+			checker:     newIncreaseIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores subqueries",
+			content:     "- record: foo\n  expr: increase(rate(foo[5m])[1m:])\n",
+			checker:     newIncreaseIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: increase(foo[1m])\n",
+			checker:     newIncreaseIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.IncreaseIntervalCheckName,
+						Text:     checkErrorUnableToRun(checks.IncreaseIntervalCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "window longer than evaluation_interval",
+			content:     "- record: foo\n  expr: increase(foo[5m])\n",
+			checker:     newIncreaseIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  evaluation_interval: 1m\n"},
+				},
+			},
+		},
+		{
+			description: "window equal to evaluation_interval",
+			content:     "- record: foo\n  expr: increase(foo[1m]) / increase(bar[30s])\n",
+			checker:     newIncreaseIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.IncreaseIntervalCheckName,
+						Text:     increaseIntervalText("increase(foo[1m])", "1m", "1m", fmt.Sprintf("configured on `prom` Prometheus server at %s", uri)),
+						Details:  checks.IncreaseIntervalCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.IncreaseIntervalCheckName,
+						Text:     increaseIntervalText("increase(bar[30s])", "30s", "1m", fmt.Sprintf("configured on `prom` Prometheus server at %s", uri)),
+						Details:  checks.IncreaseIntervalCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  evaluation_interval: 1m\n"},
+				},
+			},
+		},
+		{
+			description: "window equal to group interval",
+			content:     "groups:\n- name: foo\n  interval: 2m\n  rules:\n  - record: foo\n    expr: increase(foo[2m])\n",
+			checker:     newIncreaseIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 6,
+							Last:  6,
+						},
+						Reporter: checks.IncreaseIntervalCheckName,
+						Text:     increaseIntervalText("increase(foo[2m])", "2m", "2m", "set on the rule group"),
+						Details:  checks.IncreaseIntervalCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "window longer than group interval",
+			content:     "groups:\n- name: foo\n  interval: 30s\n  rules:\n  - record: foo\n    expr: increase(foo[2m])\n",
+			checker:     newIncreaseIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,145 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	PrometheusInternalMetricCheckName    = "promql/internal"
+	PrometheusInternalMetricCheckDetails = `Metrics exported by Prometheus about itself describe the state of the Prometheus server evaluating this rule, not the state of your services.
+Rules depending on them can change behaviour when Prometheus is restarted, upgraded or under load, which makes them fragile.
+If you need to monitor Prometheus itself it's best to do that from a different Prometheus server.`
+)
+
+var defaultInternalMetricPrefixes = []string{"prometheus_"}
+
+type PrometheusInternalMetricSettings struct {
+	Comment  string   `hcl:"comment,optional" json:"comment,omitempty"`
+	Severity string   `hcl:"severity,optional" json:"severity,omitempty"`
+	Prefixes []string `hcl:"prefixes,optional" json:"prefixes,omitempty"`
+	prefixes []string
+	severity Severity
+}
+
+func (s *PrometheusInternalMetricSettings) Validate() (err error) {
+	for _, prefix := range s.Prefixes {
+		if prefix == "" {
+			return errors.New("prefixes cannot contain empty values")
+		}
+	}
+	s.prefixes = defaultInternalMetricPrefixes
+	if len(s.Prefixes) > 0 {
+		s.prefixes = s.Prefixes
+	}
+	s.severity = Warning
+	if s.Severity != "" {
+		if s.severity, err = ParseSeverity(s.Severity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *PrometheusInternalMetricSettings) MetricPrefixes() []string {
+	return s.prefixes
+}
+
+func (s *PrometheusInternalMetricSettings) ReportSeverity() Severity {
+	return s.severity
+}
+
+func NewPrometheusInternalMetricCheck(prefixes []string, comment string, severity Severity) PrometheusInternalMetricCheck {
+	return PrometheusInternalMetricCheck{
+		prefixes: prefixes,
+		comment:  comment,
+		severity: severity,
+	}
+}
+
+type PrometheusInternalMetricCheck struct {
+	comment  string
+	prefixes []string
+	severity Severity
+}
+
+func (c PrometheusInternalMetricCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c PrometheusInternalMetricCheck) String() string {
+	return fmt.Sprintf("%s(%s)", PrometheusInternalMetricCheckName, strings.Join(c.prefixes, ","))
+}
+
+func (c PrometheusInternalMetricCheck) Reporter() string {
+	return PrometheusInternalMetricCheckName
+}
+
+func (c PrometheusInternalMetricCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	details := PrometheusInternalMetricCheckDetails
+	if c.comment != "" {
+		details = fmt.Sprintf("%s\n%s", details, maybeComment(c.comment))
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.VectorSelector](expr.Query) {
+		name := selectorMetricName(node.Expr.(*promParser.VectorSelector))
+		if name == "" {
+			continue
+		}
+		if _, ok := done[name]; ok {
+			continue
+		}
+		done[name] = struct{}{}
+
+		for _, prefix := range c.prefixes {
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     fmt.Sprintf("`%s` is an internal Prometheus metric matching `%s` prefix, it shouldn't be used in rules.", name, prefix),
+				Details:  details,
+				Severity: c.severity,
+			})
+			break
+		}
+	}
+
+	return problems
+}
+
+func selectorMetricName(vs *promParser.VectorSelector) string {
+	if vs.Name != "" {
+		return vs.Name
+	}
+	for _, lm := range vs.LabelMatchers {
+		if lm.Name == labels.MetricName && lm.Type == labels.MatchEqual {
+			return lm.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newPrometheusInternalMetricCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewPrometheusInternalMetricCheck([]string{"prometheus_", "alertmanager_"}, "", checks.Warning)
+}
+
+func TestPrometheusInternalMetricCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: sum(prometheus_tsdb_head_series\n",
+			checker:     newPrometheusInternalMetricCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores user metrics",
+			content:     "- record: foo\n  expr: sum(http_requests_total) / sum(my_prometheus_metric)\n",
+			checker:     newPrometheusInternalMetricCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores selectors without metric name",
+			content:     "- record: foo\n  expr: sum({__name__=~\"prometheus_.+\"})\n",
+			checker:     newPrometheusInternalMetricCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "internal metric in recording rule",
+			content:     "- record: foo\n  expr: sum(prometheus_tsdb_head_series) by(instance)\n",
+			checker:     newPrometheusInternalMetricCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.PrometheusInternalMetricCheckName,
+						Text:     "`prometheus_tsdb_head_series` is an internal Prometheus metric matching `prometheus_` prefix, it shouldn't be used in rules.",
+						Details:  checks.PrometheusInternalMetricCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "internal metrics in alerting rule",
+			content: `
+- alert: foo
+  expr: |
+    rate(prometheus_rule_evaluation_duration_seconds_sum[5m]) > 1
+    and on(instance)
+    rate(prometheus_rule_evaluation_duration_seconds_sum[5m]) > 0
+    unless on(instance)
+    {__name__="alertmanager_alerts"} > 0
+`,
+			checker:    newPrometheusInternalMetricCheck,
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  8,
+						},
+						Reporter: checks.PrometheusInternalMetricCheckName,
+						Text:     "`prometheus_rule_evaluation_duration_seconds_sum` is an internal Prometheus metric matching `prometheus_` prefix, it shouldn't be used in rules.",
+						Details:  checks.PrometheusInternalMetricCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  8,
+						},
+						Reporter: checks.PrometheusInternalMetricCheckName,
+						Text:     "`alertmanager_alerts` is an internal Prometheus metric matching `alertmanager_` prefix, it shouldn't be used in rules.",
+						Details:  checks.PrometheusInternalMetricCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "comment and severity",
+			content:     "- record: foo\n  expr: prometheus_build_info\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewPrometheusInternalMetricCheck([]string{"prometheus_"}, "Use meta-monitoring instead", checks.Bug)
+			},
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.PrometheusInternalMetricCheckName,
+						Text:     "`prometheus_build_info` is an internal Prometheus metric matching `prometheus_` prefix, it shouldn't be used in rules.",
+						Details:  checks.PrometheusInternalMetricCheckDetails + "\nRule comment: Use meta-monitoring instead",
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+	}
+	runTests(t, testCases)
+}
+
+func TestPrometheusInternalMetricSettings(t *testing.T) {
+	s := checks.PrometheusInternalMetricSettings{}
+	require.NoError(t, s.Validate())
+	require.Equal(t, []string{"prometheus_"}, s.MetricPrefixes())
+	require.Equal(t, checks.Warning, s.ReportSeverity())
+
+	s = checks.PrometheusInternalMetricSettings{Prefixes: []string{"prometheus_", "alertmanager_"}, Severity: "bug"}
+	require.NoError(t, s.Validate())
+	require.Equal(t, []string{"prometheus_", "alertmanager_"}, s.MetricPrefixes())
+	require.Equal(t, checks.Bug, s.ReportSeverity())
+
+	s = checks.PrometheusInternalMetricSettings{Prefixes: []string{"prometheus_", ""}}
+	require.EqualError(t, s.Validate(), "prefixes cannot contain empty values")
+
+	s = checks.PrometheusInternalMetricSettings{Severity: "xxx"}
+	require.EqualError(t, s.Validate(), "unknown severity: xxx")
+}
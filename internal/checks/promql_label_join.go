@@ -0,0 +1,116 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	LabelJoinCheckName    = "promql/label_join"
+	LabelJoinCheckDetails = `[label_join()](https://prometheus.io/docs/prometheus/latest/querying/functions/#label_join) will replace the value of the destination label if it's already present on the time series it's called with.
+Double check that overwriting that label is intended, otherwise pick a destination label name that isn't used yet.`
+)
+
+func NewLabelJoinCheck(prom *promapi.FailoverGroup) LabelJoinCheck {
+	return LabelJoinCheck{prom: prom}
+}
+
+type LabelJoinCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c LabelJoinCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c LabelJoinCheck) String() string {
+	return fmt.Sprintf("%s(%s)", LabelJoinCheckName, c.prom.Name())
+}
+
+func (c LabelJoinCheck) Reporter() string {
+	return LabelJoinCheckName
+}
+
+func (c LabelJoinCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "label_join" || len(call.Args) < 3 {
+			continue
+		}
+
+		vs, ok := unwrapParens(call.Args[0]).(*promParser.VectorSelector)
+		if !ok {
+			continue
+		}
+		dst, ok := unwrapParens(call.Args[1]).(*promParser.StringLiteral)
+		if !ok || dst.Val == "" {
+			continue
+		}
+
+		// Joining a label into itself is a deliberate way of modifying its value.
+		var isSource bool
+		for _, arg := range call.Args[3:] {
+			if src, ok := unwrapParens(arg).(*promParser.StringLiteral); ok && src.Val == dst.Val {
+				isSource = true
+			}
+		}
+		if isSource {
+			continue
+		}
+
+		selector := promParser.VectorSelector{Name: vs.Name, LabelMatchers: vs.LabelMatchers}
+		qr, err := c.prom.Query(ctx, fmt.Sprintf("count(%s) by (%s)", selector.String(), dst.Val))
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+
+		var values []string
+		for _, s := range qr.Series {
+			if v := s.Labels.Get(dst.Val); v != "" && !slices.Contains(values, v) {
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`label_join()` will overwrite the `%s` label, but `%s` already has %d distinct value(s) for it according to %s.",
+				dst.Val, selector.String(), len(values), promText(c.prom.Name(), qr.URI)),
+			Details:  LabelJoinCheckDetails,
+			Severity: Information,
+		})
+	}
+
+	return problems
+}
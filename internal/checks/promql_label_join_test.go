@@ -0,0 +1,134 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newLabelJoinCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewLabelJoinCheck(prom)
+}
+
+func labelJoinText(name, uri, label, selector string, values int) string {
+	return fmt.Sprintf("`label_join()` will overwrite the `%s` label, but `%s` already has %d distinct value(s) for it according to `%s` Prometheus server at %s.", label, selector, values, name, uri)
+}
+
+func TestLabelJoinCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: label_join(foo, \"dst\", \"-\", \"a\"\n",
+			checker:     newLabelJoinCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores other functions",
+			content:     "- record: foo\n  expr: label_replace(foo, \"dst\", \"$1\", \"a\", \"(.*)\")\n",
+			checker:     newLabelJoinCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores label_join() on complex queries",
+			content:     "- record: foo\n  expr: label_join(sum(foo) by (a, b), \"dst\", \"-\", \"a\", \"b\")\n",
+			checker:     newLabelJoinCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores destination used as a source",
+			content:     "- record: foo\n  expr: label_join(foo, \"a\", \"-\", \"a\", \"b\")\n",
+			checker:     newLabelJoinCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: label_join(foo, \"dst\", \"-\", \"a\", \"b\")\n",
+			checker:     newLabelJoinCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.LabelJoinCheckName,
+						Text:     checkErrorUnableToRun(checks.LabelJoinCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "destination label not present",
+			content:     "- record: foo\n  expr: label_join(foo{job=\"bar\"}, \"dst\", \"-\", \"a\", \"b\")\n",
+			checker:     newLabelJoinCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(foo{job="bar"}) by (dst)`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "destination label present",
+			content:     "- record: foo\n  expr: label_join(foo{job=\"bar\"}, \"dst\", \"-\", \"a\", \"b\")\n",
+			checker:     newLabelJoinCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.LabelJoinCheckName,
+						Text:     labelJoinText("prom", uri, "dst", `foo{job="bar"}`, 2),
+						Details:  checks.LabelJoinCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(foo{job="bar"}) by (dst)`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"dst": "a"}),
+							generateSample(map[string]string{"dst": "b"}),
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,119 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	LogExpCheckName    = "promql/logarithm"
+	LogExpCheckDetails = `Logarithm is only defined for positive numbers.
+[ln()](https://prometheus.io/docs/prometheus/latest/querying/functions/#ln), [log2()](https://prometheus.io/docs/prometheus/latest/querying/functions/#log2) and [log10()](https://prometheus.io/docs/prometheus/latest/querying/functions/#log10) will return ` + "`-Inf`" + ` when the value is zero and ` + "`NaN`" + ` when it's negative.
+Such results can break comparisons and any further calculations done on them.`
+
+	logExpLookback = time.Hour * 24 * 7
+)
+
+var logFunctions = []string{"ln", "log2", "log10"}
+
+type LogExpSettings struct{}
+
+func (s *LogExpSettings) Validate() error {
+	return nil
+}
+
+func NewLogExpCheck(prom *promapi.FailoverGroup) LogExpCheck {
+	return LogExpCheck{prom: prom}
+}
+
+type LogExpCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c LogExpCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c LogExpCheck) String() string {
+	return fmt.Sprintf("%s(%s)", LogExpCheckName, c.prom.Name())
+}
+
+func (c LogExpCheck) Reporter() string {
+	return LogExpCheckName
+}
+
+func (c LogExpCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if !slices.Contains(logFunctions, call.Func.Name) {
+			continue
+		}
+		vs, ok := call.Args[0].(*promParser.VectorSelector)
+		if !ok {
+			continue
+		}
+
+		selector := promParser.VectorSelector{
+			Name:          vs.Name,
+			LabelMatchers: vs.LabelMatchers,
+		}
+		if _, ok := done[selector.String()]; ok {
+			continue
+		}
+		done[selector.String()] = struct{}{}
+
+		qr, err := c.prom.Query(ctx, fmt.Sprintf("min(min_over_time(%s[%s]))", selector.String(), output.HumanizeDuration(logExpLookback)))
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+		if len(qr.Series) == 0 {
+			continue
+		}
+
+		minValue := float64(qr.Series[0].Value)
+		if minValue > 0 {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is applied to `%s` which had a minimum value of `%v` in the last %s according to %s, logarithm of zero or a negative value is `-Inf` or `NaN`.",
+				call.String(), selector.String(), minValue, output.HumanizeDuration(logExpLookback), promText(c.prom.Name(), qr.URI)),
+			Details:  LogExpCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
@@ -0,0 +1,213 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newLogExpCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewLogExpCheck(prom)
+}
+
+func logExpText(call, selector, value, name, uri string) string {
+	return fmt.Sprintf("`%s` is applied to `%s` which had a minimum value of `%s` in the last 1w according to `%s` Prometheus server at %s, logarithm of zero or a negative value is `-Inf` or `NaN`.",
+		call, selector, value, name, uri)
+}
+
+func TestLogExpCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: ln(foo\n",
+			checker:     newLogExpCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores exp()",
+			content:     "- record: foo\n  expr: exp(foo)\n",
+			checker:     newLogExpCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores non-selector arguments",
+			content:     "- record: foo\n  expr: ln(sum(foo))\n",
+			checker:     newLogExpCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: ln(foo)\n",
+			checker:     newLogExpCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.LogExpCheckName,
+						Text:     checkErrorUnableToRun(checks.LogExpCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "500 error from Prometheus API / other selectors are still checked",
+			content:     "- record: foo\n  expr: ln(foo) + log2(bar)\n",
+			checker:     newLogExpCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.LogExpCheckName,
+						Text:     checkErrorUnableToRun(checks.LogExpCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.LogExpCheckName,
+						Text:     logExpText("log2(bar)", "bar", "-1", "prom", uri),
+						Details:  checks.LogExpCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(foo[1w]))"},
+					},
+					resp: respondWithInternalError(),
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(bar[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, -1),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "no results",
+			content:     "- record: foo\n  expr: ln(foo)\n",
+			checker:     newLogExpCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(foo[1w]))"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+		{
+			description: "always positive",
+			content:     "- record: foo\n  expr: log2(foo{job=\"bar\"})\n",
+			checker:     newLogExpCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(foo{job=\"bar\"}[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 0.5),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "zero and negative values",
+			content:     "- alert: foo\n  expr: ln(foo) > 1 or log10(bar) > 1\n",
+			checker:     newLogExpCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.LogExpCheckName,
+						Text:     logExpText("ln(foo)", "foo", "0", "prom", uri),
+						Details:  checks.LogExpCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.LogExpCheckName,
+						Text:     logExpText("log10(bar)", "bar", "-5", "prom", uri),
+						Details:  checks.LogExpCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(foo[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 0),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "min(min_over_time(bar[1w]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, -5),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
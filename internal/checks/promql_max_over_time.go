@@ -0,0 +1,115 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	MaxOverTimeCheckName    = "promql/max_over_time"
+	MaxOverTimeCheckDetails = `[Counters](https://prometheus.io/docs/concepts/metric_types/#counter) can only grow, so unless there was a counter reset [max_over_time()](https://prometheus.io/docs/prometheus/latest/querying/functions/#aggregation_over_time) will always return the last value in the given time range.
+The absolute value of a counter depends on how long your application has been running, so it's not useful on its own.
+To see how much a counter has changed in the given time range use the [increase()](https://prometheus.io/docs/prometheus/latest/querying/functions/#increase) function instead.`
+	MinOverTimeCheckDetails = `[Counters](https://prometheus.io/docs/concepts/metric_types/#counter) can only grow, so unless there was a counter reset [min_over_time()](https://prometheus.io/docs/prometheus/latest/querying/functions/#aggregation_over_time) will always return the first value in the given time range.
+The absolute value of a counter depends on how long your application has been running, so it's not useful on its own.
+To see how much a counter has changed in the given time range use the [increase()](https://prometheus.io/docs/prometheus/latest/querying/functions/#increase) function instead.`
+)
+
+func NewMaxOverTimeCheck(prom *promapi.FailoverGroup) MaxOverTimeCheck {
+	return MaxOverTimeCheck{prom: prom}
+}
+
+type MaxOverTimeCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c MaxOverTimeCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c MaxOverTimeCheck) String() string {
+	return fmt.Sprintf("%s(%s)", MaxOverTimeCheckName, c.prom.Name())
+}
+
+func (c MaxOverTimeCheck) Reporter() string {
+	return MaxOverTimeCheckName
+}
+
+func (c MaxOverTimeCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		var details string
+		switch call.Func.Name {
+		case "max_over_time":
+			details = MaxOverTimeCheckDetails
+		case "min_over_time":
+			details = MinOverTimeCheckDetails
+		default:
+			continue
+		}
+
+		for _, arg := range call.Args {
+			ms, ok := arg.(*promParser.MatrixSelector)
+			if !ok {
+				continue
+			}
+			vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+			if !ok || vs.Name == "" {
+				continue
+			}
+
+			key := call.Func.Name + "/" + vs.Name
+			if _, ok := done[key]; ok {
+				continue
+			}
+			done[key] = struct{}{}
+
+			metadata, err := c.prom.Metadata(ctx, vs.Name)
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				continue
+			}
+			if !isCounterMetadata(metadata.Metadata) {
+				continue
+			}
+
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s()` should only be used with gauges but `%s` is a counter according to metrics metadata from %s, use `increase()` instead.",
+					call.Func.Name, vs.Name, promText(c.prom.Name(), metadata.URI)),
+				Details:  details,
+				Severity: Warning,
+			})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,237 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newMaxOverTimeCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewMaxOverTimeCheck(prom)
+}
+
+func maxOverTimeText(fn, name, uri, metric string) string {
+	return fmt.Sprintf("`%s()` should only be used with gauges but `%s` is a counter according to metrics metadata from `%s` Prometheus server at %s, use `increase()` instead.", fn, metric, name, uri)
+}
+
+func TestMaxOverTimeCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without max_over_time() or min_over_time()",
+			content:     "- record: foo\n  expr: increase(http_requests_total[5m])\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores max_over_time() on subqueries",
+			content:     "- record: foo\n  expr: max_over_time(sum(temperature)[5m:])\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: max_over_time(http_requests_total[5m])\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.MaxOverTimeCheckName,
+						Text:     checkErrorUnableToRun(checks.MaxOverTimeCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "max_over_time(gauge)",
+			content:     "- record: foo\n  expr: max_over_time(temperature[5m])\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "max_over_time(counter) / no metadata",
+			content:     "- record: foo\n  expr: max_over_time(http_requests_total[5m])\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  metadataResponse{metadata: map[string][]v1.Metadata{}},
+				},
+			},
+		},
+		{
+			description: "max_over_time(counter) / mixed metadata",
+			content:     "- record: foo\n  expr: max_over_time(http_requests_total[5m])\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}, {Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "max_over_time(counter)",
+			content:     "- alert: foo\n  expr: max_over_time(http_requests_total[5m]) > 0\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.MaxOverTimeCheckName,
+						Text:     maxOverTimeText("max_over_time", "prom", uri, "http_requests_total"),
+						Details:  checks.MaxOverTimeCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "min_over_time(counter)",
+			content:     "- alert: foo\n  expr: min_over_time(http_requests_total[5m]) > 0\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.MaxOverTimeCheckName,
+						Text:     maxOverTimeText("min_over_time", "prom", uri, "http_requests_total"),
+						Details:  checks.MinOverTimeCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "max_over_time(counter) used twice",
+			content:     "- record: foo\n  expr: max_over_time(http_requests_total[5m]) / max_over_time(http_requests_total[10m])\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.MaxOverTimeCheckName,
+						Text:     maxOverTimeText("max_over_time", "prom", uri, "http_requests_total"),
+						Details:  checks.MaxOverTimeCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "max_over_time(counter) and min_over_time(counter)",
+			content:     "- record: foo\n  expr: max_over_time(http_requests_total[5m]) - min_over_time(http_requests_total[5m])\n",
+			checker:     newMaxOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.MaxOverTimeCheckName,
+						Text:     maxOverTimeText("max_over_time", "prom", uri, "http_requests_total"),
+						Details:  checks.MaxOverTimeCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.MaxOverTimeCheckName,
+						Text:     maxOverTimeText("min_over_time", "prom", uri, "http_requests_total"),
+						Details:  checks.MinOverTimeCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
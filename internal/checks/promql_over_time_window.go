@@ -0,0 +1,108 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	OverTimeSingleSampleCheckName    = "promql/over_time_window"
+	OverTimeSingleSampleCheckDetails = `[Aggregation over time](https://prometheus.io/docs/prometheus/latest/querying/functions/#aggregation_over_time) functions like ` + "`min_over_time()` or `max_over_time()`" + ` aggregate all raw samples in the given time range.
+When the time range is shorter than the rule evaluation interval then each evaluation will only look at a small part of the time since the previous evaluation, and it will usually only see a single sample.
+Such query will behave the same as ` + "`last_over_time()`" + ` and any spikes between evaluations will be missed.
+Use a time range that is at least as long as the evaluation interval.`
+)
+
+func NewOverTimeSingleSampleCheck(prom *promapi.FailoverGroup) OverTimeSingleSampleCheck {
+	return OverTimeSingleSampleCheck{prom: prom}
+}
+
+type OverTimeSingleSampleCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c OverTimeSingleSampleCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c OverTimeSingleSampleCheck) String() string {
+	return fmt.Sprintf("%s(%s)", OverTimeSingleSampleCheckName, c.prom.Name())
+}
+
+func (c OverTimeSingleSampleCheck) Reporter() string {
+	return OverTimeSingleSampleCheckName
+}
+
+func (c OverTimeSingleSampleCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	var interval time.Duration
+	var source string
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		// last_over_time() is meant to only use the most recent sample.
+		if !strings.HasSuffix(call.Func.Name, "_over_time") || call.Func.Name == "last_over_time" {
+			continue
+		}
+		var ms *promParser.MatrixSelector
+		for _, arg := range call.Args {
+			if m, ok := arg.(*promParser.MatrixSelector); ok {
+				ms = m
+			}
+		}
+		if ms == nil {
+			continue
+		}
+
+		if interval == 0 {
+			var err error
+			if interval, source, err = ruleEvaluationInterval(ctx, c.prom, rule); err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				return problems
+			}
+			if interval <= 0 {
+				return problems
+			}
+		}
+
+		if ms.Range >= interval {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is using `%s` range which is shorter than the evaluation interval of `%s` %s, it will usually only see a single sample and behave like `last_over_time()`.",
+				call.String(), output.HumanizeDuration(ms.Range), output.HumanizeDuration(interval), source),
+			Details:  OverTimeSingleSampleCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
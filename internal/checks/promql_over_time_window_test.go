@@ -0,0 +1,148 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newOverTimeSingleSampleCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewOverTimeSingleSampleCheck(prom)
+}
+
+func overTimeWindowText(call, window, interval, source string) string {
+	return fmt.Sprintf("`%s` is using `%s` range which is shorter than the evaluation interval of `%s` %s, it will usually only see a single sample and behave like `last_over_time()`.",
+		call, window, interval, source)
+}
+
+func TestOverTimeSingleSampleCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: min_over_time(foo[30s]\n",
+			checker:     newOverTimeSingleSampleCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without _over_time",
+			content:     "- record: foo\n  expr: rate(foo[30s])\n",
+			checker:     newOverTimeSingleSampleCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores last_over_time",
+			content:     "- record: foo\n  expr: last_over_time(foo[30s])\n",
+			checker:     newOverTimeSingleSampleCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores subqueries",
+			content:     "- record: foo\n  expr: max_over_time(rate(foo[5m])[30s:])\n",
+			checker:     newOverTimeSingleSampleCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- alert: foo\n  expr: min_over_time(foo[30s]) > 0\n",
+			checker:     newOverTimeSingleSampleCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.OverTimeSingleSampleCheckName,
+						Text:     checkErrorUnableToRun(checks.OverTimeSingleSampleCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "window is longer than evaluation_interval",
+			content:     "- record: foo\n  expr: max_over_time(foo[5m])\n",
+			checker:     newOverTimeSingleSampleCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  evaluation_interval: 1m\n"},
+				},
+			},
+		},
+		{
+			description: "window is shorter than evaluation_interval",
+			content:     "- alert: foo\n  expr: min_over_time(foo[30s]) > 0 and max_over_time(bar[1m]) > 0 and quantile_over_time(0.9, bar[20s]) > 0\n",
+			checker:     newOverTimeSingleSampleCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.OverTimeSingleSampleCheckName,
+						Text:     overTimeWindowText("min_over_time(foo[30s])", "30s", "1m", fmt.Sprintf("configured on `prom` Prometheus server at %s", uri)),
+						Details:  checks.OverTimeSingleSampleCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.OverTimeSingleSampleCheckName,
+						Text:     overTimeWindowText("quantile_over_time(0.9, bar[20s])", "20s", "1m", fmt.Sprintf("configured on `prom` Prometheus server at %s", uri)),
+						Details:  checks.OverTimeSingleSampleCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  evaluation_interval: 1m\n"},
+				},
+			},
+		},
+		{
+			description: "window is shorter than group interval",
+			content:     "groups:\n- name: foo\n  interval: 2m\n  rules:\n  - record: foo\n    expr: max_over_time(foo[1m])\n",
+			checker:     newOverTimeSingleSampleCheck,
+			prometheus:  newSimpleProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 6,
+							Last:  6,
+						},
+						Reporter: checks.OverTimeSingleSampleCheckName,
+						Text:     overTimeWindowText("max_over_time(foo[1m])", "1m", "2m", "set on the rule group"),
+						Details:  checks.OverTimeSingleSampleCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
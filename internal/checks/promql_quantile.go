@@ -0,0 +1,96 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	QuantileCheckName    = "promql/quantile"
+	QuantileCheckDetails = `[quantile_over_time()](https://prometheus.io/docs/prometheus/latest/querying/functions/#aggregation_over_time) and [histogram_quantile()](https://prometheus.io/docs/prometheus/latest/querying/functions/#histogram_quantile) expect the quantile to be a value between 0 and 1.
+Using a value outside of that range doesn't produce an error but the query will return ` + "`-Inf`" + ` or ` + "`+Inf`" + ` instead of a useful result.`
+)
+
+func NewQuantileCheck() QuantileCheck {
+	return QuantileCheck{}
+}
+
+type QuantileCheck struct{}
+
+func (c QuantileCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c QuantileCheck) String() string {
+	return QuantileCheckName
+}
+
+func (c QuantileCheck) Reporter() string {
+	return QuantileCheckName
+}
+
+func (c QuantileCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "quantile_over_time" && call.Func.Name != "histogram_quantile" {
+			continue
+		}
+		if len(call.Args) == 0 {
+			continue
+		}
+
+		q, ok := numberLiteralValue(call.Args[0])
+		if !ok || (q >= 0 && q <= 1) {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s()` is called with %s as the quantile but it must be a value between 0 and 1.",
+				call.Func.Name, formatFloat(q)),
+			Details:  QuantileCheckDetails,
+			Severity: Bug,
+		})
+	}
+
+	return problems
+}
+
+// numberLiteralValue returns the value of a number literal, including
+// negative numbers and numbers wrapped in parens.
+func numberLiteralValue(node promParser.Node) (float64, bool) {
+	switch n := unwrapParens(node).(type) {
+	case *promParser.NumberLiteral:
+		return n.Val, true
+	case *promParser.UnaryExpr:
+		v, ok := numberLiteralValue(n.Expr)
+		if !ok {
+			return 0, false
+		}
+		if n.Op == promParser.SUB {
+			return -v, true
+		}
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,117 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newQuantileCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewQuantileCheck()
+}
+
+func TestQuantileCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: quantile_over_time(1.5, foo[5m]\n",
+			checker:     newQuantileCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "valid quantile_over_time",
+			content:     "- record: foo\n  expr: quantile_over_time(0.99, foo[5m])\n",
+			checker:     newQuantileCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "valid histogram_quantile",
+			content:     "- record: foo\n  expr: histogram_quantile(1, sum(rate(foo_bucket[5m])) by(le))\n",
+			checker:     newQuantileCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores non-literal quantile",
+			content:     "- record: foo\n  expr: histogram_quantile(scalar(bar), sum(rate(foo_bucket[5m])) by(le))\n",
+			checker:     newQuantileCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "quantile_over_time above 1",
+			content:     "- record: foo\n  expr: quantile_over_time(1.5, foo[5m])\n",
+			checker:     newQuantileCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.QuantileCheckName,
+						Text:     "`quantile_over_time()` is called with 1.5 as the quantile but it must be a value between 0 and 1.",
+						Details:  checks.QuantileCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "histogram_quantile below 0",
+			content:     "- alert: foo\n  expr: histogram_quantile((-0.5), sum(rate(foo_bucket[5m])) by(le)) > 1\n",
+			checker:     newQuantileCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.QuantileCheckName,
+						Text:     "`histogram_quantile()` is called with -0.5 as the quantile but it must be a value between 0 and 1.",
+						Details:  checks.QuantileCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "percentile instead of quantile",
+			content:     "- record: foo\n  expr: histogram_quantile(99, sum(rate(foo_bucket[5m])) by(le)) / quantile_over_time(95, foo[5m])\n",
+			checker:     newQuantileCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.QuantileCheckName,
+						Text:     "`histogram_quantile()` is called with 99 as the quantile but it must be a value between 0 and 1.",
+						Details:  checks.QuantileCheckDetails,
+						Severity: checks.Bug,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.QuantileCheckName,
+						Text:     "`quantile_over_time()` is called with 95 as the quantile but it must be a value between 0 and 1.",
+						Details:  checks.QuantileCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+	}
+	runTests(t, testCases)
+}
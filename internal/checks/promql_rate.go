@@ -29,10 +29,12 @@ const (
 The type of your metric is defined by the application that exports that metric.
 The number of samples depends on how often your application is being scraped by Prometheus.
 Each scrape produces a sample, so if your application is scrape every minute then the minimal time window you can use is two minutes.`
+
+	rateMinIntervals = 2
 )
 
 func NewRateCheck(prom *promapi.FailoverGroup) RateCheck {
-	return RateCheck{prom: prom, minIntervals: 2}
+	return RateCheck{prom: prom, minIntervals: rateMinIntervals}
 }
 
 type RateCheck struct {
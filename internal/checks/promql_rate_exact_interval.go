@@ -0,0 +1,132 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	RateExactIntervalCheckName    = "promql/rate_exact_interval"
+	RateExactIntervalCheckDetails = `When the time window used by [rate](https://prometheus.io/docs/prometheus/latest/querying/functions/#rate) or [irate](https://prometheus.io/docs/prometheus/latest/querying/functions/#irate) is exactly the same as the scrape interval then there will be at most two samples in each window.
+Depending on the alignment of scrapes and rule evaluations some windows will only have a single sample and return no results, and every missed or delayed scrape will cause gaps.`
+)
+
+func NewRateExactIntervalCheck(prom *promapi.FailoverGroup) RateExactIntervalCheck {
+	return RateExactIntervalCheck{prom: prom}
+}
+
+type RateExactIntervalCheck struct {
+	prom          *promapi.FailoverGroup
+	enabledChecks []string
+}
+
+func (c RateExactIntervalCheck) WithEnabledChecks(names []string) RuleChecker {
+	c.enabledChecks = names
+	return c
+}
+
+func (c RateExactIntervalCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c RateExactIntervalCheck) String() string {
+	return fmt.Sprintf("%s(%s)", RateExactIntervalCheckName, c.prom.Name())
+}
+
+func (c RateExactIntervalCheck) Reporter() string {
+	return RateExactIntervalCheckName
+}
+
+func (c RateExactIntervalCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	// A range equal to the scrape interval is already reported by both
+	// promql/rate and promql/rate_window.
+	if c.isEnabled(RateCheckName) || c.isEnabled(RateWindowCheckName) {
+		return problems
+	}
+
+	var targets *promapi.TargetsResult
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "rate" && call.Func.Name != "irate" {
+			continue
+		}
+
+		ms, ok := call.Args[0].(*promParser.MatrixSelector)
+		if !ok {
+			continue
+		}
+		vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+		if !ok {
+			continue
+		}
+
+		if targets == nil {
+			var err error
+			if targets, err = c.prom.Targets(ctx); err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				return problems
+			}
+		}
+
+		jobs, err := selectorJobs(ctx, c.prom, vs)
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+
+		for _, job := range jobs {
+			if targets.JobScrapeInterval(job) != ms.Range {
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` is using `%s` range which is exactly the same as the scrape interval of targets with `job=%q` according to %s, this will give `%s()` at most two samples to work with.",
+					call.String(), output.HumanizeDuration(ms.Range), job, promText(c.prom.Name(), targets.URI), call.Func.Name),
+				Details:  RateExactIntervalCheckDetails,
+				Severity: Warning,
+			})
+			break
+		}
+	}
+
+	return problems
+}
+
+func (c RateExactIntervalCheck) isEnabled(name string) bool {
+	return slices.Contains(c.enabledChecks, fmt.Sprintf("%s(%s)", name, c.prom.Name()))
+}
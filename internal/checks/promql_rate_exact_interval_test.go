@@ -0,0 +1,239 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newRateExactIntervalCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewRateExactIntervalCheck(prom)
+}
+
+func newRateExactIntervalCheckWithEnabled(names ...string) func(*promapi.FailoverGroup) checks.RuleChecker {
+	return func(prom *promapi.FailoverGroup) checks.RuleChecker {
+		return checks.NewRateExactIntervalCheck(prom).WithEnabledChecks(names)
+	}
+}
+
+func rateExactIntervalText(call, rng, job, name, uri, fn string) string {
+	return fmt.Sprintf("`%s` is using `%s` range which is exactly the same as the scrape interval of targets with `job=%q` according to `%s` Prometheus server at %s, this will give `%s()` at most two samples to work with.",
+		call, rng, job, name, uri, fn)
+}
+
+func TestRateExactIntervalCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without rate()",
+			content:     "- record: foo\n  expr: increase(foo[15s])\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rate() on subqueries",
+			content:     "- record: foo\n  expr: rate(sum(foo)[15s:])\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from targets API",
+			content:     "- record: foo\n  expr: rate(foo{job=\"fast\"}[15s])\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateExactIntervalCheckName,
+						Text:     checkErrorUnableToRun(checks.RateExactIntervalCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "job matcher / range is longer than scrape interval",
+			content:     "- record: foo\n  expr: rate(foo{job=\"fast\"}[30s])\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "job matcher / range is equal to scrape interval",
+			content:     "- record: foo\n  expr: rate(foo{job=\"fast\"}[15s])\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateExactIntervalCheckName,
+						Text:     rateExactIntervalText(`rate(foo{job="fast"}[15s])`, "15s", "fast", "prom", uri, "rate"),
+						Details:  checks.RateExactIntervalCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "job matcher / range is equal to scrape interval / promql/rate enabled",
+			content:     "- record: foo\n  expr: rate(foo{job=\"fast\"}[15s])\n",
+			checker:     newRateExactIntervalCheckWithEnabled("promql/rate(prom)"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "job matcher / range is equal to scrape interval / promql/rate_window enabled",
+			content:     "- record: foo\n  expr: rate(foo{job=\"fast\"}[15s])\n",
+			checker:     newRateExactIntervalCheckWithEnabled("promql/rate_window(prom)"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "job matcher / range is equal to scrape interval / checks enabled on other server",
+			content:     "- record: foo\n  expr: rate(foo{job=\"fast\"}[15s])\n",
+			checker:     newRateExactIntervalCheckWithEnabled("promql/rate(other)", "promql/rate_window(other)"),
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateExactIntervalCheckName,
+						Text:     rateExactIntervalText(`rate(foo{job="fast"}[15s])`, "15s", "fast", "prom", uri, "rate"),
+						Details:  checks.RateExactIntervalCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "job matcher / unknown job",
+			content:     "- record: foo\n  expr: irate(foo{job=\"bar\"}[15s])\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "no job matcher / range is equal to scrape interval",
+			content:     "- record: foo\n  expr: sum(irate(foo{instance=\"a\"}[2m]))\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateExactIntervalCheckName,
+						Text:     rateExactIntervalText(`irate(foo{instance="a"}[2m])`, "2m", "slow", "prom", uri, "irate"),
+						Details:  checks.RateExactIntervalCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(foo{instance="a"}) by (job)`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "fast"}),
+							generateSample(map[string]string{"job": "slow"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "no job matcher / 500 error from query API",
+			content:     "- record: foo\n  expr: rate(foo[1m])\n",
+			checker:     newRateExactIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateExactIntervalCheckName,
+						Text:     checkErrorUnableToRun(checks.RateExactIntervalCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
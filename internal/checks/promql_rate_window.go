@@ -0,0 +1,170 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	RateWindowCheckName    = "promql/rate_window"
+	RateWindowCheckDetails = `The time window used by [rate](https://prometheus.io/docs/prometheus/latest/querying/functions/#rate) and [irate](https://prometheus.io/docs/prometheus/latest/querying/functions/#irate) should be at least 4 times the scrape interval of the scraped targets.
+This ensures that there are enough samples to calculate the rate even if a scrape fails or is delayed, otherwise a single missed scrape can cause gaps in the results.`
+
+	rateWindowMinIntervals = 4
+)
+
+func NewRateWindowCheck(prom *promapi.FailoverGroup) RateWindowCheck {
+	return RateWindowCheck{prom: prom}
+}
+
+type RateWindowCheck struct {
+	prom          *promapi.FailoverGroup
+	enabledChecks []string
+}
+
+func (c RateWindowCheck) WithEnabledChecks(names []string) RuleChecker {
+	c.enabledChecks = names
+	return c
+}
+
+func (c RateWindowCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c RateWindowCheck) String() string {
+	return fmt.Sprintf("%s(%s)", RateWindowCheckName, c.prom.Name())
+}
+
+func (c RateWindowCheck) Reporter() string {
+	return RateWindowCheckName
+}
+
+func (c RateWindowCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	var targets *promapi.TargetsResult
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "rate" && call.Func.Name != "irate" {
+			continue
+		}
+
+		ms, ok := call.Args[0].(*promParser.MatrixSelector)
+		if !ok {
+			continue
+		}
+		vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+		if !ok {
+			continue
+		}
+
+		if targets == nil {
+			var err error
+			if targets, err = c.prom.Targets(ctx); err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				return problems
+			}
+		}
+
+		jobs, err := selectorJobs(ctx, c.prom, vs)
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+
+		var job string
+		var interval time.Duration
+		for _, j := range jobs {
+			if i := targets.JobScrapeInterval(j); i > interval {
+				job, interval = j, i
+			}
+		}
+		if interval == 0 || ms.Range >= interval*rateWindowMinIntervals {
+			continue
+		}
+		// Ranges shorter than 2x scrape interval are already reported by promql/rate.
+		if c.isEnabled(RateCheckName) && ms.Range < interval*rateMinIntervals {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is using `%s` range but targets with `job=%q` are scraped every `%s` according to %s, use a range of at least `%s` to tolerate missed scrapes.",
+				call.String(), output.HumanizeDuration(ms.Range), job, output.HumanizeDuration(interval),
+				promText(c.prom.Name(), targets.URI), output.HumanizeDuration(interval*rateWindowMinIntervals)),
+			Details:  RateWindowCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
+
+func (c RateWindowCheck) isEnabled(name string) bool {
+	return slices.Contains(c.enabledChecks, fmt.Sprintf("%s(%s)", name, c.prom.Name()))
+}
+
+// selectorJobs returns the list of job label values for time series matching
+// given selector. If the selector is already filtering on a single job then
+// that job is returned without querying Prometheus.
+func selectorJobs(ctx context.Context, prom *promapi.FailoverGroup, vs *promParser.VectorSelector) ([]string, error) {
+	for _, lm := range vs.LabelMatchers {
+		if lm.Name == model.JobLabel && lm.Type == labels.MatchEqual {
+			return []string{lm.Value}, nil
+		}
+	}
+
+	selector := promParser.VectorSelector{
+		Name:          vs.Name,
+		LabelMatchers: vs.LabelMatchers,
+	}
+	qr, err := prom.Query(ctx, fmt.Sprintf("count(%s) by (%s)", selector.String(), model.JobLabel))
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]string, 0, len(qr.Series))
+	for _, s := range qr.Series {
+		if job := s.Labels.Get(model.JobLabel); job != "" && !slices.Contains(jobs, job) {
+			jobs = append(jobs, job)
+		}
+	}
+	slices.Sort(jobs)
+	return jobs, nil
+}
@@ -0,0 +1,292 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newRateWindowCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewRateWindowCheck(prom)
+}
+
+func newRateWindowCheckWithEnabled(names ...string) func(*promapi.FailoverGroup) checks.RuleChecker {
+	return func(prom *promapi.FailoverGroup) checks.RuleChecker {
+		return checks.NewRateWindowCheck(prom).WithEnabledChecks(names)
+	}
+}
+
+func rateWindowText(call, rng, job, interval, name, uri, minimum string) string {
+	return fmt.Sprintf("`%s` is using `%s` range but targets with `job=%q` are scraped every `%s` according to `%s` Prometheus server at %s, use a range of at least `%s` to tolerate missed scrapes.",
+		call, rng, job, interval, name, uri, minimum)
+}
+
+var rateWindowTargets = targetsResponse{
+	targets: []scrapeTarget{
+		{Labels: map[string]string{"job": "fast", "instance": "a"}, ScrapePool: "fast", ScrapeInterval: "15s"},
+		{Labels: map[string]string{"job": "slow", "instance": "a"}, ScrapePool: "slow", ScrapeInterval: "1m"},
+		{Labels: map[string]string{"job": "slow", "instance": "b"}, ScrapePool: "slow-b", ScrapeInterval: "2m"},
+	},
+}
+
+func TestRateWindowCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without rate()",
+			content:     "- record: foo\n  expr: increase(foo[1m])\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rate() on subqueries",
+			content:     "- record: foo\n  expr: rate(sum(foo)[1m:])\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from targets API",
+			content:     "- record: foo\n  expr: rate(foo{job=\"fast\"}[1m])\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateWindowCheckName,
+						Text:     checkErrorUnableToRun(checks.RateWindowCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "job matcher / range is long enough",
+			content:     "- record: foo\n  expr: rate(foo{job=\"fast\"}[1m])\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "job matcher / range is too short",
+			content:     "- record: foo\n  expr: rate(foo{job=\"slow\"}[5m])\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateWindowCheckName,
+						Text:     rateWindowText(`rate(foo{job="slow"}[5m])`, "5m", "slow", "2m", "prom", uri, "8m"),
+						Details:  checks.RateWindowCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "job matcher / range is too short / promql/rate enabled",
+			content:     "- record: foo\n  expr: rate(foo{job=\"slow\"}[3m])\n",
+			checker:     newRateWindowCheckWithEnabled("promql/rate(prom)"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "job matcher / range is between 2x and 4x / promql/rate enabled",
+			content:     "- record: foo\n  expr: rate(foo{job=\"slow\"}[5m])\n",
+			checker:     newRateWindowCheckWithEnabled("promql/rate(prom)"),
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateWindowCheckName,
+						Text:     rateWindowText(`rate(foo{job="slow"}[5m])`, "5m", "slow", "2m", "prom", uri, "8m"),
+						Details:  checks.RateWindowCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "job matcher / range is too short / promql/rate enabled on other server",
+			content:     "- record: foo\n  expr: rate(foo{job=\"slow\"}[3m])\n",
+			checker:     newRateWindowCheckWithEnabled("promql/rate(other)"),
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateWindowCheckName,
+						Text:     rateWindowText(`rate(foo{job="slow"}[3m])`, "3m", "slow", "2m", "prom", uri, "8m"),
+						Details:  checks.RateWindowCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "job matcher / unknown job",
+			content:     "- record: foo\n  expr: irate(foo{job=\"bar\"}[1m])\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+			},
+		},
+		{
+			description: "no job matcher / range is too short",
+			content:     "- record: foo\n  expr: sum(irate(foo{instance=\"a\"}[2m]))\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateWindowCheckName,
+						Text:     rateWindowText(`irate(foo{instance="a"}[2m])`, "2m", "slow", "2m", "prom", uri, "8m"),
+						Details:  checks.RateWindowCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(foo{instance="a"}) by (job)`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"job": "fast"}),
+							generateSample(map[string]string{"job": "slow"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "no job matcher / no series",
+			content:     "- record: foo\n  expr: rate(foo[1m])\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(foo) by (job)`},
+					},
+					resp: vectorResponse{samples: []*model.Sample{}},
+				},
+			},
+		},
+		{
+			description: "no job matcher / 500 error from query API",
+			content:     "- record: foo\n  expr: rate(foo[1m])\n",
+			checker:     newRateWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RateWindowCheckName,
+						Text:     checkErrorUnableToRun(checks.RateWindowCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireTargetsPath},
+					resp:  rateWindowTargets,
+				},
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
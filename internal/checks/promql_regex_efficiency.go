@@ -0,0 +1,102 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"regexp/syntax"
+
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	RegexEfficiencyCheckName    = "promql/regex_efficiency"
+	RegexEfficiencyCheckDetails = `Regexp matchers need to compile and run a regular expression for every label value, while ` + "`=`" + ` and ` + "`!=`" + ` matchers only need to compare strings.
+A regexp that matches any non-empty value, like ` + "`.+`" + `, can be replaced with a simple comparison against an empty string.
+See [Prometheus documentation](https://prometheus.io/docs/prometheus/latest/querying/basics/#time-series-selectors) for details on how vector selectors work.`
+)
+
+func NewRegexEfficiencyCheck() RegexEfficiencyCheck {
+	return RegexEfficiencyCheck{}
+}
+
+type RegexEfficiencyCheck struct{}
+
+func (c RegexEfficiencyCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c RegexEfficiencyCheck) String() string {
+	return RegexEfficiencyCheckName
+}
+
+func (c RegexEfficiencyCheck) Reporter() string {
+	return RegexEfficiencyCheckName
+}
+
+func (c RegexEfficiencyCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return nil
+	}
+
+	done := map[string]struct{}{}
+	for _, selector := range getSelectors(expr.Query) {
+		for _, lm := range selector.LabelMatchers {
+			if lm.Type != labels.MatchRegexp && lm.Type != labels.MatchNotRegexp {
+				continue
+			}
+			if _, ok := done[lm.String()]; ok {
+				continue
+			}
+			done[lm.String()] = struct{}{}
+
+			if !isNonEmptyRegexp(lm.Value) {
+				continue
+			}
+
+			// .+ matches any non-empty value, so =~".+" is the same as !="" and !~".+" is the same as ="".
+			op := labels.MatchNotEqual
+			if lm.Type == labels.MatchNotRegexp {
+				op = labels.MatchEqual
+			}
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` regexp matcher only checks if `%s` label value is empty, use more efficient `%s%s\"\"` instead.",
+					lm, lm.Name, lm.Name, op),
+				Details:  RegexEfficiencyCheckDetails,
+				Severity: Information,
+			})
+		}
+	}
+
+	return problems
+}
+
+// isNonEmptyRegexp returns true if given regexp matches any value
+// as long as it's not empty, like `.+`.
+func isNonEmptyRegexp(re string) bool {
+	r, err := syntax.Parse(re, syntax.Perl)
+	if err != nil {
+		return false
+	}
+	r = r.Simplify()
+	for r.Op == syntax.OpCapture {
+		r = r.Sub[0]
+	}
+	if r.Op != syntax.OpPlus || len(r.Sub) != 1 {
+		return false
+	}
+	return r.Sub[0].Op == syntax.OpAnyChar || r.Sub[0].Op == syntax.OpAnyCharNotNL
+}
@@ -0,0 +1,108 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newRegexEfficiencyCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewRegexEfficiencyCheck()
+}
+
+func TestRegexEfficiencyCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: sum(foo) without(\n",
+			checker:     newRegexEfficiencyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "static match",
+			content:     "- record: foo\n  expr: foo{job!=\"\"}\n",
+			checker:     newRegexEfficiencyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "useful regexp",
+			content:     "- record: foo\n  expr: foo{job=~\"bar.+\"}\n",
+			checker:     newRegexEfficiencyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "wildcard regexp",
+			content:     "- record: foo\n  expr: foo{job=~\".*\"}\n",
+			checker:     newRegexEfficiencyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "=~ non-empty regexp",
+			content:     "- record: foo\n  expr: foo{job=~\".+\"}\n",
+			checker:     newRegexEfficiencyCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RegexEfficiencyCheckName,
+						Text:     "`job=~\".+\"` regexp matcher only checks if `job` label value is empty, use more efficient `job!=\"\"` instead.",
+						Details:  checks.RegexEfficiencyCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "!~ non-empty regexp",
+			content:     "- record: foo\n  expr: foo{job!~\"(.+)\"}\n",
+			checker:     newRegexEfficiencyCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RegexEfficiencyCheckName,
+						Text:     "`job!~\"(.+)\"` regexp matcher only checks if `job` label value is empty, use more efficient `job=\"\"` instead.",
+						Details:  checks.RegexEfficiencyCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "repeated matcher",
+			content:     "- record: foo\n  expr: foo{job=~\".{1,}\"} / bar{job=~\".{1,}\"}\n",
+			checker:     newRegexEfficiencyCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RegexEfficiencyCheckName,
+						Text:     "`job=~\".{1,}\"` regexp matcher only checks if `job` label value is empty, use more efficient `job!=\"\"` instead.",
+						Details:  checks.RegexEfficiencyCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,111 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	ResetsCheckName    = "promql/resets"
+	ResetsCheckDetails = `[resets()](https://prometheus.io/docs/prometheus/latest/querying/functions/#resets) returns the number of counter resets within the provided time range and should only be used with [counters](https://prometheus.io/docs/concepts/metric_types/#counter).
+Every decrease in the value of a gauge is treated as a counter reset, so the result of ` + "`resets()`" + ` on a gauge is usually meaningless.
+To count how many times a gauge changed its value use the [changes()](https://prometheus.io/docs/prometheus/latest/querying/functions/#changes) function instead.`
+)
+
+func NewResetsCheck(prom *promapi.FailoverGroup) ResetsCheck {
+	return ResetsCheck{prom: prom}
+}
+
+type ResetsCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c ResetsCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c ResetsCheck) String() string {
+	return fmt.Sprintf("%s(%s)", ResetsCheckName, c.prom.Name())
+}
+
+func (c ResetsCheck) Reporter() string {
+	return ResetsCheckName
+}
+
+func (c ResetsCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "resets" {
+			continue
+		}
+
+		for _, arg := range call.Args {
+			ms, ok := arg.(*promParser.MatrixSelector)
+			if !ok {
+				continue
+			}
+			vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+			if !ok || vs.Name == "" {
+				continue
+			}
+
+			if _, ok := done[vs.Name]; ok {
+				continue
+			}
+			done[vs.Name] = struct{}{}
+
+			metadata, err := c.prom.Metadata(ctx, vs.Name)
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				continue
+			}
+			typ, ok := metadataType(metadata.Metadata)
+			if !ok {
+				continue
+			}
+			switch typ {
+			case v1.MetricTypeCounter, v1.MetricTypeUnknown, v1.MetricTypeHistogram:
+				// Native histograms can be counters too.
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`resets()` should only be used with counters but `%s` is a %s according to metrics metadata from %s.",
+					vs.Name, typ, promText(c.prom.Name(), metadata.URI)),
+				Details:  ResetsCheckDetails,
+				Severity: Bug,
+			})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,214 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newResetsCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewResetsCheck(prom)
+}
+
+func resetsText(name, uri, metric, kind string) string {
+	return fmt.Sprintf("`resets()` should only be used with counters but `%s` is a %s according to metrics metadata from `%s` Prometheus server at %s.", metric, kind, name, uri)
+}
+
+func TestResetsCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without resets()",
+			content:     "- record: foo\n  expr: changes(temperature[5m])\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores resets() on subqueries",
+			content:     "- record: foo\n  expr: resets(sum(http_requests_total)[5m:])\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: resets(temperature[5m])\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ResetsCheckName,
+						Text:     checkErrorUnableToRun(checks.ResetsCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "resets(counter)",
+			content:     "- record: foo\n  expr: resets(http_requests_total[5m])\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "resets(histogram)",
+			content:     "- record: foo\n  expr: resets(http_request_duration_seconds[5m])\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_request_duration_seconds": {{Type: "histogram"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "resets(gauge) / no metadata",
+			content:     "- record: foo\n  expr: resets(temperature[5m])\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  metadataResponse{metadata: map[string][]v1.Metadata{}},
+				},
+			},
+		},
+		{
+			description: "resets(gauge)",
+			content:     "- alert: foo\n  expr: resets(temperature[5m]) > 0\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ResetsCheckName,
+						Text:     resetsText("prom", uri, "temperature", "gauge"),
+						Details:  checks.ResetsCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "resets(summary) used twice",
+			content:     "- record: foo\n  expr: resets(rpc_duration_seconds[5m]) / resets(rpc_duration_seconds[10m])\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ResetsCheckName,
+						Text:     resetsText("prom", uri, "rpc_duration_seconds", "summary"),
+						Details:  checks.ResetsCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"rpc_duration_seconds": {{Type: "summary"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "resets(gauge) with multiple metadata entries",
+			content:     "- alert: foo\n  expr: resets(temperature[5m]) > 0\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ResetsCheckName,
+						Text:     resetsText("prom", uri, "temperature", "gauge"),
+						Details:  checks.ResetsCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge", Help: "Temperature."}, {Type: "gauge", Help: "Current temperature."}},
+					}},
+				},
+			},
+		},
+		{
+			description: "resets(counter or gauge)",
+			content:     "- alert: foo\n  expr: resets(temperature[5m]) > 0\n",
+			checker:     newResetsCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "counter"}, {Type: "gauge"}},
+					}},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
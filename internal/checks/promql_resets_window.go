@@ -0,0 +1,133 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	ResetsWindowCheckName    = "promql/resets_window"
+	ResetsWindowCheckDetails = `[resets()](https://prometheus.io/docs/prometheus/latest/querying/functions/#resets) returns the number of counter resets within the provided time range.
+If the time range is shorter than the typical time between counter resets then most evaluations will return zero and resets will only be visible when they happen to fall inside the time window.
+Use a time window longer than the time between resets, or use [changes()](https://prometheus.io/docs/prometheus/latest/querying/functions/#changes) on a metric that tracks process start time instead.`
+
+	resetsWindowLookback = time.Hour * 24
+)
+
+type ResetsWindowSettings struct{}
+
+func (s *ResetsWindowSettings) Validate() error {
+	return nil
+}
+
+func NewResetsWindowCheck(prom *promapi.FailoverGroup) ResetsWindowCheck {
+	return ResetsWindowCheck{prom: prom}
+}
+
+type ResetsWindowCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c ResetsWindowCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c ResetsWindowCheck) String() string {
+	return fmt.Sprintf("%s(%s)", ResetsWindowCheckName, c.prom.Name())
+}
+
+func (c ResetsWindowCheck) Reporter() string {
+	return ResetsWindowCheckName
+}
+
+func (c ResetsWindowCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "resets" {
+			continue
+		}
+
+		ms, ok := call.Args[0].(*promParser.MatrixSelector)
+		if !ok {
+			continue
+		}
+		vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+		if !ok {
+			continue
+		}
+
+		if _, ok := done[ms.String()]; ok {
+			continue
+		}
+		done[ms.String()] = struct{}{}
+
+		if ms.Range >= resetsWindowLookback {
+			continue
+		}
+
+		selector := promParser.VectorSelector{
+			Name:          vs.Name,
+			LabelMatchers: vs.LabelMatchers,
+		}
+		qr, err := c.prom.Query(ctx, fmt.Sprintf("avg(resets(%s[%s]))", selector.String(), output.HumanizeDuration(resetsWindowLookback)))
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+		if len(qr.Series) == 0 {
+			continue
+		}
+
+		resets := float64(qr.Series[0].Value)
+		if resets <= 0 {
+			// No resets at all, we can't estimate anything.
+			continue
+		}
+
+		interval := time.Duration(float64(resetsWindowLookback) / resets).Round(time.Second)
+		if ms.Range >= interval {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is using `%s` range but `%s` was only reset every %s on average in the last %s according to %s, most evaluations of this query will return zero.",
+				call.String(), output.HumanizeDuration(ms.Range), selector.String(), output.HumanizeDuration(interval),
+				output.HumanizeDuration(resetsWindowLookback), promText(c.prom.Name(), qr.URI)),
+			Details:  ResetsWindowCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
@@ -0,0 +1,170 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newResetsWindowCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewResetsWindowCheck(prom)
+}
+
+func resetsWindowText(call, rng, selector, interval, name, uri string) string {
+	return fmt.Sprintf("`%s` is using `%s` range but `%s` was only reset every %s on average in the last 1d according to `%s` Prometheus server at %s, most evaluations of this query will return zero.",
+		call, rng, selector, interval, name, uri)
+}
+
+func TestResetsWindowCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without resets()",
+			content:     "- record: foo\n  expr: changes(foo[5m])\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores resets() on subqueries",
+			content:     "- record: foo\n  expr: resets(sum(foo)[5m:])\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores windows longer than lookback",
+			content:     "- record: foo\n  expr: resets(foo[2d])\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: resets(foo[5m])\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ResetsWindowCheckName,
+						Text:     checkErrorUnableToRun(checks.ResetsWindowCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no results",
+			content:     "- record: foo\n  expr: resets(foo[5m])\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "avg(resets(foo[1d]))"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+		{
+			description: "no resets",
+			content:     "- record: foo\n  expr: resets(foo[5m])\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "avg(resets(foo[1d]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 0),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "window longer than reset interval",
+			content:     "- record: foo\n  expr: resets(foo[5m])\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "avg(resets(foo[1d]))"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 1440),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "window shorter than reset interval",
+			content:     "- alert: foo\n  expr: resets(foo{job=\"bar\"}[5m] offset 1h) > 0\n",
+			checker:     newResetsWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ResetsWindowCheckName,
+						Text:     resetsWindowText(`resets(foo{job="bar"}[5m] offset 1h)`, "5m", `foo{job="bar"}`, "1h", "prom", uri),
+						Details:  checks.ResetsWindowCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `avg(resets(foo{job="bar"}[1d]))`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{}, 24),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,99 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	RoundingCheckName    = "promql/rounding"
+	RoundingCheckDetails = `[Counters](https://prometheus.io/docs/concepts/metric_types/#counter) only ever go up and are meant to be used with functions like [rate()](https://prometheus.io/docs/prometheus/latest/querying/functions/#rate) or [increase()](https://prometheus.io/docs/prometheus/latest/querying/functions/#increase).
+Rounding the raw value of a counter with ` + "`floor()`, `ceil()` or `round()`" + ` is very unusual and usually means that the query is missing a ` + "`rate()`" + ` call.`
+)
+
+func NewRoundingCheck(prom *promapi.FailoverGroup) RoundingCheck {
+	return RoundingCheck{prom: prom}
+}
+
+type RoundingCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c RoundingCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c RoundingCheck) String() string {
+	return fmt.Sprintf("%s(%s)", RoundingCheckName, c.prom.Name())
+}
+
+func (c RoundingCheck) Reporter() string {
+	return RoundingCheckName
+}
+
+func (c RoundingCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		switch call.Func.Name {
+		case "floor", "ceil", "round":
+		default:
+			continue
+		}
+
+		vs, ok := unwrapParens(call.Args[0]).(*promParser.VectorSelector)
+		if !ok || vs.Name == "" {
+			continue
+		}
+
+		if _, ok := done[vs.Name]; ok {
+			continue
+		}
+		done[vs.Name] = struct{}{}
+
+		metadata, err := c.prom.Metadata(ctx, vs.Name)
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+		if !isCounterMetadata(metadata.Metadata) {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s()` is applied directly to `%s` which is a counter according to metrics metadata from %s, rounding raw counter values is unusual, did you mean to use `rate()` first?",
+				call.Func.Name, vs.Name, promText(c.prom.Name(), metadata.URI)),
+			Details:  RoundingCheckDetails,
+			Severity: Information,
+		})
+	}
+
+	return problems
+}
@@ -0,0 +1,186 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newRoundingCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewRoundingCheck(prom)
+}
+
+func roundingText(fn, name, uri, metric string) string {
+	return fmt.Sprintf("`%s()` is applied directly to `%s` which is a counter according to metrics metadata from `%s` Prometheus server at %s, rounding raw counter values is unusual, did you mean to use `rate()` first?", fn, metric, name, uri)
+}
+
+func TestRoundingCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without rounding",
+			content:     "- record: foo\n  expr: abs(http_requests_total)\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rounding of other expressions",
+			content:     "- record: foo\n  expr: round(rate(http_requests_total[5m]))\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: floor(http_requests_total)\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RoundingCheckName,
+						Text:     checkErrorUnableToRun(checks.RoundingCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "round(gauge)",
+			content:     "- record: foo\n  expr: round(temperature, 0.5)\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "floor(counter)",
+			content:     "- record: foo\n  expr: floor(http_requests_total{job=\"foo\"})\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RoundingCheckName,
+						Text:     roundingText("floor", "prom", uri, "http_requests_total"),
+						Details:  checks.RoundingCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "ceil(counter) used twice",
+			content:     "- alert: foo\n  expr: ceil((http_requests_total)) > 0 and round(http_requests_total) > 0\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RoundingCheckName,
+						Text:     roundingText("ceil", "prom", uri, "http_requests_total"),
+						Details:  checks.RoundingCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "floor(counter) with multiple metadata entries",
+			content:     "- record: foo\n  expr: floor(http_requests_total{job=\"foo\"})\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.RoundingCheckName,
+						Text:     roundingText("floor", "prom", uri, "http_requests_total"),
+						Details:  checks.RoundingCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter", Help: "Requests."}, {Type: "counter", Help: "HTTP requests."}},
+					}},
+				},
+			},
+		},
+		{
+			description: "floor(counter or gauge)",
+			content:     "- record: foo\n  expr: floor(http_requests_total{job=\"foo\"})\n",
+			checker:     newRoundingCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}, {Type: "gauge"}},
+					}},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,129 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	ScalarCheckName    = "promql/scalar"
+	ScalarCheckDetails = `[scalar()](https://prometheus.io/docs/prometheus/latest/querying/functions/#scalar) will only return a value if the query passed to it returns exactly one time series, otherwise it will return ` + "`NaN`" + `.
+To ensure that there's always a single time series returned aggregate the results first, for example using ` + "`scalar(sum(...))`" + `.`
+)
+
+type ScalarSettings struct{}
+
+func (s *ScalarSettings) Validate() error {
+	return nil
+}
+
+func NewScalarCheck(prom *promapi.FailoverGroup) ScalarCheck {
+	return ScalarCheck{prom: prom}
+}
+
+type ScalarCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c ScalarCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c ScalarCheck) String() string {
+	return fmt.Sprintf("%s(%s)", ScalarCheckName, c.prom.Name())
+}
+
+func (c ScalarCheck) Reporter() string {
+	return ScalarCheckName
+}
+
+func (c ScalarCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	expr := rule.RecordingRule.Expr
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "scalar" || len(call.Args) != 1 {
+			continue
+		}
+
+		arg := unwrapParens(call.Args[0])
+		if isSingleSeriesExpr(arg) {
+			continue
+		}
+
+		q := arg.String()
+		if _, ok := done[q]; ok {
+			continue
+		}
+		done[q] = struct{}{}
+
+		params := promapi.NewRelativeRange(time.Hour*24*7, time.Minute*5)
+		qr, err := c.prom.RangeQuery(ctx, fmt.Sprintf("count(%s) > 1", q), params)
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+		if len(qr.Series.Ranges) == 0 {
+			continue
+		}
+
+		delta := qr.Series.Until.Sub(qr.Series.From).Round(time.Minute)
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` returned more than one time series on %s in the last %s, `scalar()` will return `NaN` when that happens.",
+				q, promText(c.prom.Name(), qr.URI), output.HumanizeDuration(delta)),
+			Details:  ScalarCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
+
+// isSingleSeriesExpr returns true if given expression can never return more
+// than one time series.
+func isSingleSeriesExpr(node promParser.Node) bool {
+	switch n := node.(type) {
+	case *promParser.NumberLiteral:
+		return true
+	case *promParser.AggregateExpr:
+		switch n.Op {
+		case promParser.TOPK, promParser.BOTTOMK, promParser.COUNT_VALUES:
+			return false
+		}
+		return !n.Without && len(n.Grouping) == 0
+	case *promParser.Call:
+		switch n.Func.Name {
+		case "vector", "time":
+			return true
+		}
+	}
+	return false
+}
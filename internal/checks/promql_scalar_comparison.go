@@ -0,0 +1,82 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	ScalarComparisonCheckName    = "promql/scalar_comparison"
+	ScalarComparisonCheckDetails = `Comparison operators between a scalar and a time series will filter time series, only returning those for which the comparison is true.
+This is easy to miss when the scalar is on the left side of the comparison, which usually reads like a boolean test.
+If you want to filter results put the time series on the left side, if you want to get ` + "`0` or `1`" + ` for every time series use the ` + "`bool`" + ` modifier.`
+)
+
+var flippedComparisons = map[promParser.ItemType]promParser.ItemType{
+	promParser.EQLC: promParser.EQLC,
+	promParser.NEQ:  promParser.NEQ,
+	promParser.GTR:  promParser.LSS,
+	promParser.LSS:  promParser.GTR,
+	promParser.GTE:  promParser.LTE,
+	promParser.LTE:  promParser.GTE,
+}
+
+func NewScalarComparisonCheck() ScalarComparisonCheck {
+	return ScalarComparisonCheck{}
+}
+
+type ScalarComparisonCheck struct{}
+
+func (c ScalarComparisonCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c ScalarComparisonCheck) String() string {
+	return ScalarComparisonCheckName
+}
+
+func (c ScalarComparisonCheck) Reporter() string {
+	return ScalarComparisonCheckName
+}
+
+func (c ScalarComparisonCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.BinaryExpr](expr.Query) {
+		be := node.Expr.(*promParser.BinaryExpr)
+		if !be.Op.IsComparisonOperator() || be.ReturnBool {
+			continue
+		}
+		num, ok := unwrapParens(be.LHS).(*promParser.NumberLiteral)
+		if !ok || be.RHS.Type() != promParser.ValueTypeVector {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is using a scalar on the left side of a comparison without the `bool` modifier, this will only return time series for which the comparison is true, use `%s %s %s` to make it clear that this is a filter or `%s %s bool %s` to return `0` or `1` for every time series.",
+				be, be.RHS, flippedComparisons[be.Op], num, num, be.Op, be.RHS),
+			Details:  ScalarComparisonCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
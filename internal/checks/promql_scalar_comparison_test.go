@@ -0,0 +1,94 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newScalarComparisonCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewScalarComparisonCheck()
+}
+
+func TestScalarComparisonCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: 0 == foo without(\n",
+			checker:     newScalarComparisonCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "metric on the left side",
+			content:     "- alert: foo\n  expr: foo == 0\n",
+			checker:     newScalarComparisonCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "scalar on the left side with bool",
+			content:     "- record: foo\n  expr: 0 == bool foo\n",
+			checker:     newScalarComparisonCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "scalar compared with scalar",
+			content:     "- record: foo\n  expr: vector(1 > bool 0)\n",
+			checker:     newScalarComparisonCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "arithmetic with scalar on the left side",
+			content:     "- record: foo\n  expr: 100 * foo\n",
+			checker:     newScalarComparisonCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "scalar on the left side",
+			content:     "- record: foo\n  expr: 0 == foo\n",
+			checker:     newScalarComparisonCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ScalarComparisonCheckName,
+						Text:     "`0 == foo` is using a scalar on the left side of a comparison without the `bool` modifier, this will only return time series for which the comparison is true, use `foo == 0` to make it clear that this is a filter or `0 == bool foo` to return `0` or `1` for every time series.",
+						Details:  checks.ScalarComparisonCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "scalar in parens on the left side",
+			content:     "- alert: foo\n  expr: sum((10) < rate(errors_total[5m])) by(job)\n",
+			checker:     newScalarComparisonCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ScalarComparisonCheckName,
+						Text:     "`(10) < rate(errors_total[5m])` is using a scalar on the left side of a comparison without the `bool` modifier, this will only return time series for which the comparison is true, use `rate(errors_total[5m]) > 10` to make it clear that this is a filter or `10 < bool rate(errors_total[5m])` to return `0` or `1` for every time series.",
+						Details:  checks.ScalarComparisonCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,141 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newScalarCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewScalarCheck(prom)
+}
+
+func scalarText(name, uri, query, since string) string {
+	return fmt.Sprintf("`%s` returned more than one time series on `%s` Prometheus server at %s in the last %s, `scalar()` will return `NaN` when that happens.", query, name, uri, since)
+}
+
+func TestScalarCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: scalar(foo) > 0\n",
+			checker:     newScalarCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: scalar(foo\n",
+			checker:     newScalarCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without scalar()",
+			content:     "- record: foo\n  expr: sum(foo)\n",
+			checker:     newScalarCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores scalar() on aggregations",
+			content:     "- record: foo\n  expr: bar * scalar(sum(foo)) + scalar(max((foo)))\n",
+			checker:     newScalarCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores scalar() on vector() and time()",
+			content:     "- record: foo\n  expr: bar * scalar(vector(1)) + scalar(time())\n",
+			checker:     newScalarCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: bar * scalar(foo)\n",
+			checker:     newScalarCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ScalarCheckName,
+						Text:     checkErrorUnableToRun(checks.ScalarCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "single series",
+			content:     "- record: foo\n  expr: bar * scalar(sum(foo) by(job))\n",
+			checker:     newScalarCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: "count(sum by (job) (foo)) > 1"},
+					},
+					resp: matrixResponse{samples: []*model.SampleStream{}},
+				},
+			},
+		},
+		{
+			description: "multiple series",
+			content:     "- record: foo\n  expr: bar * scalar(foo{job=\"a\"}) / scalar(foo{job=\"a\"})\n",
+			checker:     newScalarCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ScalarCheckName,
+						Text:     scalarText("prom", uri, `foo{job="a"}`, "1w"),
+						Details:  checks.ScalarCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireRangeQueryPath,
+						formCond{key: "query", value: `count(foo{job="a"}) > 1`},
+					},
+					resp: matrixResponse{
+						samples: []*model.SampleStream{
+							generateSampleStream(
+								map[string]string{},
+								time.Now().Add(time.Hour*-20),
+								time.Now().Add(time.Hour*-10),
+								time.Minute*5,
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
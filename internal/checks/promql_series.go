@@ -25,7 +25,11 @@ type PromqlSeriesSettings struct {
 	LookbackRange         string   `hcl:"lookbackRange,optional" json:"lookbackRange,omitempty"`
 	LookbackStep          string   `hcl:"lookbackStep,optional" json:"lookbackStep,omitempty"`
 	IgnoreMetrics         []string `hcl:"ignoreMetrics,optional" json:"ignoreMetrics,omitempty"`
+	Skip                  []string `hcl:"skip,optional" json:"skip,omitempty"`
+	ReportActualServer    bool     `hcl:"reportActualServer,optional" json:"reportActualServer,omitempty"`
+	ServerSpecificMetrics bool     `hcl:"serverSpecificMetrics,optional" json:"serverSpecificMetrics,omitempty"`
 	ignoreMetricsRe       []*regexp.Regexp
+	skipRe                []*regexp.Regexp
 	lookbackRangeDuration time.Duration
 	lookbackStepDuration  time.Duration
 }
@@ -39,6 +43,14 @@ func (c *PromqlSeriesSettings) Validate() error {
 		c.ignoreMetricsRe = append(c.ignoreMetricsRe, re)
 	}
 
+	for _, re := range c.Skip {
+		re, err := regexp.Compile("^" + re + "$")
+		if err != nil {
+			return err
+		}
+		c.skipRe = append(c.skipRe, re)
+	}
+
 	c.lookbackRangeDuration = time.Hour * 24 * 7
 	if c.LookbackRange != "" {
 		dur, err := model.ParseDuration(c.LookbackRange)
@@ -72,8 +84,8 @@ To fully validate your changes it's best to first deploy the rules that generate
 [Click here](https://cloudflare.github.io/pint/checks/promql/series.html#min-age) to see supported syntax.`
 )
 
-func NewSeriesCheck(prom *promapi.FailoverGroup) SeriesCheck {
-	return SeriesCheck{prom: prom}
+func NewSeriesCheck(prom *promapi.FailoverGroup, others []*promapi.FailoverGroup) SeriesCheck {
+	return SeriesCheck{prom: prom, others: others}
 }
 
 func (c SeriesCheck) Meta() CheckMeta {
@@ -89,7 +101,25 @@ func (c SeriesCheck) Meta() CheckMeta {
 }
 
 type SeriesCheck struct {
-	prom *promapi.FailoverGroup
+	prom                  *promapi.FailoverGroup
+	others                []*promapi.FailoverGroup
+	reportActualServer    bool
+	serverSpecificMetrics bool
+}
+
+// WithReportActualServer controls if reported problems should point out
+// when the response came from a failover server instead of the primary one.
+func (c SeriesCheck) WithReportActualServer(enabled bool) SeriesCheck {
+	c.reportActualServer = enabled
+	return c
+}
+
+// WithServerSpecificMetrics controls if metrics that were never present
+// but are present on other servers this rule is deployed to should be
+// reported as a warning instead of a bug.
+func (c SeriesCheck) WithServerSpecificMetrics(enabled bool) SeriesCheck {
+	c.serverSpecificMetrics = enabled
+	return c
 }
 
 func (c SeriesCheck) String() string {
@@ -116,10 +146,20 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 		return problems
 	}
 
+	selectors := getSelectors(expr.Query)
+
 	params := promapi.NewRelativeRange(settings.lookbackRangeDuration, settings.lookbackStepDuration)
 
+	// Problems reported for a selector matching a `# pint ignore` comment
+	// are marked as suppressed once we move on to the next selector.
+	var suppressFrom int
+	var suppressReason string
+
 	done := map[string]bool{}
-	for _, selector := range getSelectors(expr.Query) {
+	for _, selector := range selectors {
+		markSuppressed(problems[suppressFrom:], suppressReason)
+		suppressFrom, suppressReason = len(problems), ""
+
 		if _, ok := done[selector.String()]; ok {
 			continue
 		}
@@ -131,6 +171,10 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 			continue
 		}
 
+		if reason, ok := ignoreReason(rule, selector); ok {
+			suppressReason = reason
+		}
+
 		metricName := selector.Name
 		if metricName == "" {
 			for _, lm := range selector.LabelMatchers {
@@ -141,6 +185,10 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 			}
 		}
 
+		if c.isSkipped(settings, metricName) {
+			continue
+		}
+
 		// 0. Special case for alert metrics
 		if metricName == "ALERTS" || metricName == "ALERTS_FOR_STATE" {
 			var alertname string
@@ -267,28 +315,35 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 					Lines:    expr.Value.Lines,
 					Reporter: c.Reporter(),
 					Text: fmt.Sprintf("%s didn't have any series for `%s` metric in the last %s but found recording rule that generates it, skipping further checks.",
-						promText(c.prom.Name(), trs.URI), bareSelector.String(), sinceDesc(trs.Series.From)),
+						c.serverText(trs.URI), bareSelector.String(), sinceDesc(trs.Series.From)),
 					Details:  SeriesCheckRuleDetails,
 					Severity: Information,
 				})
 				continue
 			}
 
-			text, severity := c.textAndSeverity(
-				settings,
+			text := fmt.Sprintf("%s didn't have any series for `%s` metric in the last %s.",
+				c.serverText(trs.URI),
 				bareSelector.String(),
-				fmt.Sprintf("%s didn't have any series for `%s` metric in the last %s.",
-					promText(c.prom.Name(), trs.URI),
+				sinceDesc(trs.Series.From),
+			)
+			severity := Bug
+			details, names := c.checkOtherServer(ctx, selector.String())
+			if len(names) > 0 {
+				text = fmt.Sprintf("%s didn't have any series for `%s` metric in the last %s but it's present on other Prometheus servers this rule is deployed to: %s.",
+					c.serverText(trs.URI),
 					bareSelector.String(),
 					sinceDesc(trs.Series.From),
-				),
-				Bug,
-			)
+					strings.Join(names, ", "),
+				)
+				severity = Warning
+			}
+			text, severity = c.textAndSeverity(settings, bareSelector.String(), text, severity)
 			problems = append(problems, Problem{
 				Lines:    expr.Value.Lines,
 				Reporter: c.Reporter(),
 				Text:     text,
-				Details:  c.checkOtherServer(ctx, selector.String()),
+				Details:  details,
 				Severity: severity,
 			})
 			slog.Debug("No historical series for base metric", slog.String("check", c.Reporter()), slog.String("selector", (&bareSelector).String()))
@@ -325,7 +380,7 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 					Reporter: c.Reporter(),
 					Text: fmt.Sprintf(
 						"%s has `%s` metric but there are no series with `%s` label in the last %s.",
-						promText(c.prom.Name(), trsLabelCount.URI), bareSelector.String(), name, sinceDesc(trsLabelCount.Series.From)),
+						c.serverText(trsLabelCount.URI), bareSelector.String(), name, sinceDesc(trsLabelCount.Series.From)),
 					Details:  SeriesCheckCommonProblemDetails,
 					Severity: Bug,
 				})
@@ -362,7 +417,7 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 				bareSelector.String(),
 				fmt.Sprintf(
 					"%s doesn't currently have `%s`, it was last present %s ago.",
-					promText(c.prom.Name(), trs.URI), bareSelector.String(), sinceDesc(newest(trs.Series.Ranges))),
+					c.serverText(trs.URI), bareSelector.String(), sinceDesc(newest(trs.Series.Ranges))),
 				Bug,
 			)
 			problems = append(problems, Problem{
@@ -408,7 +463,7 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 					bareSelector.String(),
 					fmt.Sprintf(
 						"%s has `%s` metric with `%s` label but there are no series matching `{%s}` in the last %s.",
-						promText(c.prom.Name(), trsLabel.URI), bareSelector.String(), lm.Name, lm.String(), sinceDesc(trs.Series.From)),
+						c.serverText(trsLabel.URI), bareSelector.String(), lm.Name, lm.String(), sinceDesc(trs.Series.From)),
 					Bug,
 				)
 				problems = append(problems, Problem{
@@ -469,7 +524,7 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 					bareSelector.String(),
 					fmt.Sprintf(
 						"%s has `%s` metric but doesn't currently have series matching `{%s}`, such series was last present %s ago.",
-						promText(c.prom.Name(), trs.URI), bareSelector.String(), lm.String(), sinceDesc(newest(trsLabel.Series.Ranges))),
+						c.serverText(trs.URI), bareSelector.String(), lm.String(), sinceDesc(newest(trsLabel.Series.Ranges))),
 					Bug,
 				)
 				problems = append(problems, Problem{
@@ -495,7 +550,7 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 					Reporter: c.Reporter(),
 					Text: fmt.Sprintf(
 						"Metric `%s` with label `{%s}` is only sometimes present on %s with average life span of %s.",
-						bareSelector.String(), lm.String(), promText(c.prom.Name(), trs.URI),
+						bareSelector.String(), lm.String(), c.serverText(trs.URI),
 						output.HumanizeDuration(avgLife(trsLabel.Series.Ranges))),
 					Details:  SeriesCheckCommonProblemDetails,
 					Severity: Warning,
@@ -519,7 +574,7 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 				Reporter: c.Reporter(),
 				Text: fmt.Sprintf(
 					"Metric `%s` is only sometimes present on %s with average life span of %s in the last %s.",
-					bareSelector.String(), promText(c.prom.Name(), trs.URI), output.HumanizeDuration(avgLife(trs.Series.Ranges)), sinceDesc(trs.Series.From)),
+					bareSelector.String(), c.serverText(trs.URI), output.HumanizeDuration(avgLife(trs.Series.Ranges)), sinceDesc(trs.Series.From)),
 				Details:  SeriesCheckCommonProblemDetails,
 				Severity: Warning,
 			})
@@ -530,18 +585,33 @@ func (c SeriesCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Ru
 			)
 		}
 	}
+	markSuppressed(problems[suppressFrom:], suppressReason)
 
 	return problems
 }
 
-func (c SeriesCheck) checkOtherServer(ctx context.Context, query string) string {
+// checkOtherServer queries all other Prometheus servers for given query and
+// returns problem details listing all servers where it was found.
+// If serverSpecificMetrics is enabled then it also returns the names of servers
+// this rule is deployed to that have it.
+func (c SeriesCheck) checkOtherServer(ctx context.Context, query string) (details string, names []string) {
 	var servers []*promapi.FailoverGroup
 	if val := ctx.Value(promapi.AllPrometheusServers); val != nil {
 		servers = val.([]*promapi.FailoverGroup)
 	}
 
+	deployed := map[string]struct{}{}
+	if c.serverSpecificMetrics {
+		for _, prom := range c.others {
+			deployed[prom.Name()] = struct{}{}
+			if !slices.ContainsFunc(servers, func(s *promapi.FailoverGroup) bool { return s.Name() == prom.Name() }) {
+				servers = append(servers, prom)
+			}
+		}
+	}
+
 	if len(servers) == 0 {
-		return SeriesCheckCommonProblemDetails
+		return SeriesCheckCommonProblemDetails, nil
 	}
 
 	var buf strings.Builder
@@ -574,16 +644,27 @@ func (c SeriesCheck) checkOtherServer(ctx context.Context, query string) string
 			buf.WriteString("/graph?g0.expr=")
 			buf.WriteString(query)
 			buf.WriteString(")\n")
+			if _, ok := deployed[prom.Name()]; ok {
+				names = append(names, "`"+prom.Name()+"`")
+			}
 		}
 	}
 
 	buf.WriteString("\nYou might be trying to deploy this rule to the wrong Prometheus server instance.\n")
 
 	if matches > 0 {
-		return buf.String()
+		return buf.String(), names
 	}
 
-	return SeriesCheckCommonProblemDetails
+	return SeriesCheckCommonProblemDetails, nil
+}
+
+func (c SeriesCheck) serverText(uri string) string {
+	text := promText(c.prom.Name(), uri)
+	if primary := c.prom.PrimaryURI(); c.reportActualServer && uri != primary {
+		text += fmt.Sprintf(" (failover for %s)", primary)
+	}
+	return text
 }
 
 func (c SeriesCheck) queryProblem(err error, expr parser.PromQLExpr) Problem {
@@ -659,6 +740,20 @@ func (c SeriesCheck) isLabelValueIgnored(rule parser.Rule, selector promParser.V
 	return false
 }
 
+func (c SeriesCheck) isSkipped(settings *PromqlSeriesSettings, name string) bool {
+	for _, re := range settings.skipRe {
+		if name != "" && re.MatchString(name) {
+			slog.Debug(
+				"Metric matches check skip rules",
+				slog.String("check", c.Reporter()),
+				slog.String("metric", name),
+				slog.String("regexp", re.String()))
+			return true
+		}
+	}
+	return false
+}
+
 func (c SeriesCheck) textAndSeverity(settings *PromqlSeriesSettings, name, text string, s Severity) (string, Severity) {
 	for _, re := range settings.ignoreMetricsRe {
 		if name != "" && re.MatchString(name) {
@@ -719,35 +814,70 @@ func stripLabels(selector promParser.VectorSelector) promParser.VectorSelector {
 }
 
 func isDisabled(rule parser.Rule, selector promParser.VectorSelector) bool {
+	for _, cs := range seriesDisableSelectors(rule) {
+		if disableMatchesSelector(cs, selector) {
+			return true
+		}
+	}
+	return false
+}
+
+func seriesDisableSelectors(rule parser.Rule) (selectors []string) {
 	for _, disable := range comments.Only[comments.Disable](rule.Comments, comments.DisableType) {
 		if strings.HasPrefix(disable.Match, SeriesCheckName+"(") && strings.HasSuffix(disable.Match, ")") {
-			cs := strings.TrimSuffix(strings.TrimPrefix(disable.Match, SeriesCheckName+"("), ")")
-			// try full string or name match first
-			if cs == selector.String() || cs == selector.Name {
-				return true
-			}
-			// then try matchers
-			m, err := promParser.ParseMetricSelector(cs)
-			if err != nil {
-				continue
-			}
-			for _, l := range m {
-				var isMatch bool
-				for _, s := range selector.LabelMatchers {
-					if s.Type == l.Type && s.Name == l.Name && s.Value == l.Value {
-						isMatch = true
-						break
-					}
-				}
-				if !isMatch {
-					goto NEXT
-				}
+			selectors = append(selectors, strings.TrimSuffix(strings.TrimPrefix(disable.Match, SeriesCheckName+"("), ")"))
+		}
+	}
+	return selectors
+}
+
+// ignoreReason returns the reason from the first `# pint ignore` comment
+// matching given selector.
+func ignoreReason(rule parser.Rule, selector promParser.VectorSelector) (string, bool) {
+	for _, ignore := range comments.Only[comments.Ignore](rule.Comments, comments.IgnoreType) {
+		if !strings.HasPrefix(ignore.Match, SeriesCheckName+"(") || !strings.HasSuffix(ignore.Match, ")") {
+			continue
+		}
+		cs := strings.TrimSuffix(strings.TrimPrefix(ignore.Match, SeriesCheckName+"("), ")")
+		if disableMatchesSelector(cs, selector) {
+			return ignore.Reason, true
+		}
+	}
+	return "", false
+}
+
+func markSuppressed(problems []Problem, reason string) {
+	if reason == "" {
+		return
+	}
+	for i := range problems {
+		problems[i].SuppressedBy = reason
+	}
+}
+
+func disableMatchesSelector(cs string, selector promParser.VectorSelector) bool {
+	// try full string or name match first
+	if cs == selector.String() || cs == selector.Name {
+		return true
+	}
+	// then try matchers
+	m, err := promParser.ParseMetricSelector(cs)
+	if err != nil {
+		return false
+	}
+	for _, l := range m {
+		var isMatch bool
+		for _, s := range selector.LabelMatchers {
+			if s.Type == l.Type && s.Name == l.Name && s.Value == l.Value {
+				isMatch = true
+				break
 			}
-			return true
 		}
-	NEXT:
+		if !isMatch {
+			return false
+		}
 	}
-	return false
+	return true
 }
 
 func sinceDesc(t time.Time) (s string) {
@@ -0,0 +1,102 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+
+	"github.com/prometheus/prometheus/model/labels"
+	promParser "github.com/prometheus/prometheus/promql/parser"
+)
+
+const (
+	SeriesDisableCheckName    = "promql/series_disable"
+	SeriesDisableCheckDetails = `A disable comment with a metric selector will only disable [promql/series](https://cloudflare.github.io/pint/checks/promql/series.html) checks for selectors that have all the label matchers from that comment.
+Any other selector for the same metric will still be checked.`
+)
+
+func NewSeriesDisableCheck() SeriesDisableCheck {
+	return SeriesDisableCheck{}
+}
+
+type SeriesDisableCheck struct{}
+
+func (c SeriesDisableCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c SeriesDisableCheck) String() string {
+	return SeriesDisableCheckName
+}
+
+func (c SeriesDisableCheck) Reporter() string {
+	return SeriesDisableCheckName
+}
+
+func (c SeriesDisableCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	selectors := getSelectors(expr.Query)
+	for _, cs := range seriesDisableSelectors(rule) {
+		m, err := promParser.ParseMetricSelector(cs)
+		if err != nil {
+			continue
+		}
+		var name string
+		for _, l := range m {
+			if l.Name == labels.MetricName && l.Type == labels.MatchEqual {
+				name = l.Value
+			}
+		}
+		if name == "" || cs == name {
+			continue
+		}
+
+		var matched int
+		var unmatched []string
+		for _, selector := range selectors {
+			if selector.Name != name {
+				continue
+			}
+			if disableMatchesSelector(cs, selector) {
+				matched++
+			} else if !slices.Contains(unmatched, selector.String()) {
+				unmatched = append(unmatched, selector.String())
+			}
+		}
+		if len(unmatched) == 0 {
+			continue
+		}
+
+		text := fmt.Sprintf("`%s` comment only disables checks for some selectors of `%s`, `%s` will still be checked.",
+			"# pint disable "+SeriesCheckName+"("+cs+")", name, strings.Join(unmatched, "`, `"))
+		if matched == 0 {
+			text = fmt.Sprintf("`%s` comment doesn't match any selector of `%s` used in this query, `%s` will still be checked.",
+				"# pint disable "+SeriesCheckName+"("+cs+")", name, strings.Join(unmatched, "`, `"))
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Details:  SeriesDisableCheckDetails,
+			Severity: Warning,
+		})
+	}
+	return problems
+}
@@ -0,0 +1,115 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newSeriesDisableCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewSeriesDisableCheck()
+}
+
+func TestSeriesDisableCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newSeriesDisableCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "no disable comments",
+			content:     "- record: foo\n  expr: count(notfound{job=\"foo\"})\n",
+			checker:     newSeriesDisableCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "disable comment with metric name only",
+			content: `
+# pint disable promql/series(notfound)
+- record: foo
+  expr: count(notfound{job="foo"}) + count(notfound{job="bar"})
+`,
+			checker:    newSeriesDisableCheck,
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "disable comment doesn't match any selector",
+			content: `
+# pint disable promql/series(notfound{job="foo"})
+- record: foo
+  expr: count(notfound) == 0
+`,
+			checker:    newSeriesDisableCheck,
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.SeriesDisableCheckName,
+						Text:     "`# pint disable promql/series(notfound{job=\"foo\"})` comment doesn't match any selector of `notfound` used in this query, `notfound` will still be checked.",
+						Details:  checks.SeriesDisableCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "disable comment covers some selectors",
+			content: `
+# pint disable promql/series(notfound{job="foo"})
+- record: foo
+  expr: count(notfound{job="foo"}) + count(notfound{job="bar"})
+`,
+			checker:    newSeriesDisableCheck,
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.SeriesDisableCheckName,
+						Text:     "`# pint disable promql/series(notfound{job=\"foo\"})` comment only disables checks for some selectors of `notfound`, `notfound{job=\"bar\"}` will still be checked.",
+						Details:  checks.SeriesDisableCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "disable comment covers all selectors",
+			content: `
+# pint disable promql/series(notfound{job="foo"})
+- record: foo
+  expr: count(notfound{job="foo"}) + count(notfound{job="foo", instance="bar"})
+`,
+			checker:    newSeriesDisableCheck,
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "disable comment for other metric",
+			content: `
+# pint disable promql/series(other{job="foo"})
+- record: foo
+  expr: count(notfound{job="bar"})
+`,
+			checker:    newSeriesDisableCheck,
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+	}
+
+	runTests(t, testCases)
+}
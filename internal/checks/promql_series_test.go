@@ -3,9 +3,13 @@ package checks_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 
 	"github.com/cloudflare/pint/internal/checks"
@@ -14,7 +18,23 @@ import (
 )
 
 func newSeriesCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
-	return checks.NewSeriesCheck(prom)
+	return checks.NewSeriesCheck(prom, nil)
+}
+
+func failoverProm(uri string) *promapi.FailoverGroup {
+	return promapi.NewFailoverGroup(
+		"prom",
+		"http://127.0.0.1:1111",
+		[]*promapi.Prometheus{
+			promapi.NewPrometheus("prom", "http://127.0.0.1:1111", "", map[string]string{"X-Debug": "1"}, time.Second, 16, 1000, nil),
+			promapi.NewPrometheus("prom", uri, "", map[string]string{"X-Debug": "1"}, time.Second, 16, 1000, nil),
+		},
+		true,
+		"up",
+		[]*regexp.Regexp{},
+		[]*regexp.Regexp{},
+		[]string{},
+	)
 }
 
 func noMetricText(name, uri, metric, since string) string {
@@ -466,6 +486,66 @@ func TestSeriesCheck(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "series skipped via settings",
+			content:     "- record: foo\n  expr: sum(kube_pod_info) / sum(kube_pod_status_ready)\n",
+			ctx: func() context.Context {
+				s := checks.PromqlSeriesSettings{
+					Skip: []string{"kube_pod_.*"},
+				}
+				if err := s.Validate(); err != nil {
+					t.Error(err)
+					t.FailNow()
+				}
+				return context.WithValue(context.Background(), checks.SettingsKey(checks.SeriesCheckName), &s)
+			},
+			checker:    newSeriesCheck,
+			prometheus: newSimpleProm,
+			problems:   noProblems,
+		},
+		{
+			description: "series partially skipped via settings",
+			content:     "- record: foo\n  expr: sum(kube_pod_info) / sum(notfound)\n",
+			ctx: func() context.Context {
+				s := checks.PromqlSeriesSettings{
+					Skip: []string{"kube_pod_.*"},
+				}
+				if err := s.Validate(); err != nil {
+					t.Error(err)
+					t.FailNow()
+				}
+				return context.WithValue(context.Background(), checks.SettingsKey(checks.SeriesCheckName), &s)
+			},
+			checker:    newSeriesCheck,
+			prometheus: newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SeriesCheckName,
+						Text:     noMetricText("prom", uri, "notfound", "1w"),
+						Details:  checks.SeriesCheckCommonProblemDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(notfound)"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
 		{
 			description: "#2 series never present but recording rule provides it correctly",
 			content:     "- record: foo\n  expr: sum(foo:bar{job=\"xxx\"})\n",
@@ -2960,6 +3040,75 @@ func TestSeriesCheck(t *testing.T) {
 # pint disable promql/series({job="foo"})
 - record: foo
   expr: count(notfound{job=~"foo"}) == 0
+`,
+			checker:    newSeriesCheck,
+			prometheus: newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.SeriesCheckName,
+						Text:     noMetricText("prom", uri, "notfound", "1w"),
+						Details:  checks.SeriesCheckCommonProblemDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
+		{
+			description: "series missing, ignored with reason",
+			content: `
+# pint ignore promql/series(notfound) reason="not scraped yet"
+- record: foo
+  expr: count(notfound{job="foo"}) == 0
+`,
+			checker:    newSeriesCheck,
+			prometheus: newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter:     checks.SeriesCheckName,
+						Text:         noMetricText("prom", uri, "notfound", "1w"),
+						Details:      checks.SeriesCheckCommonProblemDetails,
+						Severity:     checks.Bug,
+						SuppressedBy: "not scraped yet",
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
+		{
+			description: "series missing, ignore comment for another selector",
+			content: `
+# pint ignore promql/series(notfound{job="bar"}) reason="not scraped yet"
+- record: foo
+  expr: count(notfound{job="foo"}) == 0
 `,
 			checker:    newSeriesCheck,
 			prometheus: newSimpleProm,
@@ -3423,6 +3572,227 @@ func TestSeriesCheck(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "failover / actual server not reported",
+			content:     "- record: foo\n  expr: sum(notfound)\n",
+			checker: func(prom *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewSeriesCheck(prom, nil).WithReportActualServer(false)
+			},
+			prometheus: failoverProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SeriesCheckName,
+						Text:     noMetricText("prom", uri, "notfound", "1w"),
+						Details:  checks.SeriesCheckCommonProblemDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
+		{
+			description: "failover / actual server reported",
+			content:     "- record: foo\n  expr: sum(notfound)\n",
+			checker: func(prom *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewSeriesCheck(prom, nil).WithReportActualServer(true)
+			},
+			prometheus: failoverProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SeriesCheckName,
+						Text:     noMetricText("prom", uri+" (failover for http://127.0.0.1:1111)", "notfound", "1w"),
+						Details:  checks.SeriesCheckCommonProblemDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
+		{
+			description: "no failover / actual server reported",
+			content:     "- record: foo\n  expr: sum(notfound)\n",
+			checker: func(prom *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewSeriesCheck(prom, nil).WithReportActualServer(true)
+			},
+			prometheus: newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SeriesCheckName,
+						Text:     noMetricText("prom", uri, "notfound", "1w"),
+						Details:  checks.SeriesCheckCommonProblemDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
+		{
+			description: "metric present on other servers",
+			content:     "- record: foo\n  expr: sum(notfound{job=\"foo\"})\n",
+			checker: func(prom *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewSeriesCheck(prom, []*promapi.FailoverGroup{otherServerWithSeries(t)}).WithServerSpecificMetrics(true)
+			},
+			prometheus: newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SeriesCheckName,
+						Text:     fmt.Sprintf("`prom` Prometheus server at %s didn't have any series for `notfound` metric in the last 1w but it's present on other Prometheus servers this rule is deployed to: `other`.", uri),
+						Details:  "`notfound{job=\"foo\"}` was found on other prometheus servers:\n\n- [other](http://other.example.com/graph?g0.expr=notfound{job=\"foo\"})\n\nYou might be trying to deploy this rule to the wrong Prometheus server instance.\n",
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
+		{
+			description: "metric present on other servers / serverSpecificMetrics disabled",
+			content:     "- record: foo\n  expr: sum(notfound{job=\"foo\"})\n",
+			checker: func(prom *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewSeriesCheck(prom, []*promapi.FailoverGroup{otherServerWithSeries(t)})
+			},
+			prometheus: newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SeriesCheckName,
+						Text:     noMetricText("prom", uri, "notfound", "1w"),
+						Details:  checks.SeriesCheckCommonProblemDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
+		{
+			description: "metric missing on other servers",
+			content:     "- record: foo\n  expr: sum(notfound)\n",
+			checker: func(prom *promapi.FailoverGroup) checks.RuleChecker {
+				other := simpleProm("other", prom.PrimaryURI(), time.Second*5, true)
+				other.StartWorkers(prometheus.NewRegistry())
+				return checks.NewSeriesCheck(prom, []*promapi.FailoverGroup{other}).WithServerSpecificMetrics(true)
+			},
+			prometheus: newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SeriesCheckName,
+						Text:     noMetricText("prom", uri, "notfound", "1w"),
+						Details:  checks.SeriesCheckCommonProblemDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithEmptyVector(),
+				},
+				{
+					conds: []requestCondition{requireRangeQueryPath},
+					resp:  respondWithEmptyMatrix(),
+				},
+			},
+		},
 	}
 	runTests(t, testCases)
 }
+
+// otherServerWithSeries returns a Prometheus server that responds with a
+// single series to every instant query.
+func otherServerWithSeries(t *testing.T) *promapi.FailoverGroup {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1614859502.068,"1"]}]}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	prom := promapi.NewFailoverGroup(
+		"other",
+		"http://other.example.com",
+		[]*promapi.Prometheus{
+			promapi.NewPrometheus("other", srv.URL, "http://other.example.com", nil, time.Second*5, 16, 1000, nil),
+		},
+		true,
+		"up",
+		nil,
+		nil,
+		nil,
+	)
+	reg := prometheus.NewRegistry()
+	prom.StartWorkers(reg)
+	t.Cleanup(func() { prom.Close(reg) })
+	return prom
+}
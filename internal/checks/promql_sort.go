@@ -0,0 +1,66 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	SortCheckName    = "promql/sort"
+	SortCheckDetails = `[sort()](https://prometheus.io/docs/prometheus/latest/querying/functions/#sort) and [sort_desc()](https://prometheus.io/docs/prometheus/latest/querying/functions/#sort_desc) only change the order of returned time series.
+Recording rules don't preserve that order when storing results and it has no effect on any aggregation or join done on the results, so sorting there only wastes CPU.
+Sort results in the query that reads recorded time series instead.`
+)
+
+func NewSortCheck() SortCheck {
+	return SortCheck{}
+}
+
+type SortCheck struct{}
+
+func (c SortCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c SortCheck) String() string {
+	return SortCheckName
+}
+
+func (c SortCheck) Reporter() string {
+	return SortCheckName
+}
+
+func (c SortCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.Call](rule.RecordingRule.Expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "sort" && call.Func.Name != "sort_desc" {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    rule.RecordingRule.Expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     fmt.Sprintf("Recording rule is using `%s()` which has no effect on recorded time series.", call.Func.Name),
+			Details:  SortCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
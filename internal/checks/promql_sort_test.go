@@ -0,0 +1,90 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newSortCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewSortCheck()
+}
+
+func TestSortCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: sort(up\n",
+			checker:     newSortCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: sort_desc(up == 0)\n",
+			checker:     newSortCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without sort()",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newSortCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "sort() in recording rule",
+			content:     "- record: foo\n  expr: sort(sum(up) by(job))\n",
+			checker:     newSortCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SortCheckName,
+						Text:     "Recording rule is using `sort()` which has no effect on recorded time series.",
+						Details:  checks.SortCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "nested sort_desc() in recording rule",
+			content:     "- record: foo\n  expr: sum(sort_desc(up)) by(job) / count(sort(up)) by(job)\n",
+			checker:     newSortCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SortCheckName,
+						Text:     "Recording rule is using `sort_desc()` which has no effect on recorded time series.",
+						Details:  checks.SortCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SortCheckName,
+						Text:     "Recording rule is using `sort()` which has no effect on recorded time series.",
+						Details:  checks.SortCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,100 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	StddevCheckName    = "promql/stddev"
+	StddevCheckDetails = `[stddev()](https://prometheus.io/docs/prometheus/latest/querying/operators/#aggregation-operators) and ` + "`stdvar()`" + ` calculate the spread of values across all time series in each group.
+A group with only one time series has no spread, so the result will always be ` + "`0`" + `.
+Double check the labels passed to ` + "`by(...)`" + `, you might be keeping a label that is unique to each time series.`
+)
+
+func NewStddevCheck(prom *promapi.FailoverGroup) StddevCheck {
+	return StddevCheck{prom: prom}
+}
+
+type StddevCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c StddevCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c StddevCheck) String() string {
+	return fmt.Sprintf("%s(%s)", StddevCheckName, c.prom.Name())
+}
+
+func (c StddevCheck) Reporter() string {
+	return StddevCheckName
+}
+
+func (c StddevCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.AggregateExpr](expr.Query) {
+		agg := node.Expr.(*promParser.AggregateExpr)
+		if agg.Op != promParser.STDDEV && agg.Op != promParser.STDVAR {
+			continue
+		}
+		if agg.Without || len(agg.Grouping) == 0 {
+			continue
+		}
+
+		qr, err := c.prom.Query(ctx, fmt.Sprintf("count(%s) by (%s)", agg.Expr.String(), strings.Join(agg.Grouping, ", ")))
+		if err != nil {
+			text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text:     text,
+				Severity: severity,
+			})
+			continue
+		}
+		if len(qr.Series) == 0 {
+			continue
+		}
+
+		var total float64
+		for _, s := range qr.Series {
+			total += s.Value
+		}
+		if total/float64(len(qr.Series)) != 1 {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s()` is aggregating by `%s` but each group only has a single time series according to %s, this query will always return `0`.",
+				agg.Op, strings.Join(agg.Grouping, ", "), promText(c.prom.Name(), qr.URI)),
+			Details:  StddevCheckDetails,
+			Severity: Information,
+		})
+	}
+
+	return problems
+}
@@ -0,0 +1,150 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newStddevCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewStddevCheck(prom)
+}
+
+func stddevText(name, uri, fn, labels string) string {
+	return fmt.Sprintf("`%s()` is aggregating by `%s` but each group only has a single time series according to `%s` Prometheus server at %s, this query will always return `0`.", fn, labels, name, uri)
+}
+
+func TestStddevCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: stddev(foo) by (job\n",
+			checker:     newStddevCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores other aggregations",
+			content:     "- record: foo\n  expr: sum(foo) by (job)\n",
+			checker:     newStddevCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores stddev() without by",
+			content:     "- record: foo\n  expr: stddev(foo)\n",
+			checker:     newStddevCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores stddev() with without",
+			content:     "- record: foo\n  expr: stddev(foo) without (instance)\n",
+			checker:     newStddevCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: stddev(foo) by (job)\n",
+			checker:     newStddevCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.StddevCheckName,
+						Text:     checkErrorUnableToRun(checks.StddevCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no results",
+			content:     "- record: foo\n  expr: stddev(foo) by (job)\n",
+			checker:     newStddevCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(foo) by (job)`},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+		{
+			description: "groups with multiple series",
+			content:     "- record: foo\n  expr: stddev(foo) by (job)\n",
+			checker:     newStddevCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(foo) by (job)`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{"job": "a"}, 1),
+							generateSampleWithValue(map[string]string{"job": "b"}, 5),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "single series groups",
+			content:     "- record: foo\n  expr: stdvar(rate(foo[5m])) by (job, instance)\n",
+			checker:     newStddevCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.StddevCheckName,
+						Text:     stddevText("prom", uri, "stdvar", "job, instance"),
+						Details:  checks.StddevCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: `count(rate(foo[5m])) by (job, instance)`},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSampleWithValue(map[string]string{"job": "a", "instance": "1"}, 1),
+							generateSampleWithValue(map[string]string{"job": "a", "instance": "2"}, 1),
+						},
+					},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
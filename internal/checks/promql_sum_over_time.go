@@ -0,0 +1,105 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	SumOverTimeCheckName    = "promql/sum_over_time"
+	SumOverTimeCheckDetails = `[sum_over_time()](https://prometheus.io/docs/prometheus/latest/querying/functions/#aggregation_over_time) adds up all raw samples in the given time range and should only be used with gauges.
+[Counters](https://prometheus.io/docs/concepts/metric_types/#counter) are always growing, so the sum of counter values depends on how many samples were scraped and how big the counter was at that time, it doesn't tell you anything about the number of events.
+To calculate how much a counter has increased in the given time range use the [increase()](https://prometheus.io/docs/prometheus/latest/querying/functions/#increase) function instead, it also handles counter resets correctly.`
+)
+
+func NewSumOverTimeCheck(prom *promapi.FailoverGroup) SumOverTimeCheck {
+	return SumOverTimeCheck{prom: prom}
+}
+
+type SumOverTimeCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c SumOverTimeCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c SumOverTimeCheck) String() string {
+	return fmt.Sprintf("%s(%s)", SumOverTimeCheckName, c.prom.Name())
+}
+
+func (c SumOverTimeCheck) Reporter() string {
+	return SumOverTimeCheckName
+}
+
+func (c SumOverTimeCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "sum_over_time" {
+			continue
+		}
+
+		for _, arg := range call.Args {
+			ms, ok := arg.(*promParser.MatrixSelector)
+			if !ok {
+				continue
+			}
+			vs, ok := ms.VectorSelector.(*promParser.VectorSelector)
+			if !ok || vs.Name == "" {
+				continue
+			}
+
+			if _, ok := done[vs.Name]; ok {
+				continue
+			}
+			done[vs.Name] = struct{}{}
+
+			metadata, err := c.prom.Metadata(ctx, vs.Name)
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				continue
+			}
+			if !isCounterMetadata(metadata.Metadata) {
+				continue
+			}
+
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`sum_over_time()` should only be used with gauges but `%s` is a counter according to metrics metadata from %s, use `increase()` instead.",
+					vs.Name, promText(c.prom.Name(), metadata.URI)),
+				Details:  SumOverTimeCheckDetails,
+				Severity: Bug,
+			})
+		}
+	}
+
+	return problems
+}
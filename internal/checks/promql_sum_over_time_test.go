@@ -0,0 +1,171 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newSumOverTimeCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewSumOverTimeCheck(prom)
+}
+
+func sumOverTimeText(name, uri, metric string) string {
+	return fmt.Sprintf("`sum_over_time()` should only be used with gauges but `%s` is a counter according to metrics metadata from `%s` Prometheus server at %s, use `increase()` instead.", metric, name, uri)
+}
+
+func TestSumOverTimeCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without sum_over_time()",
+			content:     "- record: foo\n  expr: increase(http_requests_total[5m])\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores sum_over_time() on subqueries",
+			content:     "- record: foo\n  expr: sum_over_time(sum(temperature)[5m:])\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: sum_over_time(http_requests_total[5m])\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SumOverTimeCheckName,
+						Text:     checkErrorUnableToRun(checks.SumOverTimeCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "sum_over_time(gauge)",
+			content:     "- record: foo\n  expr: sum_over_time(temperature[5m])\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"temperature": {{Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "sum_over_time(counter) / no metadata",
+			content:     "- record: foo\n  expr: sum_over_time(http_requests_total[5m])\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp:  metadataResponse{metadata: map[string][]v1.Metadata{}},
+				},
+			},
+		},
+		{
+			description: "sum_over_time(counter) / mixed metadata",
+			content:     "- record: foo\n  expr: sum_over_time(http_requests_total[5m])\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}, {Type: "gauge"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "sum_over_time(counter)",
+			content:     "- alert: foo\n  expr: sum_over_time(http_requests_total[5m]) > 0\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SumOverTimeCheckName,
+						Text:     sumOverTimeText("prom", uri, "http_requests_total"),
+						Details:  checks.SumOverTimeCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+		{
+			description: "sum_over_time(counter) used twice",
+			content:     "- record: foo\n  expr: sum_over_time(http_requests_total[5m]) / sum_over_time(http_requests_total[10m])\n",
+			checker:     newSumOverTimeCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SumOverTimeCheckName,
+						Text:     sumOverTimeText("prom", uri, "http_requests_total"),
+						Details:  checks.SumOverTimeCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireMetadataPath},
+					resp: metadataResponse{metadata: map[string][]v1.Metadata{
+						"http_requests_total": {{Type: "counter"}},
+					}},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,101 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	SumOverTimeWindowCheckName    = "promql/sum_over_time_window"
+	SumOverTimeWindowCheckDetails = `[sum_over_time()](https://prometheus.io/docs/prometheus/latest/querying/functions/#aggregation_over_time) adds up all raw samples in the given time range.
+When a recording rule is evaluated at a fixed interval and the time range isn't a multiple of that interval, then consecutive evaluations will cover windows that don't line up with each other.
+Some samples will be included in more results than others, which makes it hard to correctly aggregate recorded values later.
+Use a time range that is a multiple of the evaluation interval.`
+)
+
+func NewSumOverTimeWindowCheck(prom *promapi.FailoverGroup) SumOverTimeWindowCheck {
+	return SumOverTimeWindowCheck{prom: prom}
+}
+
+type SumOverTimeWindowCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c SumOverTimeWindowCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c SumOverTimeWindowCheck) String() string {
+	return fmt.Sprintf("%s(%s)", SumOverTimeWindowCheckName, c.prom.Name())
+}
+
+func (c SumOverTimeWindowCheck) Reporter() string {
+	return SumOverTimeWindowCheckName
+}
+
+func (c SumOverTimeWindowCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	expr := rule.Expr()
+	var interval time.Duration
+	var source string
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "sum_over_time" {
+			continue
+		}
+		ms, ok := call.Args[0].(*promParser.MatrixSelector)
+		if !ok {
+			continue
+		}
+
+		if interval == 0 {
+			var err error
+			if interval, source, err = ruleEvaluationInterval(ctx, c.prom, rule); err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				return problems
+			}
+			if interval <= 0 {
+				return problems
+			}
+		}
+
+		if ms.Range%interval == 0 {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is using `%s` range which is not a multiple of the evaluation interval of `%s` %s, time windows of consecutive evaluations won't line up.",
+				call.String(), output.HumanizeDuration(ms.Range), output.HumanizeDuration(interval), source),
+			Details:  SumOverTimeWindowCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
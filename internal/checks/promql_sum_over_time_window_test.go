@@ -0,0 +1,145 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newSumOverTimeWindowCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewSumOverTimeWindowCheck(prom)
+}
+
+func sumOverTimeWindowText(call, window, interval, source string) string {
+	return fmt.Sprintf("`%s` is using `%s` range which is not a multiple of the evaluation interval of `%s` %s, time windows of consecutive evaluations won't line up.",
+		call, window, interval, source)
+}
+
+func TestSumOverTimeWindowCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum_over_time(foo[1m]\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: sum_over_time(foo[90s]) > 0\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without sum_over_time",
+			content:     "- record: foo\n  expr: avg_over_time(foo[90s])\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores subqueries",
+			content:     "- record: foo\n  expr: sum_over_time(rate(foo[5m])[90s:])\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: sum_over_time(foo[90s])\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SumOverTimeWindowCheckName,
+						Text:     checkErrorUnableToRun(checks.SumOverTimeWindowCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "window is a multiple of evaluation_interval",
+			content:     "- record: foo\n  expr: sum_over_time(foo[5m])\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  evaluation_interval: 1m\n"},
+				},
+			},
+		},
+		{
+			description: "window is not a multiple of evaluation_interval",
+			content:     "- record: foo\n  expr: sum_over_time(foo[90s]) / sum_over_time(bar[2m])\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.SumOverTimeWindowCheckName,
+						Text:     sumOverTimeWindowText("sum_over_time(foo[1m30s])", "1m30s", "1m", fmt.Sprintf("configured on `prom` Prometheus server at %s", uri)),
+						Details:  checks.SumOverTimeWindowCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  evaluation_interval: 1m\n"},
+				},
+			},
+		},
+		{
+			description: "window is not a multiple of group interval",
+			content:     "groups:\n- name: foo\n  interval: 2m\n  rules:\n  - record: foo\n    expr: sum_over_time(foo[5m])\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 6,
+							Last:  6,
+						},
+						Reporter: checks.SumOverTimeWindowCheckName,
+						Text:     sumOverTimeWindowText("sum_over_time(foo[5m])", "5m", "2m", "set on the rule group"),
+						Details:  checks.SumOverTimeWindowCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "window is a multiple of group interval",
+			content:     "groups:\n- name: foo\n  interval: 30s\n  rules:\n  - record: foo\n    expr: sum_over_time(foo[2m])\n",
+			checker:     newSumOverTimeWindowCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+	}
+
+	runTests(t, testCases)
+}
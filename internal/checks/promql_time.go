@@ -0,0 +1,74 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	TimeCheckName    = "promql/time"
+	TimeCheckDetails = `The [time()](https://prometheus.io/docs/prometheus/latest/querying/functions/#time) function returns the time when the rule was evaluated.
+Recording rules using it will record a value that depends on the evaluation time, so results will be different when the rule is replayed or used to backfill data.
+Alerting rules can use ` + "`time()`" + ` to calculate how long ago something happened, but it's worth checking that the query doesn't depend on the evaluation time in any other way.`
+)
+
+func NewTimeCheck() TimeCheck {
+	return TimeCheck{}
+}
+
+type TimeCheck struct{}
+
+func (c TimeCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c TimeCheck) String() string {
+	return TimeCheckName
+}
+
+func (c TimeCheck) Reporter() string {
+	return TimeCheckName
+}
+
+func (c TimeCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	kind, severity := "Recording", Warning
+	if rule.AlertingRule != nil {
+		kind, severity = "Alerting", Information
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "time" {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     fmt.Sprintf("%s rule is using `%s` which makes the result depend on the evaluation time.", kind, call),
+			Details:  TimeCheckDetails,
+			Severity: severity,
+		})
+		// Report time() only once, all calls return the same value.
+		break
+	}
+
+	return problems
+}
@@ -0,0 +1,94 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newTimeCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewTimeCheck()
+}
+
+func TestTimeCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: time(\n",
+			checker:     newTimeCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without time()",
+			content:     "- record: foo\n  expr: sum(timestamp(up))\n",
+			checker:     newTimeCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "time() in recording rule",
+			content:     "- record: foo\n  expr: time() - max(process_start_time_seconds) by(job)\n",
+			checker:     newTimeCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.TimeCheckName,
+						Text:     "Recording rule is using `time()` which makes the result depend on the evaluation time.",
+						Details:  checks.TimeCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "time() in alerting rule",
+			content:     "- alert: foo\n  expr: time() - timestamp(up) > 300\n",
+			checker:     newTimeCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.TimeCheckName,
+						Text:     "Alerting rule is using `time()` which makes the result depend on the evaluation time.",
+						Details:  checks.TimeCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "multiple time() calls",
+			content:     "- record: foo\n  expr: (time() - foo) / (time() - bar)\n",
+			checker:     newTimeCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.TimeCheckName,
+						Text:     "Recording rule is using `time()` which makes the result depend on the evaluation time.",
+						Details:  checks.TimeCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
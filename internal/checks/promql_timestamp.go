@@ -0,0 +1,65 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	TimestampCheckName    = "promql/timestamp"
+	TimestampCheckDetails = `Recording rules using [timestamp()](https://prometheus.io/docs/prometheus/latest/querying/functions/#timestamp) will record a value that depends on the time when the rule was evaluated.
+Results of such rules will be different when the rule is replayed or used to backfill data, it's usually better to store the raw data and use ` + "`timestamp()`" + ` in queries or alerting rules instead.`
+)
+
+func NewTimestampCheck() TimestampCheck {
+	return TimestampCheck{}
+}
+
+type TimestampCheck struct{}
+
+func (c TimestampCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c TimestampCheck) String() string {
+	return TimestampCheckName
+}
+
+func (c TimestampCheck) Reporter() string {
+	return TimestampCheckName
+}
+
+func (c TimestampCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.Call](rule.RecordingRule.Expr.Query) {
+		call := node.Expr.(*promParser.Call)
+		if call.Func.Name != "timestamp" {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    rule.RecordingRule.Expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     fmt.Sprintf("Recording rule is using `%s` which makes the recorded value depend on the evaluation time.", call),
+			Details:  TimestampCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
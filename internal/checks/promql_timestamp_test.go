@@ -0,0 +1,91 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newTimestampCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewTimestampCheck()
+}
+
+func TestTimestampCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: timestamp(up\n",
+			checker:     newTimestampCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: time() - timestamp(up) > 300\n",
+			checker:     newTimestampCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without timestamp()",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newTimestampCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "timestamp() in recording rule",
+			content:     "- record: foo\n  expr: max(timestamp(up)) by(job)\n",
+			checker:     newTimestampCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.TimestampCheckName,
+						Text:     "Recording rule is using `timestamp(up)` which makes the recorded value depend on the evaluation time.",
+						Details:  checks.TimestampCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+		{
+			description: "multiple timestamp() calls",
+			content:     "- record: foo\n  expr: timestamp(foo) - timestamp(bar)\n",
+			checker:     newTimestampCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.TimestampCheckName,
+						Text:     "Recording rule is using `timestamp(foo)` which makes the recorded value depend on the evaluation time.",
+						Details:  checks.TimestampCheckDetails,
+						Severity: checks.Warning,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.TimestampCheckName,
+						Text:     "Recording rule is using `timestamp(bar)` which makes the recorded value depend on the evaluation time.",
+						Details:  checks.TimestampCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
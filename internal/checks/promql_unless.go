@@ -0,0 +1,73 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	UnlessCheckName    = "promql/unless"
+	UnlessCheckDetails = `[unless](https://prometheus.io/docs/prometheus/latest/querying/operators/#logical-set-binary-operators) only checks if there's a time series with matching labels on the right hand side, it never compares sample values.
+When the right hand side is using a comparison then it's the comparison that decides which time series are removed from the left hand side, ` + "`unless`" + ` itself will remove every left hand side time series with a matching right hand side time series, regardless of its value.
+Double check that this query removes the time series you expect it to remove, especially when the right hand side can return time series with different labels than the left hand side.`
+)
+
+func NewUnlessCheck() UnlessCheck {
+	return UnlessCheck{}
+}
+
+type UnlessCheck struct{}
+
+func (c UnlessCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c UnlessCheck) String() string {
+	return UnlessCheckName
+}
+
+func (c UnlessCheck) Reporter() string {
+	return UnlessCheckName
+}
+
+func (c UnlessCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.BinaryExpr](expr.Query) {
+		be := node.Expr.(*promParser.BinaryExpr)
+		if be.Op != promParser.LUNLESS {
+			continue
+		}
+		rhs, ok := unwrapParens(be.RHS).(*promParser.BinaryExpr)
+		if !ok || !rhs.Op.IsComparisonOperator() || rhs.ReturnBool {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`unless` is used with `%s` comparison on the right hand side, `unless` only checks if there are matching time series and doesn't compare values, make sure this query removes the time series you expect it to.",
+				rhs),
+			Details:  UnlessCheckDetails,
+			Severity: Information,
+		})
+	}
+
+	return problems
+}
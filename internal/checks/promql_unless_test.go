@@ -0,0 +1,87 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newUnlessCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewUnlessCheck()
+}
+
+func TestUnlessCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: foo unless bar >\n",
+			checker:     newUnlessCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "unless without comparison",
+			content:     "- alert: foo\n  expr: up == 0 unless maintenance\n",
+			checker:     newUnlessCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "unless with bool comparison",
+			content:     "- record: foo\n  expr: foo unless bar > bool 5\n",
+			checker:     newUnlessCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "comparison on the left side",
+			content:     "- record: foo\n  expr: foo > 5 unless bar\n",
+			checker:     newUnlessCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "unless with comparison",
+			content:     "- alert: foo\n  expr: up == 0 unless (maintenance > 0)\n",
+			checker:     newUnlessCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.UnlessCheckName,
+						Text:     "`unless` is used with `maintenance > 0` comparison on the right hand side, `unless` only checks if there are matching time series and doesn't compare values, make sure this query removes the time series you expect it to.",
+						Details:  checks.UnlessCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "unless with comparison without parens",
+			content:     "- record: foo\n  expr: sum(foo) by(job) unless sum(bar) by(job) > 5\n",
+			checker:     newUnlessCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.UnlessCheckName,
+						Text:     "`unless` is used with `sum by (job) (bar) > 5` comparison on the right hand side, `unless` only checks if there are matching time series and doesn't compare values, make sure this query removes the time series you expect it to.",
+						Details:  checks.UnlessCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+	}
+	runTests(t, testCases)
+}
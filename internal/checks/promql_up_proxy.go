@@ -0,0 +1,90 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	UpProxyCheckName    = "promql/up_proxy"
+	UpProxyCheckDetails = `The ` + "`up`" + ` metric is set by Prometheus after each scrape, it's ` + "`1`" + ` if the scrape was successful and ` + "`0`" + ` otherwise.
+It only tells you if Prometheus can reach a target, a service can be reachable and still fail to serve any requests.
+To measure health of a service use metrics exposed by that service, like the number of successful and failed requests.`
+)
+
+func NewUpProxyCheck() UpProxyCheck {
+	return UpProxyCheck{}
+}
+
+type UpProxyCheck struct{}
+
+func (c UpProxyCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c UpProxyCheck) String() string {
+	return UpProxyCheckName
+}
+
+func (c UpProxyCheck) Reporter() string {
+	return UpProxyCheckName
+}
+
+func (c UpProxyCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	if !isUpRatio(rule.RecordingRule.Expr.Query.Expr) {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s` recording rule is using `up` metric to calculate service health, but `up` only tells if Prometheus can scrape targets, consider using metrics exposed by the service instead.",
+			rule.RecordingRule.Record.Value),
+		Details:  UpProxyCheckDetails,
+		Severity: Information,
+	})
+
+	return problems
+}
+
+// isUpRatio returns true for avg(up) and sum(up) / count(up) queries.
+func isUpRatio(node promParser.Node) bool {
+	switch n := unwrapParens(node).(type) {
+	case *promParser.AggregateExpr:
+		return n.Op == promParser.AVG && isUpSelector(n.Expr)
+	case *promParser.BinaryExpr:
+		if n.Op != promParser.DIV {
+			return false
+		}
+		lhs, ok := unwrapParens(n.LHS).(*promParser.AggregateExpr)
+		if !ok || lhs.Op != promParser.SUM || !isUpSelector(lhs.Expr) {
+			return false
+		}
+		rhs, ok := unwrapParens(n.RHS).(*promParser.AggregateExpr)
+		return ok && rhs.Op == promParser.COUNT && isUpSelector(rhs.Expr)
+	default:
+		return false
+	}
+}
+
+func isUpSelector(node promParser.Node) bool {
+	vs, ok := unwrapParens(node).(*promParser.VectorSelector)
+	return ok && vs.Name == "up"
+}
@@ -0,0 +1,83 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newUpProxyCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewUpProxyCheck()
+}
+
+func TestUpProxyCheck(t *testing.T) {
+	upProxyProblem := func(_ string) []checks.Problem {
+		return []checks.Problem{
+			{
+				Lines: parser.LineRange{
+					First: 2,
+					Last:  2,
+				},
+				Reporter: checks.UpProxyCheckName,
+				Text:     "`service:up:ratio` recording rule is using `up` metric to calculate service health, but `up` only tells if Prometheus can scrape targets, consider using metrics exposed by the service instead.",
+				Details:  checks.UpProxyCheckDetails,
+				Severity: checks.Information,
+			},
+		}
+	}
+
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: service:up:ratio\n  expr: avg(up{job=\"foo\"}\n",
+			checker:     newUpProxyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: avg(up{job=\"foo\"}) < 0.5\n",
+			checker:     newUpProxyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores other metrics",
+			content:     "- record: service:up:ratio\n  expr: avg(foo{job=\"foo\"})\n",
+			checker:     newUpProxyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores other aggregations of up",
+			content:     "- record: service:up:count\n  expr: count(up{job=\"foo\"}) by (job)\n",
+			checker:     newUpProxyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores sum(up) divided by other metrics",
+			content:     "- record: service:up:ratio\n  expr: sum(up{job=\"foo\"}) / count(foo)\n",
+			checker:     newUpProxyCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "avg(up)",
+			content:     "- record: service:up:ratio\n  expr: avg(up{job=\"foo\"}) by (cluster)\n",
+			checker:     newUpProxyCheck,
+			prometheus:  noProm,
+			problems:    upProxyProblem,
+		},
+		{
+			description: "sum(up) / count(up)",
+			content:     "- record: service:up:ratio\n  expr: (sum(up{job=\"foo\"}) / count((up{job=\"foo\"})))\n",
+			checker:     newUpProxyCheck,
+			prometheus:  noProm,
+			problems:    upProxyProblem,
+		},
+	}
+	runTests(t, testCases)
+}
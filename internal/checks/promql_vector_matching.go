@@ -219,6 +219,14 @@ NEXT:
 }
 
 func (c VectorMatchingCheck) seriesLabels(ctx context.Context, query string, ignored ...model.LabelName) (labelSets, error) {
+	lsets, _, err := querySeriesLabels(ctx, c.prom, query, ignored...)
+	return lsets, err
+}
+
+// querySeriesLabels returns label names of all time series returned by
+// given query, with ignored labels removed, and the URI of the server
+// that was queried.
+func querySeriesLabels(ctx context.Context, prom *promapi.FailoverGroup, query string, ignored ...model.LabelName) (labelSets, string, error) {
 	var expr strings.Builder
 	expr.WriteString("count(")
 	expr.WriteString(query)
@@ -230,13 +238,13 @@ func (c VectorMatchingCheck) seriesLabels(ctx context.Context, query string, ign
 		}
 	}
 	expr.WriteString(")")
-	qr, err := c.prom.Query(ctx, expr.String())
+	qr, err := prom.Query(ctx, expr.String())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if len(qr.Series) == 0 {
-		return nil, nil
+		return nil, qr.URI, nil
 	}
 
 	var lsets labelSets
@@ -251,7 +259,7 @@ func (c VectorMatchingCheck) seriesLabels(ctx context.Context, query string, ign
 		lsets = append(lsets, ls)
 	}
 
-	return lsets, nil
+	return lsets, qr.URI, nil
 }
 
 type labelSet struct {
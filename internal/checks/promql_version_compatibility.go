@@ -0,0 +1,134 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	VersionCompatibilityCheckName    = "promql/version_compatibility"
+	VersionCompatibilityCheckDetails = `Some PromQL functions were added in later Prometheus releases and are not available on older versions.
+Rules using these functions will fail to evaluate.
+Either upgrade Prometheus or rewrite the query without using this function.
+See [Prometheus documentation](https://prometheus.io/docs/prometheus/latest/querying/functions/) for the list of all available functions.`
+)
+
+// functionMinVersion maps PromQL functions to the first Prometheus release
+// that supports them.
+var functionMinVersion = map[string]string{
+	"absent_over_time":   "2.16.0",
+	"last_over_time":     "2.26.0",
+	"sgn":                "2.26.0",
+	"clamp":              "2.26.0",
+	"acos":               "2.26.0",
+	"acosh":              "2.26.0",
+	"asin":               "2.26.0",
+	"asinh":              "2.26.0",
+	"atan":               "2.26.0",
+	"atanh":              "2.26.0",
+	"cos":                "2.26.0",
+	"cosh":               "2.26.0",
+	"sin":                "2.26.0",
+	"sinh":               "2.26.0",
+	"tan":                "2.26.0",
+	"tanh":               "2.26.0",
+	"deg":                "2.26.0",
+	"rad":                "2.26.0",
+	"pi":                 "2.26.0",
+	"present_over_time":  "2.29.0",
+	"histogram_count":    "2.40.0",
+	"histogram_sum":      "2.40.0",
+	"histogram_fraction": "2.40.0",
+}
+
+func NewVersionCompatibilityCheck(prom *promapi.FailoverGroup) VersionCompatibilityCheck {
+	return VersionCompatibilityCheck{prom: prom}
+}
+
+type VersionCompatibilityCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c VersionCompatibilityCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c VersionCompatibilityCheck) String() string {
+	return fmt.Sprintf("%s(%s)", VersionCompatibilityCheckName, c.prom.Name())
+}
+
+func (c VersionCompatibilityCheck) Reporter() string {
+	return VersionCompatibilityCheckName
+}
+
+func (c VersionCompatibilityCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	var funcs []string
+	done := map[string]struct{}{}
+	for _, node := range parser.WalkDownExpr[*promParser.Call](expr.Query) {
+		name := node.Expr.(*promParser.Call).Func.Name
+		if _, ok := functionMinVersion[name]; !ok {
+			continue
+		}
+		if _, ok := done[name]; ok {
+			continue
+		}
+		done[name] = struct{}{}
+		funcs = append(funcs, name)
+	}
+	if len(funcs) == 0 {
+		return problems
+	}
+
+	info, err := c.prom.BuildInfo(ctx)
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	ver, err := parseVersion(info.BuildInfo.Version)
+	if err != nil {
+		return problems
+	}
+
+	for _, name := range funcs {
+		minVer, _ := parseVersion(functionMinVersion[name])
+		if !ver.isOlder(minVer) {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s()` function requires Prometheus `%s` or newer but %s is running Prometheus `%s`.",
+				name, functionMinVersion[name], promText(c.prom.Name(), info.URI), info.BuildInfo.Version),
+			Details:  VersionCompatibilityCheckDetails,
+			Severity: Bug,
+		})
+	}
+
+	return problems
+}
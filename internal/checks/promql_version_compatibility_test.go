@@ -0,0 +1,153 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newVersionCompatibilityCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewVersionCompatibilityCheck(prom)
+}
+
+func versionCompatibilityText(fn, minVer, name, uri, ver string) string {
+	return fmt.Sprintf("`%s()` function requires Prometheus `%s` or newer but `%s` Prometheus server at %s is running Prometheus `%s`.",
+		fn, minVer, name, uri, ver)
+}
+
+func TestVersionCompatibilityCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: last_over_time(foo[5m]\n",
+			checker:     newVersionCompatibilityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without new functions",
+			content:     "- record: foo\n  expr: sum(rate(foo[5m]))\n",
+			checker:     newVersionCompatibilityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: last_over_time(foo[5m])\n",
+			checker:     newVersionCompatibilityCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.VersionCompatibilityCheckName,
+						Text:     checkErrorUnableToRun(checks.VersionCompatibilityCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "new Prometheus",
+			content:     "- record: foo\n  expr: present_over_time(foo[5m]) or last_over_time(bar[5m])\n",
+			checker:     newVersionCompatibilityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "2.29.0"},
+				},
+			},
+		},
+		{
+			description: "unparsable version",
+			content:     "- record: foo\n  expr: last_over_time(foo[5m])\n",
+			checker:     newVersionCompatibilityCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "main"},
+				},
+			},
+		},
+		{
+			description: "old Prometheus",
+			content:     "- record: foo\n  expr: present_over_time(foo[5m]) or last_over_time(bar[5m]) or last_over_time(baz[5m])\n",
+			checker:     newVersionCompatibilityCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.VersionCompatibilityCheckName,
+						Text:     versionCompatibilityText("present_over_time", "2.29.0", "prom", uri, "2.26.1-rc.0"),
+						Details:  checks.VersionCompatibilityCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "2.26.1-rc.0"},
+				},
+			},
+		},
+		{
+			description: "very old Prometheus",
+			content:     "- record: foo\n  expr: clamp(foo, 0, 1) + sgn(bar)\n",
+			checker:     newVersionCompatibilityCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.VersionCompatibilityCheckName,
+						Text:     versionCompatibilityText("clamp", "2.26.0", "prom", uri, "2.25.2"),
+						Details:  checks.VersionCompatibilityCheckDetails,
+						Severity: checks.Bug,
+					},
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.VersionCompatibilityCheckName,
+						Text:     versionCompatibilityText("sgn", "2.26.0", "prom", uri, "2.25.2"),
+						Details:  checks.VersionCompatibilityCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireBuildInfoPath},
+					resp:  buildInfoResponse{version: "2.25.2"},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,105 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	WithoutLabelCheckName    = "promql/without_label"
+	WithoutLabelCheckDetails = `Labels listed in ` + "`without(...)`" + ` are removed from the results of the aggregation.
+Listing a label that is never present on the aggregated time series doesn't do anything, which might mean that there's a typo in the label name or that the query is not doing what it was intended to do.`
+)
+
+func NewWithoutLabelCheck(prom *promapi.FailoverGroup) WithoutLabelCheck {
+	return WithoutLabelCheck{prom: prom}
+}
+
+type WithoutLabelCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c WithoutLabelCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c WithoutLabelCheck) String() string {
+	return fmt.Sprintf("%s(%s)", WithoutLabelCheckName, c.prom.Name())
+}
+
+func (c WithoutLabelCheck) Reporter() string {
+	return WithoutLabelCheckName
+}
+
+func (c WithoutLabelCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	for _, node := range parser.WalkDownExpr[*promParser.AggregateExpr](expr.Query) {
+		aggr := node.Expr.(*promParser.AggregateExpr)
+		if !aggr.Without || len(aggr.Grouping) == 0 {
+			continue
+		}
+
+		query := utils.RemoveConditions(aggr.Expr.String()).String()
+		var missing []string
+		var uri string
+		for _, name := range aggr.Grouping {
+			qr, err := c.prom.Query(ctx, fmt.Sprintf("count(%s) by (%s)", query, name))
+			if err != nil {
+				text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+				problems = append(problems, Problem{
+					Lines:    expr.Value.Lines,
+					Reporter: c.Reporter(),
+					Text:     text,
+					Severity: severity,
+				})
+				return problems
+			}
+			// No results means that there are no time series at all, other checks will report that.
+			if len(qr.Series) == 0 {
+				continue
+			}
+			var found bool
+			for _, s := range qr.Series {
+				if s.Labels.Get(name) != "" {
+					found = true
+					break
+				}
+			}
+			if !found {
+				missing = append(missing, name)
+				uri = qr.URI
+			}
+		}
+		if len(missing) > 0 {
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("`%s` aggregation is removing labels that are not present on any time series on %s: %s.",
+					aggr.String(), promText(c.prom.Name(), uri), quoteLabelNames(missing)),
+				Details:  WithoutLabelCheckDetails,
+				Severity: Information,
+			})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,166 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newWithoutLabelCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewWithoutLabelCheck(prom)
+}
+
+func withoutLabelText(aggr, name, uri, labels string) string {
+	return fmt.Sprintf("`%s` aggregation is removing labels that are not present on any time series on `%s` Prometheus server at %s: %s.",
+		aggr, name, uri, labels)
+}
+
+func TestWithoutLabelCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newWithoutLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores by()",
+			content:     "- record: foo\n  expr: sum(foo) by(job)\n",
+			checker:     newWithoutLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores empty without()",
+			content:     "- record: foo\n  expr: sum(foo) without()\n",
+			checker:     newWithoutLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: foo\n  expr: sum(foo) without(instance)\n",
+			checker:     newWithoutLabelCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.WithoutLabelCheckName,
+						Text:     checkErrorUnableToRun(checks.WithoutLabelCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireQueryPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no results",
+			content:     "- record: foo\n  expr: sum(foo) without(instance)\n",
+			checker:     newWithoutLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(foo) by (instance)"},
+					},
+					resp: respondWithEmptyVector(),
+				},
+			},
+		},
+		{
+			description: "label present",
+			content:     "- record: foo\n  expr: sum(foo) without(instance)\n",
+			checker:     newWithoutLabelCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(foo) by (instance)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"instance": "a"}),
+							generateSample(map[string]string{"instance": "b"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "some labels missing",
+			content:     "- alert: foo\n  expr: sum(rate(foo[5m])) without(instance, pod, nonexistent) > 0\n",
+			checker:     newWithoutLabelCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.WithoutLabelCheckName,
+						Text:     withoutLabelText("sum without (instance, pod, nonexistent) (rate(foo[5m]))", "prom", uri, "`pod`, `nonexistent`"),
+						Details:  checks.WithoutLabelCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(rate(foo[5m])) by (instance)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{"instance": "a"}),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(rate(foo[5m])) by (pod)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+				{
+					conds: []requestCondition{
+						requireQueryPath,
+						formCond{key: "query", value: "count(rate(foo[5m])) by (nonexistent)"},
+					},
+					resp: vectorResponse{
+						samples: []*model.Sample{
+							generateSample(map[string]string{}),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
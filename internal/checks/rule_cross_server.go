@@ -0,0 +1,88 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	CrossServerCheckName    = "rule/cross_server"
+	CrossServerCheckDetails = `This query uses a metric produced by a recording rule that is defined in a rule file, but that file is not deployed to the same Prometheus server as this rule.
+The recording rule will not be evaluated on this server, so the metric will be missing here. Either deploy the recording rule to this server too or move this rule to a server that has it.`
+)
+
+func NewCrossServerCheck(prom *promapi.FailoverGroup) CrossServerCheck {
+	return CrossServerCheck{prom: prom}
+}
+
+type CrossServerCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c CrossServerCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c CrossServerCheck) String() string {
+	return fmt.Sprintf("%s(%s)", CrossServerCheckName, c.prom.Name())
+}
+
+func (c CrossServerCheck) Reporter() string {
+	return CrossServerCheckName
+}
+
+func (c CrossServerCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return nil
+	}
+
+	if !c.prom.IsEnabledForPath(path.Name) {
+		return nil
+	}
+
+	for _, name := range metricNames(expr.Query) {
+		var deployed bool
+		var elsewhere []string
+		for _, entry := range entries {
+			if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+				continue
+			}
+			if entry.Rule.RecordingRule == nil || entry.Rule.RecordingRule.Record.Value != name {
+				continue
+			}
+			if c.prom.IsEnabledForPath(entry.Path.Name) {
+				deployed = true
+				break
+			}
+			elsewhere = append(elsewhere, fmt.Sprintf("%s:%d", entry.Path.SymlinkTarget, entry.Rule.RecordingRule.Record.Lines.First))
+		}
+		if deployed || len(elsewhere) == 0 {
+			continue
+		}
+
+		problems = append(problems, Problem{
+			Lines:    expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("`%s` is produced by a recording rule defined at %s but that file is not deployed to %s, this metric will be missing there.",
+				name, strings.Join(elsewhere, ", "), promText(c.prom.Name(), c.prom.PublicURI())),
+			Details:  CrossServerCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
@@ -0,0 +1,168 @@
+package checks_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newCrossServerCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewCrossServerCheck(prom)
+}
+
+func fakeYamlProm(uri string) *promapi.FailoverGroup {
+	return promapi.NewFailoverGroup(
+		"prom",
+		uri,
+		[]*promapi.Prometheus{
+			promapi.NewPrometheus("prom", uri, "", map[string]string{}, time.Second, 4, 100, nil),
+		},
+		true,
+		"up",
+		[]*regexp.Regexp{regexp.MustCompile("^fake.yml$")},
+		nil,
+		nil,
+	)
+}
+
+func crossServerEntries(name, content string) []discovery.Entry {
+	entries := mustParseContent(content)
+	for i := range entries {
+		entries[i].Path.Name = name
+		entries[i].Path.SymlinkTarget = name
+	}
+	return entries
+}
+
+func TestCrossServerCheck(t *testing.T) {
+	otherEntries := crossServerEntries("other.yml", "- record: foo:sum\n  expr: sum(foo)\n")
+	localEntries := crossServerEntries("fake.yml", "- record: foo:sum\n  expr: sum(foo)\n")
+
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- alert: foo\n  expr: sum(foo:sum) without(\n",
+			checker:     newCrossServerCheck,
+			prometheus:  fakeYamlProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores metrics not produced by recording rules",
+			content:     "- alert: foo\n  expr: bar > 0\n",
+			checker:     newCrossServerCheck,
+			prometheus:  fakeYamlProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores recording rules deployed to the same server",
+			content:     "- alert: foo\n  expr: foo:sum > 0\n",
+			checker:     newCrossServerCheck,
+			prometheus:  fakeYamlProm,
+			problems:    noProblems,
+			entries:     localEntries,
+		},
+		{
+			description: "ignores recording rules when all paths are enabled",
+			content:     "- alert: foo\n  expr: foo:sum > 0\n",
+			checker:     newCrossServerCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores rules from paths not deployed to the server",
+			content:     "- alert: foo\n  expr: foo:sum > 0\n",
+			checker:     newCrossServerCheck,
+			prometheus: func(uri string) *promapi.FailoverGroup {
+				return promapi.NewFailoverGroup(
+					"prom",
+					uri,
+					[]*promapi.Prometheus{
+						promapi.NewPrometheus("prom", uri, "", map[string]string{}, time.Second, 4, 100, nil),
+					},
+					true,
+					"up",
+					nil,
+					[]*regexp.Regexp{regexp.MustCompile("^fake.yml$")},
+					nil,
+				)
+			},
+			problems: noProblems,
+			entries:  otherEntries,
+		},
+		{
+			description: "ignores removed recording rules",
+			content:     "- alert: foo\n  expr: foo:sum > 0\n",
+			checker:     newCrossServerCheck,
+			prometheus:  fakeYamlProm,
+			problems:    noProblems,
+			entries: func() []discovery.Entry {
+				entries := crossServerEntries("other.yml", "- record: foo:sum\n  expr: sum(foo)\n")
+				for i := range entries {
+					entries[i].State = discovery.Removed
+				}
+				return entries
+			}(),
+		},
+		{
+			description: "recording rule deployed to a different server",
+			content:     "- alert: foo\n  expr: foo:sum > 0\n",
+			checker:     newCrossServerCheck,
+			prometheus:  fakeYamlProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CrossServerCheckName,
+						Text:     fmt.Sprintf("`foo:sum` is produced by a recording rule defined at other.yml:1 but that file is not deployed to `prom` Prometheus server at %s, this metric will be missing there.", uri),
+						Details:  checks.CrossServerCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: otherEntries,
+		},
+		{
+			description: "recording rule deployed to a different server from a recording rule",
+			content:     "- record: foo:sum:ratio\n  expr: foo:sum / on() group_left() bar\n",
+			checker:     newCrossServerCheck,
+			prometheus:  fakeYamlProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.CrossServerCheckName,
+						Text:     fmt.Sprintf("`foo:sum` is produced by a recording rule defined at other.yml:1 but that file is not deployed to `prom` Prometheus server at %s, this metric will be missing there.", uri),
+						Details:  checks.CrossServerCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: otherEntries,
+		},
+		{
+			description: "recording rule deployed to both servers",
+			content:     "- alert: foo\n  expr: foo:sum > 0\n",
+			checker:     newCrossServerCheck,
+			prometheus:  fakeYamlProm,
+			problems:    noProblems,
+			entries:     append(append([]discovery.Entry{}, otherEntries...), localEntries...),
+		},
+	}
+
+	runTests(t, testCases)
+}
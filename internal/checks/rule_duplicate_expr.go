@@ -0,0 +1,84 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	ExpressionDuplicateCheckName    = "rule/duplicate_expr"
+	ExpressionDuplicateCheckDetails = `Multiple recording rules are using the same query, so Prometheus will evaluate it multiple times and store identical results under different names.
+Consider removing all but one of these rules and updating all queries to use the remaining metric.`
+)
+
+func NewExpressionDuplicateCheck() ExpressionDuplicateCheck {
+	return ExpressionDuplicateCheck{}
+}
+
+type ExpressionDuplicateCheck struct{}
+
+func (c ExpressionDuplicateCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c ExpressionDuplicateCheck) String() string {
+	return ExpressionDuplicateCheckName
+}
+
+func (c ExpressionDuplicateCheck) Reporter() string {
+	return ExpressionDuplicateCheckName
+}
+
+func (c ExpressionDuplicateCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	// Comparing parsed queries means that any formatting differences are ignored.
+	query := rule.RecordingRule.Expr.Query.Expr.String()
+	ruleLabels := buildRuleLabels(rule.RecordingRule)
+
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if entry.Rule.RecordingRule == nil || entry.Rule.RecordingRule.Expr.SyntaxError != nil {
+			continue
+		}
+		// Rules from different files might be deployed to different Prometheus servers.
+		if entry.Path.Name != path.Name || entry.Rule.Lines.First == rule.Lines.First {
+			continue
+		}
+		if entry.Rule.RecordingRule.Record.Value == rule.RecordingRule.Record.Value {
+			continue
+		}
+		if entry.Rule.RecordingRule.Expr.Query.Expr.String() != query {
+			continue
+		}
+		// Rules with different labels produce different time series.
+		if buildRuleLabels(entry.Rule.RecordingRule).Hash() != ruleLabels.Hash() {
+			continue
+		}
+		problems = append(problems, Problem{
+			Lines:    rule.RecordingRule.Expr.Value.Lines,
+			Reporter: c.Reporter(),
+			Text: fmt.Sprintf("This query is identical to the one used by `%s` recording rule at %s:%d, consider removing one of them and using the other metric instead.",
+				entry.Rule.RecordingRule.Record.Value, entry.Path.SymlinkTarget, entry.Rule.RecordingRule.Record.Lines.First),
+			Details:  ExpressionDuplicateCheckDetails,
+			Severity: Warning,
+		})
+	}
+
+	return problems
+}
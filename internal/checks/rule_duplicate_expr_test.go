@@ -0,0 +1,120 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newExpressionDuplicateCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewExpressionDuplicateCheck()
+}
+
+func TestExpressionDuplicateCheck(t *testing.T) {
+	otherEntries := mustParseContent("\n- record: bar\n  expr: sum(foo)   by (job)\n")
+	otherFileEntries := mustParseContent("\n- record: bar\n  expr: sum(foo)   by (job)\n")
+	for i := range otherFileEntries {
+		otherFileEntries[i].Path.Name = "other.yml"
+		otherFileEntries[i].Path.SymlinkTarget = "other.yml"
+	}
+
+	testCases := []checkTest{
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: sum(foo) by (job)\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores self",
+			content:     "- record: foo\n  expr: sum(foo) by (job)\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- record: foo\n  expr: sum(foo) by (job)\n"),
+		},
+		{
+			description: "ignores rules with the same name",
+			content:     "- record: bar\n  expr: sum(foo) by (job)\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores alerting and broken entries",
+			content:     "- record: foo\n  expr: sum(foo) by (job)\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(`
+- alert: bar
+  expr: sum(foo) by (job)
+- record: bar
+  expr: sum(foo) by (job
+- record: bar
+  expr: sum(foo) by (job)
+  keep_firing_for: 5m
+`),
+		},
+		{
+			description: "ignores rules with different labels",
+			content:     "- record: foo\n  expr: sum(foo) by (job)\n  labels:\n    env: prod\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores different queries",
+			content:     "- record: foo\n  expr: sum(foo) by (instance)\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherEntries,
+		},
+		{
+			description: "ignores rules from other files",
+			content:     "- record: foo\n  expr: sum by (job) (foo)\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     otherFileEntries,
+		},
+		{
+			description: "identical query",
+			content:     "- record: foo\n  expr: sum by (job) (foo)\n",
+			checker:     newExpressionDuplicateCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.ExpressionDuplicateCheckName,
+						Text:     "This query is identical to the one used by `bar` recording rule at fake.yml:2, consider removing one of them and using the other metric instead.",
+						Details:  checks.ExpressionDuplicateCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: otherEntries,
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,88 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/parser/utils"
+)
+
+const (
+	EvalOrderCheckName    = "rule/eval_order"
+	EvalOrderCheckDetails = `Prometheus evaluates all rules in a group sequentially, in the order they are defined.
+If a rule uses a metric produced by a recording rule that is defined after it in the same group, then it will always see results from the previous evaluation of that recording rule.
+Move the recording rule above all the rules that are using it.`
+)
+
+func NewEvalOrderCheck() EvalOrderCheck {
+	return EvalOrderCheck{}
+}
+
+type EvalOrderCheck struct{}
+
+func (c EvalOrderCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c EvalOrderCheck) String() string {
+	return EvalOrderCheckName
+}
+
+func (c EvalOrderCheck) Reporter() string {
+	return EvalOrderCheckName
+}
+
+func (c EvalOrderCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.Group == "" {
+		return problems
+	}
+
+	expr := rule.Expr()
+	if expr.SyntaxError != nil {
+		return problems
+	}
+
+	done := map[string]struct{}{}
+	for _, vs := range utils.HasVectorSelector(expr.Query) {
+		if _, ok := done[vs.Name]; ok {
+			continue
+		}
+		done[vs.Name] = struct{}{}
+
+		for _, entry := range entries {
+			if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+				continue
+			}
+			if entry.Path.Name != path.Name || entry.Rule.Group != rule.Group {
+				continue
+			}
+			if entry.Rule.RecordingRule == nil || entry.Rule.RecordingRule.Record.Value != vs.Name {
+				continue
+			}
+			if entry.Rule.Lines.First <= rule.Lines.First {
+				continue
+			}
+			problems = append(problems, Problem{
+				Lines:    expr.Value.Lines,
+				Reporter: c.Reporter(),
+				Text: fmt.Sprintf("This rule uses `%s` metric produced by a recording rule defined later in the `%s` group on line %d, so it will always use results from the previous evaluation.",
+					vs.Name, rule.Group, entry.Rule.RecordingRule.Record.Lines.First),
+				Details:  EvalOrderCheckDetails,
+				Severity: Warning,
+			})
+			break
+		}
+	}
+
+	return problems
+}
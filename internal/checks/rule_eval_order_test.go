@@ -0,0 +1,64 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newEvalOrderCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewEvalOrderCheck()
+}
+
+func TestEvalOrderCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores rules outside of groups",
+			content:     "- alert: foo\n  expr: bar == 0\n",
+			checker:     newEvalOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- alert: foo\n  expr: bar == 0\n- record: bar\n  expr: sum(up)\n"),
+		},
+		{
+			description: "recording rule defined earlier",
+			content:     "groups:\n- name: foo\n  rules:\n  - record: bar\n    expr: sum(up)\n  - alert: foo\n    expr: bar == 0\n",
+			checker:     newEvalOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("groups:\n- name: foo\n  rules:\n  - record: bar\n    expr: sum(up)\n  - alert: foo\n    expr: bar == 0\n"),
+		},
+		{
+			description: "recording rule defined later in a different group",
+			content:     "groups:\n- name: foo\n  rules:\n  - alert: foo\n    expr: bar == 0\n",
+			checker:     newEvalOrderCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("groups:\n- name: foo\n  rules:\n  - alert: foo\n    expr: bar == 0\n- name: bar\n  rules:\n  - record: bar\n    expr: sum(up)\n"),
+		},
+		{
+			description: "recording rule defined later in the same group",
+			content:     "groups:\n- name: foo\n  rules:\n  - alert: foo\n    expr: bar == 0 or bar > 10\n",
+			checker:     newEvalOrderCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 5,
+							Last:  5,
+						},
+						Reporter: checks.EvalOrderCheckName,
+						Text:     "This rule uses `bar` metric produced by a recording rule defined later in the `foo` group on line 6, so it will always use results from the previous evaluation.",
+						Details:  checks.EvalOrderCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: mustParseContent("groups:\n- name: foo\n  rules:\n  - alert: foo\n    expr: bar == 0 or bar > 10\n  - record: bar\n    expr: sum(up)\n"),
+		},
+	}
+	runTests(t, testCases)
+}
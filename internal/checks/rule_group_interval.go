@@ -0,0 +1,97 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/output"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	GroupIntervalCheckName    = "rule/group_interval"
+	GroupIntervalCheckDetails = `The ` + "`interval`" + ` option of a rule group overrides the [global evaluation_interval](https://prometheus.io/docs/prometheus/latest/configuration/configuration/#configuration-file) for all rules in that group.
+Using a shorter interval means that these rules will be evaluated more often than all other rules, which increases the load on Prometheus, while the data they query is usually not updated any faster than the scrape interval.
+Make sure that this is intentional.`
+)
+
+func NewGroupIntervalCheck(prom *promapi.FailoverGroup) GroupIntervalCheck {
+	return GroupIntervalCheck{prom: prom}
+}
+
+type GroupIntervalCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c GroupIntervalCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c GroupIntervalCheck) String() string {
+	return fmt.Sprintf("%s(%s)", GroupIntervalCheckName, c.prom.Name())
+}
+
+func (c GroupIntervalCheck) Reporter() string {
+	return GroupIntervalCheckName
+}
+
+func (c GroupIntervalCheck) Check(ctx context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.GroupInterval == nil {
+		return problems
+	}
+
+	interval, err := model.ParseDuration(rule.GroupInterval.Value)
+	if err != nil {
+		return problems
+	}
+
+	// Only report this once per group, on the first rule.
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil {
+			continue
+		}
+		if entry.Path.Name == path.Name && entry.Rule.Group == rule.Group && entry.Rule.Lines.First < rule.Lines.First {
+			return problems
+		}
+	}
+
+	cfg, err := c.prom.Config(ctx, 0)
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Bug)
+		problems = append(problems, Problem{
+			Lines:    rule.GroupInterval.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	if time.Duration(interval) >= cfg.Config.Global.EvaluationInterval {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.GroupInterval.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s` rule group is using `interval: %s` which is shorter than the global `evaluation_interval` of %s configured on %s, rules in this group will be evaluated more often than all other rules.",
+			rule.Group, rule.GroupInterval.Value, output.HumanizeDuration(cfg.Config.Global.EvaluationInterval), promText(c.prom.Name(), cfg.URI)),
+		Details:  GroupIntervalCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
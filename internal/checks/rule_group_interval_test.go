@@ -0,0 +1,147 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newGroupIntervalCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewGroupIntervalCheck(prom)
+}
+
+func groupIntervalText(group, interval, global, name, uri string) string {
+	return fmt.Sprintf("`%s` rule group is using `interval: %s` which is shorter than the global `evaluation_interval` of %s configured on `%s` Prometheus server at %s, rules in this group will be evaluated more often than all other rules.",
+		group, interval, global, name, uri)
+}
+
+func TestGroupIntervalCheck(t *testing.T) {
+	content := "groups:\n- name: fast\n  interval: 5s\n  rules:\n  - record: foo\n    expr: sum(bar)\n"
+
+	testCases := []checkTest{
+		{
+			description: "ignores rules without groups",
+			content:     "- record: foo\n  expr: sum(bar)\n",
+			checker:     newGroupIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores groups without interval",
+			content:     "groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(bar)\n",
+			checker:     newGroupIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores invalid interval",
+			content:     "groups:\n- name: foo\n  interval: xxx\n  rules:\n  - record: foo\n    expr: sum(bar)\n",
+			checker:     newGroupIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     content,
+			checker:     newGroupIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.GroupIntervalCheckName,
+						Text:     checkErrorUnableToRun(checks.GroupIntervalCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "interval longer than evaluation_interval",
+			content:     "groups:\n- name: slow\n  interval: 5m\n  rules:\n  - record: foo\n    expr: sum(bar)\n",
+			checker:     newGroupIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  evaluation_interval: 1m\n"},
+				},
+			},
+		},
+		{
+			description: "interval shorter than evaluation_interval",
+			content:     content,
+			checker:     newGroupIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.GroupIntervalCheckName,
+						Text:     groupIntervalText("fast", "5s", "30s", "prom", uri),
+						Details:  checks.GroupIntervalCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  evaluation_interval: 30s\n"},
+				},
+			},
+		},
+		{
+			description: "interval shorter than default evaluation_interval",
+			content:     content,
+			checker:     newGroupIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  3,
+						},
+						Reporter: checks.GroupIntervalCheckName,
+						Text:     groupIntervalText("fast", "5s", "1m", "prom", uri),
+						Details:  checks.GroupIntervalCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireConfigPath},
+					resp:  configResponse{yaml: "global:\n  scrape_interval: 15s\n"},
+				},
+			},
+		},
+		{
+			description: "only reports first rule in a group",
+			content:     "groups:\n- name: fast\n  interval: 5s\n  rules:\n\n\n  - record: bar\n    expr: sum(foo)\n",
+			checker:     newGroupIntervalCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			entries:     mustParseContent("groups:\n- name: fast\n  interval: 5s\n  rules:\n  - record: foo\n    expr: sum(bar)\n  - record: bar\n    expr: sum(foo)\n"),
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,76 @@
+package checks
+
+import (
+	"context"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	GroupsWrapperCheckName    = "rule/groups_wrapper"
+	GroupsWrapperCheckDetails = `Prometheus rule files must have a top level ` + "`groups:`" + ` key with a list of rule groups, and every rule must be defined inside one of those groups.
+A file that is just a list of rules is valid YAML, but Prometheus will refuse to load it.
+See [Prometheus docs](https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/#syntax) for details.`
+)
+
+type GroupsWrapperSettings struct{}
+
+func (s *GroupsWrapperSettings) Validate() error {
+	return nil
+}
+
+func NewGroupsWrapperCheck() GroupsWrapperCheck {
+	return GroupsWrapperCheck{}
+}
+
+type GroupsWrapperCheck struct{}
+
+func (c GroupsWrapperCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c GroupsWrapperCheck) String() string {
+	return GroupsWrapperCheckName
+}
+
+func (c GroupsWrapperCheck) Reporter() string {
+	return GroupsWrapperCheckName
+}
+
+func (c GroupsWrapperCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.Group != "" || (rule.AlertingRule == nil && rule.RecordingRule == nil) {
+		return problems
+	}
+
+	// Only report the first rule in each file, it's the file that needs fixing.
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if entry.Path.Name != path.Name || entry.Rule.Group != "" {
+			continue
+		}
+		if entry.Rule.Lines.First < rule.Lines.First {
+			return problems
+		}
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.Lines,
+		Reporter: c.Reporter(),
+		Text:     "This file doesn't have a top level `groups:` key, Prometheus requires all rules to be defined inside a rule group and will fail to load this file.",
+		Details:  GroupsWrapperCheckDetails,
+		Severity: Bug,
+	})
+
+	return problems
+}
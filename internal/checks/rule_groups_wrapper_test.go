@@ -0,0 +1,56 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newGroupsWrapperCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewGroupsWrapperCheck()
+}
+
+func TestGroupsWrapperCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "rules inside a group",
+			content:     "groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(up)\n",
+			checker:     newGroupsWrapperCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(up)\n"),
+		},
+		{
+			description: "first rule without a group",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newGroupsWrapperCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  2,
+						},
+						Reporter: checks.GroupsWrapperCheckName,
+						Text:     "This file doesn't have a top level `groups:` key, Prometheus requires all rules to be defined inside a rule group and will fail to load this file.",
+						Details:  checks.GroupsWrapperCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+			entries: mustParseContent("- record: foo\n  expr: sum(up)\n- alert: bar\n  expr: up == 0\n"),
+		},
+		{
+			description: "second rule without a group",
+			content:     "\n\n- alert: bar\n  expr: up == 0\n",
+			checker:     newGroupsWrapperCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- record: foo\n  expr: sum(up)\n- alert: bar\n  expr: up == 0\n"),
+		},
+	}
+	runTests(t, testCases)
+}
@@ -0,0 +1,88 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	HistogramCompletenessCheckName    = "rule/histogram_completeness"
+	HistogramCompletenessCheckDetails = `A [histogram](https://prometheus.io/docs/concepts/metric_types/#histogram) is exposed as a set of time series: ` + "`_bucket`" + `, ` + "`_sum`" + ` and ` + "`_count`" + `.
+Recording rules that only produce the ` + "`_bucket`" + ` time series will create an incomplete histogram, it can be used with ` + "`histogram_quantile()`" + ` but you won't be able to calculate the average value or the number of observations.
+Add recording rules for the missing ` + "`_sum`" + ` and ` + "`_count`" + ` time series to the same group.`
+)
+
+func NewHistogramCompletenessCheck() HistogramCompletenessCheck {
+	return HistogramCompletenessCheck{}
+}
+
+type HistogramCompletenessCheck struct{}
+
+func (c HistogramCompletenessCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c HistogramCompletenessCheck) String() string {
+	return HistogramCompletenessCheckName
+}
+
+func (c HistogramCompletenessCheck) Reporter() string {
+	return HistogramCompletenessCheckName
+}
+
+func (c HistogramCompletenessCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil {
+		return problems
+	}
+
+	name, ok := strings.CutSuffix(rule.RecordingRule.Record.Value, "_bucket")
+	if !ok || name == "" {
+		return problems
+	}
+
+	missing := []string{name + "_sum", name + "_count"}
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if entry.Rule.RecordingRule == nil {
+			continue
+		}
+		if entry.Path.Name != path.Name || entry.Rule.Group != rule.Group {
+			continue
+		}
+		for i, m := range missing {
+			if entry.Rule.RecordingRule.Record.Value == m {
+				missing = append(missing[:i], missing[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if len(missing) == 0 {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Record.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s` looks like a histogram bucket but there's no recording rule for `%s` in the same group, this histogram will be incomplete.",
+			rule.RecordingRule.Record.Value, strings.Join(missing, "` and `")),
+		Details:  HistogramCompletenessCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
@@ -0,0 +1,119 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newHistogramCompletenessCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewHistogramCompletenessCheck()
+}
+
+func TestHistogramCompletenessCheck(t *testing.T) {
+	bucket := "groups:\n- name: foo\n  rules:\n  - record: job:latency_seconds_bucket\n    expr: sum(rate(latency_seconds_bucket[5m])) by (job, le)\n"
+
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo_bucket\n  expr: up == 0\n",
+			checker:     newHistogramCompletenessCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules without _bucket suffix",
+			content:     "- record: job:latency_seconds_sum\n  expr: sum(rate(latency_seconds_sum[5m])) by (job)\n",
+			checker:     newHistogramCompletenessCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "complete histogram",
+			content:     bucket,
+			checker:     newHistogramCompletenessCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(bucket + `  - record: job:latency_seconds_sum
+    expr: sum(rate(latency_seconds_sum[5m])) by (job)
+  - record: job:latency_seconds_count
+    expr: sum(rate(latency_seconds_count[5m])) by (job)
+`),
+		},
+		{
+			description: "missing _sum and _count",
+			content:     bucket,
+			checker:     newHistogramCompletenessCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.HistogramCompletenessCheckName,
+						Text:     "`job:latency_seconds_bucket` looks like a histogram bucket but there's no recording rule for `job:latency_seconds_sum` and `job:latency_seconds_count` in the same group, this histogram will be incomplete.",
+						Details:  checks.HistogramCompletenessCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: mustParseContent(bucket),
+		},
+		{
+			description: "missing _count",
+			content:     bucket,
+			checker:     newHistogramCompletenessCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.HistogramCompletenessCheckName,
+						Text:     "`job:latency_seconds_bucket` looks like a histogram bucket but there's no recording rule for `job:latency_seconds_count` in the same group, this histogram will be incomplete.",
+						Details:  checks.HistogramCompletenessCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: mustParseContent(bucket + `  - record: job:latency_seconds_sum
+    expr: sum(rate(latency_seconds_sum[5m])) by (job)
+`),
+		},
+		{
+			description: "_sum and _count in a different group",
+			content:     bucket,
+			checker:     newHistogramCompletenessCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.HistogramCompletenessCheckName,
+						Text:     "`job:latency_seconds_bucket` looks like a histogram bucket but there's no recording rule for `job:latency_seconds_sum` and `job:latency_seconds_count` in the same group, this histogram will be incomplete.",
+						Details:  checks.HistogramCompletenessCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: mustParseContent(bucket + `- name: bar
+  rules:
+  - record: job:latency_seconds_sum
+    expr: sum(rate(latency_seconds_sum[5m])) by (job)
+  - record: job:latency_seconds_count
+    expr: sum(rate(latency_seconds_count[5m])) by (job)
+`),
+		},
+	}
+
+	runTests(t, testCases)
+}
@@ -0,0 +1,66 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	promParser "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	IdentityRecordCheckName    = "rule/identity"
+	IdentityRecordCheckDetails = `This recording rule is using the same metric it produces as its only input.
+Every evaluation will read the results of the previous one and write them back under the same name, so the rule doesn't do anything useful.
+Since the rule depends on its own output it will also keep producing time series even after the original source of this metric is gone.`
+)
+
+func NewIdentityRecordCheck() IdentityRecordCheck {
+	return IdentityRecordCheck{}
+}
+
+type IdentityRecordCheck struct{}
+
+func (c IdentityRecordCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c IdentityRecordCheck) String() string {
+	return IdentityRecordCheckName
+}
+
+func (c IdentityRecordCheck) Reporter() string {
+	return IdentityRecordCheckName
+}
+
+func (c IdentityRecordCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	vs, ok := unwrapParens(rule.RecordingRule.Expr.Query.Expr).(*promParser.VectorSelector)
+	if !ok || selectorMetricName(vs) != rule.RecordingRule.Record.Value {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Expr.Value.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("This recording rule is recording `%s` as itself, `%s` is both the input and the output of this rule.",
+			vs, rule.RecordingRule.Record.Value),
+		Details:  IdentityRecordCheckDetails,
+		Severity: Bug,
+	})
+
+	return problems
+}
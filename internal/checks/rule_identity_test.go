@@ -0,0 +1,88 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newIdentityRecordCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewIdentityRecordCheck()
+}
+
+func TestIdentityRecordCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: foo\n",
+			checker:     newIdentityRecordCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores syntax errors",
+			content:     "- record: foo\n  expr: foo{\n",
+			checker:     newIdentityRecordCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores different metric",
+			content:     "- record: foo\n  expr: bar\n",
+			checker:     newIdentityRecordCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores expressions using the same metric",
+			content:     "- record: foo\n  expr: sum(foo) by(job)\n",
+			checker:     newIdentityRecordCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "records metric as itself",
+			content:     "- record: foo\n  expr: foo\n",
+			checker:     newIdentityRecordCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.IdentityRecordCheckName,
+						Text:     "This recording rule is recording `foo` as itself, `foo` is both the input and the output of this rule.",
+						Details:  checks.IdentityRecordCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+		{
+			description: "records metric as itself with matchers",
+			content:     "- record: foo\n  expr: (({__name__=\"foo\", job=\"bar\"}))\n",
+			checker:     newIdentityRecordCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: checks.IdentityRecordCheckName,
+						Text:     "This recording rule is recording `{__name__=\"foo\",job=\"bar\"}` as itself, `foo` is both the input and the output of this rule.",
+						Details:  checks.IdentityRecordCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
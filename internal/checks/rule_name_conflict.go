@@ -0,0 +1,117 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+const (
+	NamingConflictCheckName    = "rule/name_conflict"
+	NamingConflictCheckDetails = `Metric names that only differ by ` + "`_total`, `_sum`, `_count` or `_bucket`" + ` suffix are usually assumed to be a part of the same metric family.
+Using such name for a recording rule makes it easy to query the recording rule results when you meant to query the original metric, or the other way round.
+Consider using the [recommended naming convention](https://prometheus.io/docs/practices/rules/#naming) for recording rules - ` + "`level:metric:operations`."
+)
+
+var metricFamilySuffixes = []string{"_total", "_sum", "_count", "_bucket"}
+
+type NamingConflictSettings struct{}
+
+func (s *NamingConflictSettings) Validate() error {
+	return nil
+}
+
+func NewNamingConflictCheck(prom *promapi.FailoverGroup) NamingConflictCheck {
+	return NamingConflictCheck{prom: prom}
+}
+
+type NamingConflictCheck struct {
+	prom *promapi.FailoverGroup
+}
+
+func (c NamingConflictCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: true,
+	}
+}
+
+func (c NamingConflictCheck) String() string {
+	return fmt.Sprintf("%s(%s)", NamingConflictCheckName, c.prom.Name())
+}
+
+func (c NamingConflictCheck) Reporter() string {
+	return NamingConflictCheckName
+}
+
+func (c NamingConflictCheck) Check(ctx context.Context, _ discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Expr.SyntaxError != nil {
+		return problems
+	}
+
+	name := rule.RecordingRule.Record.Value
+	base := metricFamilyName(name)
+
+	values, err := c.prom.LabelValues(ctx, "__name__")
+	if err != nil {
+		text, severity := textAndSeverityFromError(err, c.Reporter(), c.prom.Name(), Warning)
+		problems = append(problems, Problem{
+			Lines:    rule.RecordingRule.Record.Lines,
+			Reporter: c.Reporter(),
+			Text:     text,
+			Severity: severity,
+		})
+		return problems
+	}
+
+	var recorded []string
+	for _, entry := range entries {
+		if entry.Rule.RecordingRule != nil && entry.Rule.Error.Err == nil {
+			recorded = append(recorded, entry.Rule.RecordingRule.Record.Value)
+		}
+	}
+
+	var conflicts []string
+	for _, metric := range values.Values {
+		if metric == name || metricFamilyName(metric) != base {
+			continue
+		}
+		if slices.Contains(recorded, metric) {
+			continue
+		}
+		conflicts = append(conflicts, metric)
+	}
+	if len(conflicts) == 0 {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Record.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s` recording rule name conflicts with `%s` already present on %s, these names only differ by a suffix which makes it easy to query the wrong metric.",
+			name, strings.Join(conflicts, "`, `"), promText(c.prom.Name(), values.URI)),
+		Details:  NamingConflictCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
+
+func metricFamilyName(name string) string {
+	for _, suffix := range metricFamilySuffixes {
+		if base, ok := strings.CutSuffix(name, suffix); ok {
+			return base
+		}
+	}
+	return name
+}
@@ -0,0 +1,146 @@
+package checks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newNamingConflictCheck(prom *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewNamingConflictCheck(prom)
+}
+
+func namingConflictText(name, uri, rule, metrics string) string {
+	return fmt.Sprintf("`%s` recording rule name conflicts with `%s` already present on `%s` Prometheus server at %s, these names only differ by a suffix which makes it easy to query the wrong metric.", rule, metrics, name, uri)
+}
+
+func TestNamingConflictCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newNamingConflictCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "ignores rules with syntax errors",
+			content:     "- record: foo\n  expr: sum(foo) without(\n",
+			checker:     newNamingConflictCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+		},
+		{
+			description: "500 error from Prometheus API",
+			content:     "- record: http_requests\n  expr: sum(rate(http_requests_total[5m]))\n",
+			checker:     newNamingConflictCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.NamingConflictCheckName,
+						Text:     checkErrorUnableToRun(checks.NamingConflictCheckName, "prom", uri, "server_error: internal error"),
+						Severity: checks.Bug,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireNamesPath},
+					resp:  respondWithInternalError(),
+				},
+			},
+		},
+		{
+			description: "no conflicts",
+			content:     "- record: job:http_requests:rate5m\n  expr: sum(rate(http_requests_total[5m])) by(job)\n",
+			checker:     newNamingConflictCheck,
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireNamesPath},
+					resp:  labelValuesResponse{values: []string{"http_requests_total", "job:http_requests:rate5m", "up"}},
+				},
+			},
+		},
+		{
+			description: "conflict with _total",
+			content:     "- record: http_requests\n  expr: sum(rate(http_requests_total[5m]))\n",
+			checker:     newNamingConflictCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.NamingConflictCheckName,
+						Text:     namingConflictText("prom", uri, "http_requests", "http_requests_total"),
+						Details:  checks.NamingConflictCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireNamesPath},
+					resp:  labelValuesResponse{values: []string{"http_requests", "http_requests_total", "up"}},
+				},
+			},
+		},
+		{
+			description: "conflict with histogram",
+			content:     "- record: request_duration_seconds_total\n  expr: sum(rate(request_duration_seconds_sum[5m]))\n",
+			checker:     newNamingConflictCheck,
+			prometheus:  newSimpleProm,
+			problems: func(uri string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.NamingConflictCheckName,
+						Text:     namingConflictText("prom", uri, "request_duration_seconds_total", "request_duration_seconds_bucket`, `request_duration_seconds_count`, `request_duration_seconds_sum"),
+						Details:  checks.NamingConflictCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireNamesPath},
+					resp: labelValuesResponse{values: []string{
+						"request_duration_seconds_bucket",
+						"request_duration_seconds_count",
+						"request_duration_seconds_sum",
+					}},
+				},
+			},
+		},
+		{
+			description: "ignores metrics from other recording rules",
+			content:     "- record: foo_sum\n  expr: sum(bar)\n",
+			checker:     newNamingConflictCheck,
+			entries:     mustParseContent("- record: foo_count\n  expr: count(bar)\n"),
+			prometheus:  newSimpleProm,
+			problems:    noProblems,
+			mocks: []*prometheusMock{
+				{
+					conds: []requestCondition{requireNamesPath},
+					resp:  labelValuesResponse{values: []string{"foo_count", "foo_sum"}},
+				},
+			},
+		},
+	}
+	runTests(t, testCases)
+}
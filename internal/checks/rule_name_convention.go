@@ -0,0 +1,91 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	RecordingNameConventionCheckName    = "rule/name_convention"
+	RecordingNameConventionCheckDetails = `Prometheus documentation recommends using the ` + "`level:metric:operations`" + ` format for recording rule names, for example ` + "`job:http_requests_total:rate5m`" + `.
+Following a consistent naming convention makes it easy to tell recording rules from raw metrics, and to see what labels and operations were used to produce them.
+See [Prometheus docs](https://prometheus.io/docs/practices/rules/#naming) for details.`
+
+	defaultRecordingNamePattern = "^[a-z][a-z0-9_]*:[a-z][a-z0-9_]*:[a-z][a-z0-9_]*$"
+)
+
+type RecordingNameConventionSettings struct {
+	Pattern string `hcl:"pattern,optional" json:"pattern,omitempty"`
+	pattern *regexp.Regexp
+}
+
+func (c *RecordingNameConventionSettings) Validate() (err error) {
+	p := defaultRecordingNamePattern
+	if c.Pattern != "" {
+		p = c.Pattern
+	}
+	if c.pattern, err = regexp.Compile(p); err != nil {
+		return fmt.Errorf("invalid pattern value: %w", err)
+	}
+	return nil
+}
+
+func (c *RecordingNameConventionSettings) Regexp() *regexp.Regexp {
+	return c.pattern
+}
+
+func NewRecordingNameConventionCheck(pattern *regexp.Regexp) RecordingNameConventionCheck {
+	if pattern == nil {
+		pattern = regexp.MustCompile(defaultRecordingNamePattern)
+	}
+	return RecordingNameConventionCheck{pattern: pattern}
+}
+
+type RecordingNameConventionCheck struct {
+	pattern *regexp.Regexp
+}
+
+func (c RecordingNameConventionCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c RecordingNameConventionCheck) String() string {
+	return RecordingNameConventionCheckName
+}
+
+func (c RecordingNameConventionCheck) Reporter() string {
+	return RecordingNameConventionCheckName
+}
+
+func (c RecordingNameConventionCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil {
+		return problems
+	}
+
+	if c.pattern.MatchString(rule.RecordingRule.Record.Value) {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Record.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("Recording rule name `%s` doesn't match `%s`, consider using the `level:metric:operations` naming convention.",
+			rule.RecordingRule.Record.Value, c.pattern.String()),
+		Details:  RecordingNameConventionCheckDetails,
+		Severity: Information,
+	})
+
+	return problems
+}
@@ -0,0 +1,99 @@
+package checks_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newRecordingNameConventionCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewRecordingNameConventionCheck(nil)
+}
+
+func TestRecordingNameConventionCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newRecordingNameConventionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "valid recording rule name",
+			content:     "- record: job:http_requests_total:rate5m\n  expr: sum(rate(http_requests_total[5m])) by (job)\n",
+			checker:     newRecordingNameConventionCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "name without levels",
+			content:     "- record: http_requests_total_rate5m\n  expr: sum(rate(http_requests_total[5m])) by (job)\n",
+			checker:     newRecordingNameConventionCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.RecordingNameConventionCheckName,
+						Text:     "Recording rule name `http_requests_total_rate5m` doesn't match `^[a-z][a-z0-9_]*:[a-z][a-z0-9_]*:[a-z][a-z0-9_]*$`, consider using the `level:metric:operations` naming convention.",
+						Details:  checks.RecordingNameConventionCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "name with missing operations",
+			content:     "- record: job:http_requests_total\n  expr: sum(http_requests_total) by (job)\n",
+			checker:     newRecordingNameConventionCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.RecordingNameConventionCheckName,
+						Text:     "Recording rule name `job:http_requests_total` doesn't match `^[a-z][a-z0-9_]*:[a-z][a-z0-9_]*:[a-z][a-z0-9_]*$`, consider using the `level:metric:operations` naming convention.",
+						Details:  checks.RecordingNameConventionCheckDetails,
+						Severity: checks.Information,
+					},
+				}
+			},
+		},
+		{
+			description: "custom pattern / match",
+			content:     "- record: job:http_requests_total\n  expr: sum(http_requests_total) by (job)\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewRecordingNameConventionCheck(regexp.MustCompile("^[a-z_]+:[a-z_]+(:[a-z0-9_]+)?$"))
+			},
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+	}
+
+	runTests(t, testCases)
+}
+
+func TestRecordingNameConventionSettings(t *testing.T) {
+	s := checks.RecordingNameConventionSettings{}
+	require.NoError(t, s.Validate())
+	require.Equal(t, "^[a-z][a-z0-9_]*:[a-z][a-z0-9_]*:[a-z][a-z0-9_]*$", s.Regexp().String())
+
+	s = checks.RecordingNameConventionSettings{Pattern: "^[a-z:]+$"}
+	require.NoError(t, s.Validate())
+	require.Equal(t, "^[a-z:]+$", s.Regexp().String())
+
+	s = checks.RecordingNameConventionSettings{Pattern: "(foo"}
+	require.EqualError(t, s.Validate(), "invalid pattern value: error parsing regexp: missing closing ): `(foo`")
+}
@@ -0,0 +1,87 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	RecordNameLengthCheckName    = "rule/name_length"
+	RecordNameLengthCheckDetails = `Some client libraries, storage backends and recording rule frameworks limit the length of metric names.
+Time series produced by a recording rule with a name that is too long might be rejected or truncated by those systems.`
+
+	defaultRecordNameMaxLength = 255
+)
+
+type RecordNameLengthSettings struct {
+	MaxLength int `hcl:"maxLength,optional" json:"maxLength,omitempty"`
+	maxLength int
+}
+
+func (c *RecordNameLengthSettings) Validate() error {
+	if c.MaxLength < 0 {
+		return errors.New("maxLength cannot be negative")
+	}
+	c.maxLength = defaultRecordNameMaxLength
+	if c.MaxLength > 0 {
+		c.maxLength = c.MaxLength
+	}
+	return nil
+}
+
+func (c *RecordNameLengthSettings) Limit() int {
+	return c.maxLength
+}
+
+func NewRecordNameLengthCheck(maxLen int) RecordNameLengthCheck {
+	if maxLen <= 0 {
+		maxLen = defaultRecordNameMaxLength
+	}
+	return RecordNameLengthCheck{maxLen: maxLen}
+}
+
+type RecordNameLengthCheck struct {
+	maxLen int
+}
+
+func (c RecordNameLengthCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c RecordNameLengthCheck) String() string {
+	return RecordNameLengthCheckName
+}
+
+func (c RecordNameLengthCheck) Reporter() string {
+	return RecordNameLengthCheckName
+}
+
+func (c RecordNameLengthCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil {
+		return problems
+	}
+
+	if l := len(rule.RecordingRule.Record.Value); l > c.maxLen {
+		problems = append(problems, Problem{
+			Lines:    rule.RecordingRule.Record.Lines,
+			Reporter: c.Reporter(),
+			Text:     fmt.Sprintf("Recording rule name is %d characters long, which is more than the %d characters limit.", l, c.maxLen),
+			Details:  RecordNameLengthCheckDetails,
+			Severity: Bug,
+		})
+	}
+
+	return problems
+}
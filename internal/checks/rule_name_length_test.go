@@ -0,0 +1,80 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func TestRecordNameLengthCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo_bar_baz\n  expr: up == 0\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewRecordNameLengthCheck(5)
+			},
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "name within the default limit",
+			content:     "- record: job:up:sum\n  expr: sum(up) by(job)\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewRecordNameLengthCheck(0)
+			},
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "name equal to the limit",
+			content:     "- record: job:up:sum\n  expr: sum(up) by(job)\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewRecordNameLengthCheck(10)
+			},
+			prometheus: noProm,
+			problems:   noProblems,
+		},
+		{
+			description: "name over the limit",
+			content:     "- record: job:up:sum\n  expr: sum(up) by(job)\n",
+			checker: func(_ *promapi.FailoverGroup) checks.RuleChecker {
+				return checks.NewRecordNameLengthCheck(8)
+			},
+			prometheus: noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 1,
+							Last:  1,
+						},
+						Reporter: checks.RecordNameLengthCheckName,
+						Text:     "Recording rule name is 10 characters long, which is more than the 8 characters limit.",
+						Details:  checks.RecordNameLengthCheckDetails,
+						Severity: checks.Bug,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
+
+func TestRecordNameLengthSettings(t *testing.T) {
+	s := checks.RecordNameLengthSettings{}
+	require.NoError(t, s.Validate())
+	require.Equal(t, 255, s.Limit())
+
+	s = checks.RecordNameLengthSettings{MaxLength: 64}
+	require.NoError(t, s.Validate())
+	require.Equal(t, 64, s.Limit())
+
+	s = checks.RecordNameLengthSettings{MaxLength: -1}
+	require.EqualError(t, s.Validate(), "maxLength cannot be negative")
+}
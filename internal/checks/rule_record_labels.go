@@ -0,0 +1,70 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	RecordingLabelCheckName    = "rule/record_labels"
+	RecordingLabelCheckDetails = `Static labels set in the ` + "`labels`" + ` block of a recording rule are added to all time series produced by it, replacing any label with the same name returned by the query.
+This can hide differences between series and break any query that joins the recorded metric with other metrics on those labels.
+Make sure that every label listed here is really needed, or move it to the query using ` + "`label_replace()`" + ` if it should only be set when missing.`
+)
+
+type RecordingLabelSettings struct{}
+
+func (s *RecordingLabelSettings) Validate() error {
+	return nil
+}
+
+func NewRecordingLabelCheck() RecordingLabelCheck {
+	return RecordingLabelCheck{}
+}
+
+type RecordingLabelCheck struct{}
+
+func (c RecordingLabelCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c RecordingLabelCheck) String() string {
+	return RecordingLabelCheckName
+}
+
+func (c RecordingLabelCheck) Reporter() string {
+	return RecordingLabelCheckName
+}
+
+func (c RecordingLabelCheck) Check(_ context.Context, _ discovery.Path, rule parser.Rule, _ []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.RecordingRule.Labels == nil || len(rule.RecordingRule.Labels.Items) == 0 {
+		return problems
+	}
+
+	names := make([]string, 0, len(rule.RecordingRule.Labels.Items))
+	for _, lab := range rule.RecordingRule.Labels.Items {
+		names = append(names, lab.Key.Value)
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Labels.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("This recording rule sets static labels on all series it produces: %s, please verify that this is intended since it will override these labels on `%s` and can break queries joining it with other metrics.",
+			quoteLabelNames(names), rule.RecordingRule.Record.Value),
+		Details:  RecordingLabelCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
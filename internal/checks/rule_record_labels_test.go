@@ -0,0 +1,61 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newRecordingLabelCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewRecordingLabelCheck()
+}
+
+func TestRecordingLabelCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: up == 0\n  labels:\n    severity: page\n",
+			checker:     newRecordingLabelCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "recording rule without labels",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newRecordingLabelCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "recording rule with empty labels",
+			content:     "- record: foo\n  expr: sum(up)\n  labels: {}\n",
+			checker:     newRecordingLabelCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "recording rule with labels",
+			content:     "- record: foo\n  expr: sum(up) by (job)\n  labels:\n    job: foo\n    env: prod\n",
+			checker:     newRecordingLabelCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 3,
+							Last:  5,
+						},
+						Reporter: checks.RecordingLabelCheckName,
+						Text:     "This recording rule sets static labels on all series it produces: `job`, `env`, please verify that this is intended since it will override these labels on `foo` and can break queries joining it with other metrics.",
+						Details:  checks.RecordingLabelCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+		},
+	}
+
+	runTests(t, testCases)
+}
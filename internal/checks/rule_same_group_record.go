@@ -0,0 +1,77 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	SameGroupRecordCheckName    = "rule/same_group_record"
+	SameGroupRecordCheckDetails = `Prometheus evaluates each rule group independently, on its own schedule.
+When multiple groups have recording rules with the same name then each of them will be writing results to the same time series, interleaving their outputs unpredictably.
+Keep all recording rules producing given metric in a single group.`
+)
+
+func NewSameGroupRecordCheck() SameGroupRecordCheck {
+	return SameGroupRecordCheck{}
+}
+
+type SameGroupRecordCheck struct{}
+
+func (c SameGroupRecordCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c SameGroupRecordCheck) String() string {
+	return SameGroupRecordCheckName
+}
+
+func (c SameGroupRecordCheck) Reporter() string {
+	return SameGroupRecordCheckName
+}
+
+func (c SameGroupRecordCheck) Check(_ context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil || rule.Group == "" {
+		return problems
+	}
+
+	var groups []string
+	for _, entry := range entries {
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if entry.Path.Name != path.Name || entry.Rule.Group == "" || entry.Rule.Group == rule.Group {
+			continue
+		}
+		if entry.Rule.RecordingRule == nil || entry.Rule.RecordingRule.Record.Value != rule.RecordingRule.Record.Value {
+			continue
+		}
+		groups = append(groups, fmt.Sprintf("`%s` on line %d", entry.Rule.Group, entry.Rule.RecordingRule.Record.Lines.First))
+	}
+	if len(groups) == 0 {
+		return problems
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Record.Lines,
+		Reporter: c.Reporter(),
+		Text: fmt.Sprintf("`%s` is also recorded by a rule in a different group of this file: %s, each group is evaluated independently so results will interleave unpredictably.",
+			rule.RecordingRule.Record.Value, strings.Join(groups, ", ")),
+		Details:  SameGroupRecordCheckDetails,
+		Severity: Warning,
+	})
+
+	return problems
+}
@@ -0,0 +1,72 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newSameGroupRecordCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewSameGroupRecordCheck()
+}
+
+func TestSameGroupRecordCheck(t *testing.T) {
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "groups:\n- name: foo\n  rules:\n  - alert: foo\n    expr: up == 0\n",
+			checker:     newSameGroupRecordCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("groups:\n- name: foo\n  rules:\n  - alert: foo\n    expr: up == 0\n- name: bar\n  rules:\n  - alert: foo\n    expr: up == 0\n"),
+		},
+		{
+			description: "ignores rules outside of groups",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newSameGroupRecordCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("- record: foo\n  expr: sum(up)\n- record: foo\n  expr: sum(up)\n"),
+		},
+		{
+			description: "same name in the same group",
+			content:     "groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(up)\n",
+			checker:     newSameGroupRecordCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(up)\n  - record: foo\n    expr: sum(down)\n"),
+		},
+		{
+			description: "different names in different groups",
+			content:     "groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(up)\n",
+			checker:     newSameGroupRecordCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries:     mustParseContent("groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(up)\n- name: bar\n  rules:\n  - record: bar\n    expr: sum(up)\n"),
+		},
+		{
+			description: "same name in different groups",
+			content:     "groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(up)\n",
+			checker:     newSameGroupRecordCheck,
+			prometheus:  noProm,
+			problems: func(_ string) []checks.Problem {
+				return []checks.Problem{
+					{
+						Lines: parser.LineRange{
+							First: 4,
+							Last:  4,
+						},
+						Reporter: checks.SameGroupRecordCheckName,
+						Text:     "`foo` is also recorded by a rule in a different group of this file: `bar` on line 8, `baz` on line 12, each group is evaluated independently so results will interleave unpredictably.",
+						Details:  checks.SameGroupRecordCheckDetails,
+						Severity: checks.Warning,
+					},
+				}
+			},
+			entries: mustParseContent("groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(up)\n- name: bar\n  rules:\n  - record: foo\n    expr: sum(down)\n- name: baz\n  rules:\n  - record: foo\n    expr: sum(other)\n"),
+		},
+	}
+	runTests(t, testCases)
+}
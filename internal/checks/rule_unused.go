@@ -0,0 +1,88 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+)
+
+const (
+	UnusedRecordingRuleCheckName    = "rule/unused"
+	UnusedRecordingRuleCheckDetails = `Metrics produced by this recording rule are not used by any other rule that pint found.
+Every recording rule is evaluated by Prometheus on each evaluation interval, so rules that nobody is using are wasting resources.
+If this metric is only used by dashboards or other tools outside of Prometheus rules you can ignore this, otherwise consider removing this rule.`
+)
+
+type UnusedRecordingRuleSettings struct {
+	OnlyUnchanged bool `hcl:"onlyUnchanged,optional" json:"onlyUnchanged,omitempty"`
+}
+
+func (s *UnusedRecordingRuleSettings) Validate() error {
+	return nil
+}
+
+func NewUnusedRecordingRuleCheck() UnusedRecordingRuleCheck {
+	return UnusedRecordingRuleCheck{}
+}
+
+type UnusedRecordingRuleCheck struct{}
+
+func (c UnusedRecordingRuleCheck) Meta() CheckMeta {
+	return CheckMeta{
+		States: []discovery.ChangeType{
+			discovery.Noop,
+			discovery.Added,
+			discovery.Modified,
+			discovery.Moved,
+		},
+		IsOnline: false,
+	}
+}
+
+func (c UnusedRecordingRuleCheck) String() string {
+	return UnusedRecordingRuleCheckName
+}
+
+func (c UnusedRecordingRuleCheck) Reporter() string {
+	return UnusedRecordingRuleCheckName
+}
+
+func (c UnusedRecordingRuleCheck) Check(ctx context.Context, path discovery.Path, rule parser.Rule, entries []discovery.Entry) (problems []Problem) {
+	if rule.RecordingRule == nil {
+		return problems
+	}
+
+	settings := &UnusedRecordingRuleSettings{}
+	if s := ctx.Value(SettingsKey(c.Reporter())); s != nil {
+		settings = s.(*UnusedRecordingRuleSettings)
+	}
+
+	var dep RuleDependencyCheck
+	for _, entry := range entries {
+		if entry.Path.Name == path.Name && entry.Rule.Lines.First == rule.Lines.First {
+			// New rules might not have any users yet.
+			if settings.OnlyUnchanged && entry.State != discovery.Noop {
+				return problems
+			}
+			continue
+		}
+		if entry.State == discovery.Removed || entry.PathError != nil || entry.Rule.Error.Err != nil {
+			continue
+		}
+		if dep.usesVector(entry, rule.RecordingRule.Record.Value) != nil {
+			return problems
+		}
+	}
+
+	problems = append(problems, Problem{
+		Lines:    rule.RecordingRule.Record.Lines,
+		Reporter: c.Reporter(),
+		Text:     fmt.Sprintf("`%s` metric produced by this recording rule is not used by any other rule.", rule.RecordingRule.Record.Value),
+		Details:  UnusedRecordingRuleCheckDetails,
+		Severity: Information,
+	})
+
+	return problems
+}
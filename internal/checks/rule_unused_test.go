@@ -0,0 +1,119 @@
+package checks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func newUnusedRecordingRuleCheck(_ *promapi.FailoverGroup) checks.RuleChecker {
+	return checks.NewUnusedRecordingRuleCheck()
+}
+
+func withEntryState(entries []discovery.Entry, state discovery.ChangeType) []discovery.Entry {
+	for i := range entries {
+		entries[i].State = state
+	}
+	return entries
+}
+
+func TestUnusedRecordingRuleCheck(t *testing.T) {
+	unusedProblem := func(_ string) []checks.Problem {
+		return []checks.Problem{
+			{
+				Lines: parser.LineRange{
+					First: 1,
+					Last:  1,
+				},
+				Reporter: checks.UnusedRecordingRuleCheckName,
+				Text:     "`foo` metric produced by this recording rule is not used by any other rule.",
+				Details:  checks.UnusedRecordingRuleCheckDetails,
+				Severity: checks.Information,
+			},
+		}
+	}
+	onlyUnchanged := func() context.Context {
+		s := checks.UnusedRecordingRuleSettings{OnlyUnchanged: true}
+		return context.WithValue(context.Background(), checks.SettingsKey(checks.UnusedRecordingRuleCheckName), &s)
+	}
+
+	testCases := []checkTest{
+		{
+			description: "ignores alerting rules",
+			content:     "- alert: foo\n  expr: up == 0\n",
+			checker:     newUnusedRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+		},
+		{
+			description: "used by a recording rule",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newUnusedRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(`
+- record: foo
+  expr: sum(up)
+- record: bar
+  expr: foo * 2
+`),
+		},
+		{
+			description: "used by an alerting rule",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newUnusedRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			entries: mustParseContent(`
+- record: foo
+  expr: sum(up)
+- alert: bar
+  expr: foo{job="bar"} == 0
+`),
+		},
+		{
+			description: "not used",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newUnusedRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    unusedProblem,
+			entries: mustParseContent(`
+- record: foo
+  expr: sum(up)
+- record: bar
+  expr: sum(foo_bar)
+`),
+		},
+		{
+			description: "only used by a removed rule",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newUnusedRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    unusedProblem,
+			entries:     withEntryState(mustParseContent("- record: bar\n  expr: foo * 2\n"), discovery.Removed),
+		},
+		{
+			description: "not used / onlyUnchanged / new rule",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newUnusedRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    noProblems,
+			ctx:         onlyUnchanged,
+			entries:     withEntryState(mustParseContent("- record: foo\n  expr: sum(up)\n"), discovery.Added),
+		},
+		{
+			description: "not used / onlyUnchanged / unchanged rule",
+			content:     "- record: foo\n  expr: sum(up)\n",
+			checker:     newUnusedRecordingRuleCheck,
+			prometheus:  noProm,
+			problems:    unusedProblem,
+			ctx:         onlyUnchanged,
+			entries:     withEntryState(mustParseContent("- record: foo\n  expr: sum(up)\n"), discovery.Noop),
+		},
+	}
+	runTests(t, testCases)
+}
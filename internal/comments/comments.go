@@ -3,6 +3,7 @@ package comments
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 	"unicode"
@@ -25,6 +26,7 @@ const (
 	FileSnoozeType     // file/snooze
 	SnoozeType         // snooze
 	RuleSetType        // rule/set
+	IgnoreType         // ignore
 )
 
 var (
@@ -42,6 +44,7 @@ var (
 	FileSnoozeComment     = "file/snooze"
 	SnoozeComment         = "snooze"
 	RuleSetComment        = "rule/set"
+	IgnoreComment         = "ignore"
 )
 
 type CommentValue interface {
@@ -79,6 +82,8 @@ func parseType(s string) Type {
 		return SnoozeType
 	case RuleSetComment:
 		return RuleSetType
+	case IgnoreComment:
+		return IgnoreType
 	default:
 		return UnknownType
 	}
@@ -134,6 +139,41 @@ func (r RuleSet) String() string {
 	return r.Value
 }
 
+const ignoreSupportedCheck = "promql/series"
+
+type Ignore struct {
+	Match  string
+	Reason string
+}
+
+func (i Ignore) String() string {
+	return fmt.Sprintf("%s reason=%q", i.Match, i.Reason)
+}
+
+func parseIgnore(s string) (Ignore, error) {
+	idx := strings.LastIndex(s, " reason=")
+	if idx < 0 {
+		return Ignore{}, fmt.Errorf(`invalid ignore comment, expected '$MATCH reason="$REASON"' got %q`, s)
+	}
+
+	reason, err := strconv.Unquote(strings.TrimPrefix(s[idx:], " reason="))
+	if err != nil {
+		return Ignore{}, fmt.Errorf("invalid ignore reason: %w", err)
+	}
+	if reason == "" {
+		return Ignore{}, fmt.Errorf("missing %s reason", IgnoreComment)
+	}
+
+	match := strings.TrimSpace(s[:idx])
+	// Only promql/series knows how to handle ignore comments, reject
+	// anything else instead of silently doing nothing.
+	if !strings.HasPrefix(match, ignoreSupportedCheck+"(") || !strings.HasSuffix(match, ")") {
+		return Ignore{}, fmt.Errorf("%s comments are only supported for %s($selector), got %q", IgnoreComment, ignoreSupportedCheck, match)
+	}
+
+	return Ignore{Match: match, Reason: reason}, nil
+}
+
 func parseSnooze(s string) (snz Snooze, err error) {
 	parts := strings.SplitN(s, " ", 2)
 	if len(parts) != 2 {
@@ -192,6 +232,11 @@ func parseValue(typ Type, s string) (CommentValue, error) {
 			return nil, fmt.Errorf("missing %s value", RuleSetComment)
 		}
 		return RuleSet{Value: s}, nil
+	case IgnoreType:
+		if s == "" {
+			return nil, fmt.Errorf("missing %s value", IgnoreComment)
+		}
+		return parseIgnore(s)
 	case UnknownType, InvalidComment:
 		// pass
 	}
@@ -329,7 +374,7 @@ func Only[T any](src []Comment, typ Type) []T {
 func IsRuleComment(typ Type) bool {
 	// nolint:exhaustive
 	switch typ {
-	case RuleOwnerType, DisableType, SnoozeType, RuleSetType:
+	case RuleOwnerType, DisableType, SnoozeType, RuleSetType, IgnoreType:
 		return true
 	}
 	return false
@@ -3,6 +3,7 @@ package comments_test
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -439,6 +440,90 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: "# pint ignore",
+			output: []comments.Comment{
+				{
+					Type: comments.InvalidComment,
+					Value: comments.Invalid{Err: comments.CommentError{
+						Line: 1,
+						Err:  fmt.Errorf("missing ignore value"),
+					}},
+				},
+			},
+		},
+		{
+			input: "# pint ignore promql/series(foo)",
+			output: []comments.Comment{
+				{
+					Type: comments.InvalidComment,
+					Value: comments.Invalid{Err: comments.CommentError{
+						Line: 1,
+						Err:  fmt.Errorf(`invalid ignore comment, expected '$MATCH reason="$REASON"' got "promql/series(foo)"`),
+					}},
+				},
+			},
+		},
+		{
+			input: `# pint ignore promql/series(foo) reason=""`,
+			output: []comments.Comment{
+				{
+					Type: comments.InvalidComment,
+					Value: comments.Invalid{Err: comments.CommentError{
+						Line: 1,
+						Err:  fmt.Errorf("missing ignore reason"),
+					}},
+				},
+			},
+		},
+		{
+			input: `# pint ignore promql/series(foo) reason=not scraped yet`,
+			output: []comments.Comment{
+				{
+					Type: comments.InvalidComment,
+					Value: comments.Invalid{Err: comments.CommentError{
+						Line: 1,
+						Err:  fmt.Errorf("invalid ignore reason: %w", strconv.ErrSyntax),
+					}},
+				},
+			},
+		},
+		{
+			input: `# pint ignore promql/rate reason="not scraped yet"`,
+			output: []comments.Comment{
+				{
+					Type: comments.InvalidComment,
+					Value: comments.Invalid{Err: comments.CommentError{
+						Line: 1,
+						Err:  fmt.Errorf(`ignore comments are only supported for promql/series($selector), got "promql/rate"`),
+					}},
+				},
+			},
+		},
+		{
+			input: `# pint ignore promql/series reason="not scraped yet"`,
+			output: []comments.Comment{
+				{
+					Type: comments.InvalidComment,
+					Value: comments.Invalid{Err: comments.CommentError{
+						Line: 1,
+						Err:  fmt.Errorf(`ignore comments are only supported for promql/series($selector), got "promql/series"`),
+					}},
+				},
+			},
+		},
+		{
+			input: `# pint ignore promql/series(http_errors_total{label="this has spaces"}) reason="not scraped yet"`,
+			output: []comments.Comment{
+				{
+					Type: comments.IgnoreType,
+					Value: comments.Ignore{
+						Match:  `promql/series(http_errors_total{label="this has spaces"})`,
+						Reason: "not scraped yet",
+					},
+				},
+			},
+		},
 		{
 			input: "{# comment #} # pint ignore/line # pint ignore/file",
 			output: []comments.Comment{
@@ -497,6 +582,10 @@ func TestCommentValueString(t *testing.T) {
 			comment:  comments.Snooze{Match: `promql/series({code="500"})`, Until: parseUntil("2023-11-28T00:00:00Z")},
 			expected: `2023-11-28T00:00:00Z promql/series({code="500"})`,
 		},
+		{
+			comment:  comments.Ignore{Match: `promql/series({code="500"})`, Reason: "not scraped yet"},
+			expected: `promql/series({code="500"}) reason="not scraped yet"`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -27,6 +27,58 @@ func (c Check) Decode() (s CheckSettings, err error) {
 	switch c.Name {
 	case checks.SeriesCheckName:
 		s = &checks.PromqlSeriesSettings{}
+	case checks.AlertsForFormatCheckName:
+		s = &checks.AlertsForFormatSettings{}
+	case checks.AbsentAlwaysPresentCheckName:
+		s = &checks.AbsentAlwaysPresentSettings{}
+	case checks.AlertNameCheckName:
+		s = &checks.AlertNameSettings{}
+	case checks.RecordNameLengthCheckName:
+		s = &checks.RecordNameLengthSettings{}
+	case checks.CardinalityDeltaCheckName:
+		s = &checks.CardinalityDeltaSettings{}
+	case checks.UnusedRecordingRuleCheckName:
+		s = &checks.UnusedRecordingRuleSettings{}
+	case checks.RoutingCheckName:
+		s = &checks.RoutingSettings{}
+	case checks.EvalDurationCheckName:
+		s = &checks.EvalDurationSettings{}
+	case checks.ExtractOpportunityCheckName:
+		s = &checks.ExtractOpportunitySettings{}
+	case checks.RecordingNameConventionCheckName:
+		s = &checks.RecordingNameConventionSettings{}
+	case checks.GroupsWrapperCheckName:
+		s = &checks.GroupsWrapperSettings{}
+	case checks.AlertmanagerDelayCheckName:
+		s = &checks.AlertmanagerDelaySettings{}
+	case checks.RecordingLabelCheckName:
+		s = &checks.RecordingLabelSettings{}
+	case checks.LabelsConsistencyCheckName:
+		s = &checks.LabelsConsistencySettings{}
+	case checks.InhibitionCheckName:
+		s = &checks.InhibitionSettings{}
+	case checks.PrometheusInternalMetricCheckName:
+		s = &checks.PrometheusInternalMetricSettings{}
+	case checks.ScalarCheckName:
+		s = &checks.ScalarSettings{}
+	case checks.ClampCheckName:
+		s = &checks.ClampSettings{}
+	case checks.NamingConflictCheckName:
+		s = &checks.NamingConflictSettings{}
+	case checks.AvgOverTimeResetCheckName:
+		s = &checks.AvgOverTimeResetSettings{}
+	case checks.LogExpCheckName:
+		s = &checks.LogExpSettings{}
+	case checks.CountAlwaysPositiveCheckName:
+		s = &checks.CountAlwaysPositiveSettings{}
+	case checks.ResetsWindowCheckName:
+		s = &checks.ResetsWindowSettings{}
+	case checks.HighCardinalityCheckName:
+		s = &checks.HighCardinalitySettings{}
+	case checks.BusinessHoursCheckName:
+		s = &checks.BusinessHoursSettings{}
+	case checks.DetectionLatencyCheckName:
+		s = &checks.DetectionLatencySettings{}
 	default:
 		return nil, fmt.Errorf("unknown check %q", c.Name)
 	}
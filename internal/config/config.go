@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 	"github.com/cloudflare/pint/internal/checks"
 	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/promapi"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsimple"
@@ -80,9 +82,17 @@ func (cfg Config) String() string {
 	return string(content)
 }
 
-func (cfg *Config) GetChecksForRule(ctx context.Context, gen *PrometheusGenerator, entry discovery.Entry, disabledChecks []string) []checks.RuleChecker {
+// GetChecksForRule returns all checks that should be run for given rule.
+// settings must be the result of CheckSettings(), it's passed in so that
+// check blocks are only decoded once and not for every rule.
+func (cfg *Config) GetChecksForRule(ctx context.Context, gen *PrometheusGenerator, entry discovery.Entry, settings map[string]CheckSettings, disabledChecks []string) []checks.RuleChecker {
 	enabled := []checks.RuleChecker{}
 
+	var alertNamePattern *regexp.Regexp
+	if s, ok := settings[checks.AlertNameCheckName].(*checks.AlertNameSettings); ok {
+		alertNamePattern = s.Regexp()
+	}
+
 	allChecks := []checkMeta{
 		{
 			name:  checks.SyntaxCheckName,
@@ -112,11 +122,153 @@ func (cfg *Config) GetChecksForRule(ctx context.Context, gen *PrometheusGenerato
 			name:  checks.RuleDependencyCheckName,
 			check: checks.NewRuleDependencyCheck(),
 		},
+		{
+			name:  checks.ThresholdRecordingRuleCheckName,
+			check: checks.NewThresholdRecordingRuleCheck(),
+		},
+		{
+			name:  checks.TimestampCheckName,
+			check: checks.NewTimestampCheck(),
+		},
+		{
+			name:  checks.ForDurationOrderCheckName,
+			check: checks.NewForDurationOrderCheck(),
+		},
+		{
+			name:  checks.SortCheckName,
+			check: checks.NewSortCheck(),
+		},
+		{
+			name:  checks.TwoPhaseAlertCheckName,
+			check: checks.NewTwoPhaseAlertCheck(),
+		},
+		{
+			name:  checks.ScalarComparisonCheckName,
+			check: checks.NewScalarComparisonCheck(),
+		},
+		{
+			name:  checks.QuantileCheckName,
+			check: checks.NewQuantileCheck(),
+		},
+		{
+			name:  checks.UnlessCheckName,
+			check: checks.NewUnlessCheck(),
+		},
+		{
+			name:  checks.DuplicateSelectorCheckName,
+			check: checks.NewDuplicateSelectorCheck(),
+		},
+		{
+			name:  checks.ForOffsetCheckName,
+			check: checks.NewForOffsetCheck(),
+		},
+		{
+			name:  checks.CountValuesCheckName,
+			check: checks.NewCountValuesCheck(),
+		},
+		{
+			name:  checks.VectorLiteralCheckName,
+			check: checks.NewVectorLiteralCheck(),
+		},
+		{
+			name:  checks.ExpressionDuplicateCheckName,
+			check: checks.NewExpressionDuplicateCheck(),
+		},
+		{
+			name:  checks.EvalOrderCheckName,
+			check: checks.NewEvalOrderCheck(),
+		},
+		{
+			name:  checks.UpProxyCheckName,
+			check: checks.NewUpProxyCheck(),
+		},
+		{
+			name:  checks.RegexEfficiencyCheckName,
+			check: checks.NewRegexEfficiencyCheck(),
+		},
+		{
+			name:  checks.TimeCheckName,
+			check: checks.NewTimeCheck(),
+		},
+		{
+			name:  checks.ComplementSelectorCheckName,
+			check: checks.NewComplementSelectorCheck(),
+		},
+		{
+			name:  checks.HistogramCompletenessCheckName,
+			check: checks.NewHistogramCompletenessCheck(),
+		},
+		{
+			name:  checks.HoltWintersCheckName,
+			check: checks.NewHoltWintersCheck(),
+		},
+		{
+			name:  checks.IdeltaCheckName,
+			check: checks.NewIdeltaCheck(),
+		},
+		{
+			name:  checks.EmptyOnCheckName,
+			check: checks.NewEmptyOnCheck(),
+		},
+		{
+			name:  checks.WindowForAlignmentCheckName,
+			check: checks.NewWindowForAlignmentCheck(),
+		},
+		{
+			name:  checks.IdentityRecordCheckName,
+			check: checks.NewIdentityRecordCheck(),
+		},
+		{
+			name:  checks.DateTimeFunctionCheckName,
+			check: checks.NewDateTimeFunctionCheck(),
+		},
+		{
+			name:  checks.AlertDuplicateConditionCheckName,
+			check: checks.NewAlertDuplicateConditionCheck(),
+		},
+		{
+			name:  checks.SameGroupRecordCheckName,
+			check: checks.NewSameGroupRecordCheck(),
+		},
+		{
+			name:  checks.SeriesDisableCheckName,
+			check: checks.NewSeriesDisableCheck(),
+		},
+		{
+			name:  checks.AlertNameCheckName,
+			check: checks.NewAlertNameCheck(alertNamePattern),
+		},
+	}
+
+	var reportActualServer, serverSpecificMetrics bool
+	if s, ok := settings[checks.SeriesCheckName].(*checks.PromqlSeriesSettings); ok {
+		reportActualServer = s.ReportActualServer
+		serverSpecificMetrics = s.ServerSpecificMetrics
 	}
 
 	proms := gen.ServersForPath(entry.Path.Name)
+	evalDuration := settings[checks.EvalDurationCheckName]
+	consistency := settings[checks.LabelsConsistencyCheckName]
+	scalar := settings[checks.ScalarCheckName]
+	clamp := settings[checks.ClampCheckName]
+	namingConflict := settings[checks.NamingConflictCheckName]
+	avgOverTimeReset := settings[checks.AvgOverTimeResetCheckName]
+	logExp := settings[checks.LogExpCheckName]
+	countAlwaysPositive := settings[checks.CountAlwaysPositiveCheckName]
+	resetsWindow := settings[checks.ResetsWindowCheckName]
+	forFormat := settings[checks.AlertsForFormatCheckName]
+	highCardinality := settings[checks.HighCardinalityCheckName]
+	businessHours := settings[checks.BusinessHoursCheckName]
 
 	for _, p := range proms {
+		// Only enabled when there's a check block for it.
+		if settings[checks.AbsentAlwaysPresentCheckName] != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.AbsentAlwaysPresentCheckName,
+				check: checks.NewAbsentAlwaysPresentCheck(p),
+				tags:  p.Tags(),
+			})
+		}
 		allChecks = append(allChecks, checkMeta{
 			name:  checks.RateCheckName,
 			check: checks.NewRateCheck(p),
@@ -124,7 +276,7 @@ func (cfg *Config) GetChecksForRule(ctx context.Context, gen *PrometheusGenerato
 		})
 		allChecks = append(allChecks, checkMeta{
 			name:  checks.SeriesCheckName,
-			check: checks.NewSeriesCheck(p),
+			check: checks.NewSeriesCheck(p, otherServers(proms, p)).WithReportActualServer(reportActualServer).WithServerSpecificMetrics(serverSpecificMetrics),
 			tags:  p.Tags(),
 		})
 		allChecks = append(allChecks, checkMeta{
@@ -157,6 +309,284 @@ func (cfg *Config) GetChecksForRule(ctx context.Context, gen *PrometheusGenerato
 			check: checks.NewCounterCheck(p),
 			tags:  p.Tags(),
 		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.DerivCheckName,
+			check: checks.NewDerivCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.SumOverTimeCheckName,
+			check: checks.NewSumOverTimeCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.MaxOverTimeCheckName,
+			check: checks.NewMaxOverTimeCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.CrossServerCheckName,
+			check: checks.NewCrossServerCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.RateWindowCheckName,
+			check: checks.NewRateWindowCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.ResetsCheckName,
+			check: checks.NewResetsCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.AvgOverTimeCheckName,
+			check: checks.NewAvgOverTimeCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.RoundingCheckName,
+			check: checks.NewRoundingCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.ForRetentionCheckName,
+			check: checks.NewForRetentionCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.LabelJoinCheckName,
+			check: checks.NewLabelJoinCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.CardinalityDeltaCheckName,
+			check: checks.NewCardinalityDeltaCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.StddevCheckName,
+			check: checks.NewStddevCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.RateExactIntervalCheckName,
+			check: checks.NewRateExactIntervalCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.HistogramTypeCheckName,
+			check: checks.NewHistogramTypeCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.AvgCounterCheckName,
+			check: checks.NewAvgCounterCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.GroupIntervalCheckName,
+			check: checks.NewGroupIntervalCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.AnnotationLabelCheckName,
+			check: checks.NewAnnotationLabelCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.VersionCompatibilityCheckName,
+			check: checks.NewVersionCompatibilityCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.IncreaseIntervalCheckName,
+			check: checks.NewIncreaseIntervalCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.SumOverTimeWindowCheckName,
+			check: checks.NewSumOverTimeWindowCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.WithoutLabelCheckName,
+			check: checks.NewWithoutLabelCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.EmptyMatcherCheckName,
+			check: checks.NewEmptyMatcherCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.OrLabelMismatchCheckName,
+			check: checks.NewOrLabelMismatchCheck(p),
+			tags:  p.Tags(),
+		})
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.OverTimeSingleSampleCheckName,
+			check: checks.NewOverTimeSingleSampleCheck(p),
+			tags:  p.Tags(),
+		})
+		// Only enabled when there's a check block for it.
+		if evalDuration != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.EvalDurationCheckName,
+				check: checks.NewEvalDurationCheck(p, evalDuration.(*checks.EvalDurationSettings).Duration()),
+				tags:  p.Tags(),
+			})
+		}
+		if scalar != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.ScalarCheckName,
+				check: checks.NewScalarCheck(p),
+				tags:  p.Tags(),
+			})
+		}
+		if clamp != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.ClampCheckName,
+				check: checks.NewClampCheck(p),
+				tags:  p.Tags(),
+			})
+		}
+		if namingConflict != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.NamingConflictCheckName,
+				check: checks.NewNamingConflictCheck(p),
+				tags:  p.Tags(),
+			})
+		}
+		if avgOverTimeReset != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.AvgOverTimeResetCheckName,
+				check: checks.NewAvgOverTimeResetCheck(p),
+				tags:  p.Tags(),
+			})
+		}
+		if logExp != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.LogExpCheckName,
+				check: checks.NewLogExpCheck(p),
+				tags:  p.Tags(),
+			})
+		}
+		if countAlwaysPositive != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.CountAlwaysPositiveCheckName,
+				check: checks.NewCountAlwaysPositiveCheck(p),
+				tags:  p.Tags(),
+			})
+		}
+		if resetsWindow != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.ResetsWindowCheckName,
+				check: checks.NewResetsWindowCheck(p),
+				tags:  p.Tags(),
+			})
+		}
+		if forFormat != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.AlertsForFormatCheckName,
+				check: checks.NewAlertsForFormatCheck(p),
+				tags:  p.Tags(),
+			})
+		}
+		if consistency != nil {
+			cs := consistency.(*checks.LabelsConsistencySettings)
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.LabelsConsistencyCheckName,
+				check: checks.NewLabelsConsistencyCheck(p, cs.LookBack(), cs.Resolution(), cs.Comment, cs.ReportSeverity()),
+				tags:  p.Tags(),
+			})
+		}
+		if highCardinality != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.HighCardinalityCheckName,
+				check: checks.NewHighCardinalityCheck(p, highCardinality.(*checks.HighCardinalitySettings).LabelNames()),
+				tags:  p.Tags(),
+			})
+		}
+		if businessHours != nil {
+			allChecks = append(allChecks, checkMeta{
+				name:  checks.BusinessHoursCheckName,
+				check: checks.NewBusinessHoursCheck(p, businessHours.(*checks.BusinessHoursSettings).Location()),
+				tags:  p.Tags(),
+			})
+		}
+	}
+
+	// Checks below are only enabled when there's a check block for them.
+	if s := settings[checks.RecordNameLengthCheckName]; s != nil {
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.RecordNameLengthCheckName,
+			check: checks.NewRecordNameLengthCheck(s.(*checks.RecordNameLengthSettings).Limit()),
+		})
+	}
+	if s := settings[checks.UnusedRecordingRuleCheckName]; s != nil {
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.UnusedRecordingRuleCheckName,
+			check: checks.NewUnusedRecordingRuleCheck(),
+		})
+	}
+	if s := settings[checks.ExtractOpportunityCheckName]; s != nil {
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.ExtractOpportunityCheckName,
+			check: checks.NewExtractOpportunityCheck(s.(*checks.ExtractOpportunitySettings).Limit()),
+		})
+	}
+	if s := settings[checks.RecordingNameConventionCheckName]; s != nil {
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.RecordingNameConventionCheckName,
+			check: checks.NewRecordingNameConventionCheck(s.(*checks.RecordingNameConventionSettings).Regexp()),
+		})
+	}
+	if s := settings[checks.GroupsWrapperCheckName]; s != nil {
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.GroupsWrapperCheckName,
+			check: checks.NewGroupsWrapperCheck(),
+		})
+	}
+	if s := settings[checks.RecordingLabelCheckName]; s != nil {
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.RecordingLabelCheckName,
+			check: checks.NewRecordingLabelCheck(),
+		})
+	}
+	if s := settings[checks.DetectionLatencyCheckName]; s != nil {
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.DetectionLatencyCheckName,
+			check: checks.NewDetectionLatencyCheck(s.(*checks.DetectionLatencySettings).MaxLatency()),
+		})
+	}
+	if s := settings[checks.PrometheusInternalMetricCheckName]; s != nil {
+		is := s.(*checks.PrometheusInternalMetricSettings)
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.PrometheusInternalMetricCheckName,
+			check: checks.NewPrometheusInternalMetricCheck(is.MetricPrefixes(), is.Comment, is.ReportSeverity()),
+		})
+	}
+	if s := settings[checks.InhibitionCheckName]; s != nil {
+		is := s.(*checks.InhibitionSettings)
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.InhibitionCheckName,
+			check: checks.NewInhibitionCheck(is.Rules(), is.Comment, is.ReportSeverity()),
+		})
+	}
+	if s := settings[checks.RoutingCheckName]; s != nil {
+		rs := s.(*checks.RoutingSettings)
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.RoutingCheckName,
+			check: checks.NewRoutingCheck(gen.Alertmanager(rs.URI, rs.RequestTimeout())),
+		})
+	}
+	if s := settings[checks.AlertmanagerDelayCheckName]; s != nil {
+		ds := s.(*checks.AlertmanagerDelaySettings)
+		allChecks = append(allChecks, checkMeta{
+			name:  checks.AlertmanagerDelayCheckName,
+			check: checks.NewAlertmanagerDelayCheck(gen.Alertmanager(ds.URI, ds.RequestTimeout()), ds.Limit()),
+		})
 	}
 
 	for _, rule := range cfg.Rules {
@@ -195,6 +625,11 @@ func (cfg *Config) GetChecksForRule(ctx context.Context, gen *PrometheusGenerato
 	for _, e := range enabled {
 		el = append(el, fmt.Sprintf("%v", e))
 	}
+	for i, e := range enabled {
+		if dc, ok := e.(checks.DependentChecker); ok {
+			enabled[i] = dc.WithEnabledChecks(el)
+		}
+	}
 	slog.Debug("Configured checks for rule",
 		slog.Any("enabled", el),
 		slog.String("path", entry.Path.Name),
@@ -240,6 +675,10 @@ func Load(path string, failOnMissing bool) (cfg Config, err error) {
 		}
 	}
 
+	if err = applyEnv(&cfg); err != nil {
+		return cfg, err
+	}
+
 	if cfg.CI != nil {
 		if err = cfg.CI.validate(); err != nil {
 			return cfg, err
@@ -335,6 +774,29 @@ func parseDuration(d string) (time.Duration, error) {
 	return time.Duration(mdur), nil
 }
 
+// CheckSettings returns decoded settings from all check blocks, keyed by check name.
+func (cfg *Config) CheckSettings() (map[string]CheckSettings, error) {
+	settings := make(map[string]CheckSettings, len(cfg.Check))
+	for _, chk := range cfg.Check {
+		s, err := chk.Decode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %q check settings: %w", chk.Name, err)
+		}
+		settings[chk.Name] = s
+	}
+	return settings, nil
+}
+
+// otherServers returns all servers from proms except for the one passed as prom.
+func otherServers(proms []*promapi.FailoverGroup, prom *promapi.FailoverGroup) (others []*promapi.FailoverGroup) {
+	for _, p := range proms {
+		if p.Name() != prom.Name() {
+			others = append(others, p)
+		}
+	}
+	return others
+}
+
 type checkMeta struct {
 	name  string
 	check checks.RuleChecker
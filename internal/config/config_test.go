@@ -33,6 +33,59 @@ func TestConfigLoadMissingFileOk(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestConfigPrometheusURLFromEnv(t *testing.T) {
+	type testCaseT struct {
+		config string
+		err    string
+		uris   []string
+	}
+
+	testCases := []testCaseT{
+		{
+			config: "",
+			uris:   []string{"http://env.example.com"},
+		},
+		{
+			config: `prometheus "prom" {
+  uri = "http://localhost"
+}`,
+			uris: []string{"http://env.example.com"},
+		},
+		{
+			config: `prometheus "prom1" {
+  uri = "http://localhost:9090"
+}
+prometheus "prom2" {
+  uri = "http://localhost:9091"
+}`,
+			err: "PINT_PROMETHEUS_URL can only be used when there's at most one prometheus block in the config file",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			t.Setenv("PINT_PROMETHEUS_URL", "http://env.example.com")
+
+			dir := t.TempDir()
+			path := path.Join(dir, "config.hcl")
+			require.NoError(t, os.WriteFile(path, []byte(tc.config), 0o644))
+
+			cfg, err := config.Load(path, true)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			uris := make([]string, 0, len(cfg.Prometheus))
+			for _, prom := range cfg.Prometheus {
+				uris = append(uris, prom.URI)
+			}
+			require.Equal(t, tc.uris, uris)
+		})
+	}
+}
+
 func TestDisableOnlineChecksWithPrometheus(t *testing.T) {
 	dir := t.TempDir()
 	path := path.Join(dir, "config.hcl")
@@ -170,6 +223,35 @@ func TestGetChecksForRule(t *testing.T) {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -194,7 +276,37 @@ prometheus "prom" {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RateCheckName + "(prom)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RateCheckName + "(prom)",
 				checks.SeriesCheckName + "(prom)",
 				checks.VectorMatchingCheckName + "(prom)",
 				checks.RangeQueryCheckName + "(prom)",
@@ -202,6 +314,30 @@ prometheus "prom" {
 				checks.LabelsConflictCheckName + "(prom)",
 				checks.AlertsExternalLabelsCheckName + "(prom)",
 				checks.CounterCheckName + "(prom)",
+				checks.DerivCheckName + "(prom)",
+				checks.SumOverTimeCheckName + "(prom)",
+				checks.MaxOverTimeCheckName + "(prom)",
+				checks.CrossServerCheckName + "(prom)",
+				checks.RateWindowCheckName + "(prom)",
+				checks.ResetsCheckName + "(prom)",
+				checks.AvgOverTimeCheckName + "(prom)",
+				checks.RoundingCheckName + "(prom)",
+				checks.ForRetentionCheckName + "(prom)",
+				checks.LabelJoinCheckName + "(prom)",
+				checks.CardinalityDeltaCheckName + "(prom)",
+				checks.StddevCheckName + "(prom)",
+				checks.RateExactIntervalCheckName + "(prom)",
+				checks.HistogramTypeCheckName + "(prom)",
+				checks.AvgCounterCheckName + "(prom)",
+				checks.GroupIntervalCheckName + "(prom)",
+				checks.AnnotationLabelCheckName + "(prom)",
+				checks.VersionCompatibilityCheckName + "(prom)",
+				checks.IncreaseIntervalCheckName + "(prom)",
+				checks.SumOverTimeWindowCheckName + "(prom)",
+				checks.WithoutLabelCheckName + "(prom)",
+				checks.EmptyMatcherCheckName + "(prom)",
+				checks.OrLabelMismatchCheckName + "(prom)",
+				checks.OverTimeSingleSampleCheckName + "(prom)",
 			},
 		},
 		{
@@ -227,7 +363,37 @@ prometheus "prom" {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RateCheckName + "(prom)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RateCheckName + "(prom)",
 				checks.SeriesCheckName + "(prom)",
 				checks.VectorMatchingCheckName + "(prom)",
 				checks.RangeQueryCheckName + "(prom)",
@@ -235,6 +401,30 @@ prometheus "prom" {
 				checks.LabelsConflictCheckName + "(prom)",
 				checks.AlertsExternalLabelsCheckName + "(prom)",
 				checks.CounterCheckName + "(prom)",
+				checks.DerivCheckName + "(prom)",
+				checks.SumOverTimeCheckName + "(prom)",
+				checks.MaxOverTimeCheckName + "(prom)",
+				checks.CrossServerCheckName + "(prom)",
+				checks.RateWindowCheckName + "(prom)",
+				checks.ResetsCheckName + "(prom)",
+				checks.AvgOverTimeCheckName + "(prom)",
+				checks.RoundingCheckName + "(prom)",
+				checks.ForRetentionCheckName + "(prom)",
+				checks.LabelJoinCheckName + "(prom)",
+				checks.CardinalityDeltaCheckName + "(prom)",
+				checks.StddevCheckName + "(prom)",
+				checks.RateExactIntervalCheckName + "(prom)",
+				checks.HistogramTypeCheckName + "(prom)",
+				checks.AvgCounterCheckName + "(prom)",
+				checks.GroupIntervalCheckName + "(prom)",
+				checks.AnnotationLabelCheckName + "(prom)",
+				checks.VersionCompatibilityCheckName + "(prom)",
+				checks.IncreaseIntervalCheckName + "(prom)",
+				checks.SumOverTimeWindowCheckName + "(prom)",
+				checks.WithoutLabelCheckName + "(prom)",
+				checks.EmptyMatcherCheckName + "(prom)",
+				checks.OrLabelMismatchCheckName + "(prom)",
+				checks.OverTimeSingleSampleCheckName + "(prom)",
 			},
 		},
 		{
@@ -260,6 +450,38 @@ checks {
 				},
 				Rule: newRule(t, `
 # pint disable promql/counter
+# pint disable promql/deriv
+# pint disable promql/sum_over_time
+# pint disable promql/max_over_time
+# pint disable alerts/for_format
+# pint disable rule/name_conflict
+# pint disable promql/scalar
+# pint disable promql/clamp
+# pint disable rule/cross_server
+# pint disable promql/rate_window
+# pint disable promql/resets
+# pint disable promql/avg_over_time
+# pint disable promql/rounding
+# pint disable alerts/for_retention
+# pint disable promql/label_join
+# pint disable promql/cardinality_delta
+# pint disable promql/stddev
+# pint disable promql/count_positive
+# pint disable promql/rate_exact_interval
+# pint disable promql/histogram_type
+# pint disable promql/resets_window
+# pint disable promql/avg_counter
+# pint disable rule/group_interval
+# pint disable alerts/annotation_label
+# pint disable promql/version_compatibility
+# pint disable promql/increase_interval
+# pint disable promql/avg_over_time_reset
+# pint disable promql/sum_over_time_window
+# pint disable promql/without_label
+# pint disable promql/empty_matcher
+# pint disable alerts/or_labels
+# pint disable promql/over_time_window
+# pint disable promql/logarithm
 # pint disable promql/rate
 # pint disable promql/series
 # pint disable promql/vector_matching
@@ -276,6 +498,35 @@ checks {
 				checks.ComparisonCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -302,6 +553,35 @@ prometheus "prom" {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -329,6 +609,35 @@ prometheus "prom" {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -355,6 +664,35 @@ prometheus "prom" {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -380,7 +718,37 @@ prometheus "prom" {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RateCheckName + "(prom)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RateCheckName + "(prom)",
 				checks.SeriesCheckName + "(prom)",
 				checks.VectorMatchingCheckName + "(prom)",
 				checks.RangeQueryCheckName + "(prom)",
@@ -388,6 +756,30 @@ prometheus "prom" {
 				checks.LabelsConflictCheckName + "(prom)",
 				checks.AlertsExternalLabelsCheckName + "(prom)",
 				checks.CounterCheckName + "(prom)",
+				checks.DerivCheckName + "(prom)",
+				checks.SumOverTimeCheckName + "(prom)",
+				checks.MaxOverTimeCheckName + "(prom)",
+				checks.CrossServerCheckName + "(prom)",
+				checks.RateWindowCheckName + "(prom)",
+				checks.ResetsCheckName + "(prom)",
+				checks.AvgOverTimeCheckName + "(prom)",
+				checks.RoundingCheckName + "(prom)",
+				checks.ForRetentionCheckName + "(prom)",
+				checks.LabelJoinCheckName + "(prom)",
+				checks.CardinalityDeltaCheckName + "(prom)",
+				checks.StddevCheckName + "(prom)",
+				checks.RateExactIntervalCheckName + "(prom)",
+				checks.HistogramTypeCheckName + "(prom)",
+				checks.AvgCounterCheckName + "(prom)",
+				checks.GroupIntervalCheckName + "(prom)",
+				checks.AnnotationLabelCheckName + "(prom)",
+				checks.VersionCompatibilityCheckName + "(prom)",
+				checks.IncreaseIntervalCheckName + "(prom)",
+				checks.SumOverTimeWindowCheckName + "(prom)",
+				checks.WithoutLabelCheckName + "(prom)",
+				checks.EmptyMatcherCheckName + "(prom)",
+				checks.OrLabelMismatchCheckName + "(prom)",
+				checks.OverTimeSingleSampleCheckName + "(prom)",
 			},
 		},
 		{
@@ -418,7 +810,37 @@ prometheus "ignore" {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RateCheckName + "(prom)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RateCheckName + "(prom)",
 				checks.SeriesCheckName + "(prom)",
 				checks.VectorMatchingCheckName + "(prom)",
 				checks.RangeQueryCheckName + "(prom)",
@@ -426,6 +848,30 @@ prometheus "ignore" {
 				checks.LabelsConflictCheckName + "(prom)",
 				checks.AlertsExternalLabelsCheckName + "(prom)",
 				checks.CounterCheckName + "(prom)",
+				checks.DerivCheckName + "(prom)",
+				checks.SumOverTimeCheckName + "(prom)",
+				checks.MaxOverTimeCheckName + "(prom)",
+				checks.CrossServerCheckName + "(prom)",
+				checks.RateWindowCheckName + "(prom)",
+				checks.ResetsCheckName + "(prom)",
+				checks.AvgOverTimeCheckName + "(prom)",
+				checks.RoundingCheckName + "(prom)",
+				checks.ForRetentionCheckName + "(prom)",
+				checks.LabelJoinCheckName + "(prom)",
+				checks.CardinalityDeltaCheckName + "(prom)",
+				checks.StddevCheckName + "(prom)",
+				checks.RateExactIntervalCheckName + "(prom)",
+				checks.HistogramTypeCheckName + "(prom)",
+				checks.AvgCounterCheckName + "(prom)",
+				checks.GroupIntervalCheckName + "(prom)",
+				checks.AnnotationLabelCheckName + "(prom)",
+				checks.VersionCompatibilityCheckName + "(prom)",
+				checks.IncreaseIntervalCheckName + "(prom)",
+				checks.SumOverTimeWindowCheckName + "(prom)",
+				checks.WithoutLabelCheckName + "(prom)",
+				checks.EmptyMatcherCheckName + "(prom)",
+				checks.OrLabelMismatchCheckName + "(prom)",
+				checks.OverTimeSingleSampleCheckName + "(prom)",
 			},
 		},
 		{
@@ -446,6 +892,35 @@ prometheus "ignore" {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -475,7 +950,37 @@ rule {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.AggregationCheckName + "(job:true)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.AggregationCheckName + "(job:true)",
 				checks.AggregationCheckName + "(instance:false)",
 				checks.AggregationCheckName + "(rack:false)",
 			},
@@ -512,7 +1017,37 @@ rule {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.AggregationCheckName + "(job:true)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.AggregationCheckName + "(job:true)",
 				checks.AggregationCheckName + "(rack:false)",
 			},
 		},
@@ -542,6 +1077,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -577,6 +1141,38 @@ prometheus "prom2" {
   # pint disable labels/conflict(prom2)
   # pint disable alerts/external_labels(prom2)
   # pint disable promql/counter(prom1)
+  # pint disable promql/deriv(prom1)
+  # pint disable promql/sum_over_time(prom1)
+  # pint disable promql/max_over_time(prom1)
+  # pint disable alerts/for_format(prom1)
+  # pint disable rule/name_conflict(prom1)
+  # pint disable promql/scalar(prom1)
+  # pint disable promql/clamp(prom1)
+  # pint disable rule/cross_server(prom1)
+  # pint disable promql/rate_window(prom1)
+  # pint disable promql/resets(prom1)
+  # pint disable promql/avg_over_time(prom1)
+  # pint disable promql/rounding(prom1)
+  # pint disable alerts/for_retention(prom1)
+  # pint disable promql/label_join(prom1)
+  # pint disable promql/cardinality_delta(prom1)
+  # pint disable promql/stddev(prom1)
+  # pint disable promql/count_positive(prom1)
+  # pint disable promql/rate_exact_interval(prom1)
+  # pint disable promql/histogram_type(prom1)
+  # pint disable promql/resets_window(prom1)
+  # pint disable promql/avg_counter(prom1)
+  # pint disable rule/group_interval(prom1)
+  # pint disable alerts/annotation_label(prom1)
+  # pint disable promql/version_compatibility(prom1)
+  # pint disable promql/increase_interval(prom1)
+  # pint disable promql/avg_over_time_reset(prom1)
+  # pint disable promql/sum_over_time_window(prom1)
+  # pint disable promql/without_label(prom1)
+  # pint disable promql/empty_matcher(prom1)
+  # pint disable alerts/or_labels(prom1)
+  # pint disable promql/over_time_window(prom1)
+  # pint disable promql/logarithm(prom1)
   expr: sum(foo)
 `),
 			},
@@ -587,6 +1183,35 @@ prometheus "prom2" {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 				checks.RateCheckName + "(prom1)",
 				checks.RangeQueryCheckName + "(prom1)",
 				checks.LabelsConflictCheckName + "(prom1)",
@@ -596,6 +1221,30 @@ prometheus "prom2" {
 				checks.RangeQueryCheckName + "(prom2)",
 				checks.RuleDuplicateCheckName + "(prom2)",
 				checks.CounterCheckName + "(prom2)",
+				checks.DerivCheckName + "(prom2)",
+				checks.SumOverTimeCheckName + "(prom2)",
+				checks.MaxOverTimeCheckName + "(prom2)",
+				checks.CrossServerCheckName + "(prom2)",
+				checks.RateWindowCheckName + "(prom2)",
+				checks.ResetsCheckName + "(prom2)",
+				checks.AvgOverTimeCheckName + "(prom2)",
+				checks.RoundingCheckName + "(prom2)",
+				checks.ForRetentionCheckName + "(prom2)",
+				checks.LabelJoinCheckName + "(prom2)",
+				checks.CardinalityDeltaCheckName + "(prom2)",
+				checks.StddevCheckName + "(prom2)",
+				checks.RateExactIntervalCheckName + "(prom2)",
+				checks.HistogramTypeCheckName + "(prom2)",
+				checks.AvgCounterCheckName + "(prom2)",
+				checks.GroupIntervalCheckName + "(prom2)",
+				checks.AnnotationLabelCheckName + "(prom2)",
+				checks.VersionCompatibilityCheckName + "(prom2)",
+				checks.IncreaseIntervalCheckName + "(prom2)",
+				checks.SumOverTimeWindowCheckName + "(prom2)",
+				checks.WithoutLabelCheckName + "(prom2)",
+				checks.EmptyMatcherCheckName + "(prom2)",
+				checks.OrLabelMismatchCheckName + "(prom2)",
+				checks.OverTimeSingleSampleCheckName + "(prom2)",
 				checks.CostCheckName + "(prom1)",
 			},
 		},
@@ -656,7 +1305,37 @@ rule {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.LabelCheckName + "(team:true)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.LabelCheckName + "(team:true)",
 				checks.AnnotationCheckName + "(summary:true)",
 				checks.LabelCheckName + "(team:false)",
 				checks.AnnotationCheckName + "(summary=~^foo.+$:true)",
@@ -702,6 +1381,38 @@ rule {
 				},
 				Rule: newRule(t, `
 # pint disable promql/counter
+# pint disable promql/deriv
+# pint disable promql/sum_over_time
+# pint disable promql/max_over_time
+# pint disable alerts/for_format
+# pint disable rule/name_conflict
+# pint disable promql/scalar
+# pint disable promql/clamp
+# pint disable rule/cross_server
+# pint disable promql/rate_window
+# pint disable promql/resets
+# pint disable promql/avg_over_time
+# pint disable promql/rounding
+# pint disable alerts/for_retention
+# pint disable promql/label_join
+# pint disable promql/cardinality_delta
+# pint disable promql/stddev
+# pint disable promql/count_positive
+# pint disable promql/rate_exact_interval
+# pint disable promql/histogram_type
+# pint disable promql/resets_window
+# pint disable promql/avg_counter
+# pint disable rule/group_interval
+# pint disable alerts/annotation_label
+# pint disable promql/version_compatibility
+# pint disable promql/increase_interval
+# pint disable promql/avg_over_time_reset
+# pint disable promql/sum_over_time_window
+# pint disable promql/without_label
+# pint disable promql/empty_matcher
+# pint disable alerts/or_labels
+# pint disable promql/over_time_window
+# pint disable promql/logarithm
 # pint disable promql/series
 # pint disable promql/rate
 # pint disable promql/vector_matching(prom1)
@@ -713,6 +1424,34 @@ rule {
 - record: foo
   # pint disable promql/fragile
   # pint disable promql/regexp
+  # pint disable alerts/threshold
+  # pint disable promql/timestamp
+  # pint disable alerts/for_order
+  # pint disable promql/sort
+  # pint disable alerts/two_phase
+  # pint disable promql/scalar_comparison
+  # pint disable promql/quantile
+  # pint disable promql/unless
+  # pint disable promql/duplicate_selector
+  # pint disable alerts/for_offset
+  # pint disable promql/count_values
+  # pint disable alerts/vector_literal
+  # pint disable rule/duplicate_expr
+  # pint disable rule/eval_order
+  # pint disable promql/up_proxy
+  # pint disable promql/regex_efficiency
+  # pint disable promql/time
+  # pint disable promql/complement_selector
+  # pint disable rule/histogram_completeness
+  # pint disable promql/holt_winters
+  # pint disable promql/idelta
+  # pint disable promql/empty_on
+  # pint disable alerts/window_for_alignment
+  # pint disable rule/identity
+  # pint disable promql/date_time
+  # pint disable alerts/duplicate_condition
+  # pint disable rule/same_group_record
+  # pint disable promql/series_disable
   expr: sum(foo)
 `),
 			},
@@ -721,6 +1460,7 @@ rule {
 				checks.AlertForCheckName,
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
+				checks.AlertNameCheckName,
 				checks.CostCheckName + "(prom1)",
 				checks.CostCheckName + "(prom2)",
 				checks.CostCheckName + "(prom1:10000)",
@@ -763,7 +1503,37 @@ rule {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RejectCheckName + "(key=~'^http://.+$')",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RejectCheckName + "(key=~'^http://.+$')",
 				checks.RejectCheckName + "(val=~'^http://.+$')",
 				checks.RejectCheckName + "(key=~'^.* +.*$')",
 				checks.RejectCheckName + "(val=~'^$')",
@@ -801,6 +1571,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -835,6 +1634,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -869,6 +1697,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -902,7 +1759,37 @@ rule {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.LabelCheckName + "(priority=~^(1|2|3|4|5)$:true)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.LabelCheckName + "(priority=~^(1|2|3|4|5)$:true)",
 			},
 		},
 		{
@@ -937,6 +1824,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -971,6 +1887,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -1006,7 +1951,37 @@ rule {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.LabelCheckName + "(priority=~^(1|2|3|4|5)$:true)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.LabelCheckName + "(priority=~^(1|2|3|4|5)$:true)",
 			},
 		},
 		{
@@ -1022,6 +1997,38 @@ rule {
 checks {
   disabled = [
 	"promql/counter",
+	"promql/deriv",
+	"promql/sum_over_time",
+	"promql/max_over_time",
+	"alerts/for_format",
+	"rule/name_conflict",
+	"promql/scalar",
+	"promql/clamp",
+	"rule/cross_server",
+	"promql/rate_window",
+	"promql/resets",
+	"promql/avg_over_time",
+	"promql/rounding",
+	"alerts/for_retention",
+	"promql/label_join",
+	"promql/cardinality_delta",
+	"promql/stddev",
+	"promql/count_positive",
+	"promql/rate_exact_interval",
+	"promql/histogram_type",
+	"promql/resets_window",
+	"promql/avg_counter",
+	"rule/group_interval",
+	"alerts/annotation_label",
+	"promql/version_compatibility",
+	"promql/increase_interval",
+	"promql/avg_over_time_reset",
+	"promql/sum_over_time_window",
+	"promql/without_label",
+	"promql/empty_matcher",
+	"alerts/or_labels",
+	"promql/over_time_window",
+	"promql/logarithm",
     "promql/rate",
 	"promql/vector_matching",
 	"promql/range_query",
@@ -1054,6 +2061,35 @@ prometheus "prom1" {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 				checks.AlertsExternalLabelsCheckName + "(prom1)",
 				checks.AlertsCheckName + "(prom1)",
 			},
@@ -1095,6 +2131,35 @@ prometheus "prom1" {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 				checks.RateCheckName + "(prom1)",
 				checks.SeriesCheckName + "(prom1)",
 				checks.VectorMatchingCheckName + "(prom1)",
@@ -1103,6 +2168,30 @@ prometheus "prom1" {
 				checks.LabelsConflictCheckName + "(prom1)",
 				checks.AlertsExternalLabelsCheckName + "(prom1)",
 				checks.CounterCheckName + "(prom1)",
+				checks.DerivCheckName + "(prom1)",
+				checks.SumOverTimeCheckName + "(prom1)",
+				checks.MaxOverTimeCheckName + "(prom1)",
+				checks.CrossServerCheckName + "(prom1)",
+				checks.RateWindowCheckName + "(prom1)",
+				checks.ResetsCheckName + "(prom1)",
+				checks.AvgOverTimeCheckName + "(prom1)",
+				checks.RoundingCheckName + "(prom1)",
+				checks.ForRetentionCheckName + "(prom1)",
+				checks.LabelJoinCheckName + "(prom1)",
+				checks.CardinalityDeltaCheckName + "(prom1)",
+				checks.StddevCheckName + "(prom1)",
+				checks.RateExactIntervalCheckName + "(prom1)",
+				checks.HistogramTypeCheckName + "(prom1)",
+				checks.AvgCounterCheckName + "(prom1)",
+				checks.GroupIntervalCheckName + "(prom1)",
+				checks.AnnotationLabelCheckName + "(prom1)",
+				checks.VersionCompatibilityCheckName + "(prom1)",
+				checks.IncreaseIntervalCheckName + "(prom1)",
+				checks.SumOverTimeWindowCheckName + "(prom1)",
+				checks.WithoutLabelCheckName + "(prom1)",
+				checks.EmptyMatcherCheckName + "(prom1)",
+				checks.OrLabelMismatchCheckName + "(prom1)",
+				checks.OverTimeSingleSampleCheckName + "(prom1)",
 				checks.AlertsCheckName + "(prom1)",
 			},
 		},
@@ -1144,6 +2233,498 @@ prometheus "prom1" {
 				checks.AlertsCheckName + "(prom1)",
 			},
 		},
+		{
+			title: "absent check enabled via check block",
+			config: `
+check "promql/absent" {}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/absent",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- alert: foo
+  expr: absent(up)
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.AbsentAlwaysPresentCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "consistency check enabled via check block",
+			config: `
+check "labels/consistency" {
+  range = "3d"
+  step  = "10m"
+}
+checks {
+  enabled = [
+    "promql/syntax",
+	"labels/consistency",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+prometheus "prom2" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo
+  expr: sum(foo)
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.LabelsConsistencyCheckName + "(prom1)",
+				checks.LabelsConsistencyCheckName + "(prom2)",
+			},
+		},
+		{
+			title: "scalar check enabled via check block",
+			config: `
+check "promql/scalar" {}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/scalar",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo
+  expr: scalar(foo)
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.ScalarCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "clamp check enabled via check block",
+			config: `
+check "promql/clamp" {}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/clamp",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo
+  expr: clamp_min(foo, 0)
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.ClampCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "name conflict check enabled via check block",
+			config: `
+check "rule/name_conflict" {}
+checks {
+  enabled = [
+    "promql/syntax",
+    "rule/name_conflict",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo_total
+  expr: sum(foo)
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.NamingConflictCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "avg_over_time_reset check enabled via check block",
+			config: `
+check "promql/avg_over_time_reset" {}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/avg_over_time_reset",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo
+  expr: avg_over_time(foo[1h])
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.AvgOverTimeResetCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "logarithm check enabled via check block",
+			config: `
+check "promql/logarithm" {}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/logarithm",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo
+  expr: ln(foo)
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.LogExpCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "count_positive check enabled via check block",
+			config: `
+check "promql/count_positive" {}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/count_positive",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- alert: foo
+  expr: count(foo) == 0
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.CountAlwaysPositiveCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "resets_window check enabled via check block",
+			config: `
+check "promql/resets_window" {}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/resets_window",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo
+  expr: resets(foo[5m])
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.ResetsWindowCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "for_format check enabled via check block",
+			config: `
+check "alerts/for_format" {
+  weeksMinVersion = "2.10.0"
+}
+checks {
+  enabled = [
+    "promql/syntax",
+    "alerts/for_format",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include =[ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- alert: foo
+  expr: up == 0
+  for: 1w
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.AlertsForFormatCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "inhibit check enabled via check block",
+			config: `
+check "alerts/inhibition" {
+  inhibit {
+    sourceMatchers = ["alertname=ClusterDown"]
+    targetMatchers = ["severity=\"warning\""]
+    equal          = ["cluster", "namespace"]
+  }
+}
+checks {
+  enabled = [
+    "promql/syntax",
+	"alerts/inhibition",
+  ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- alert: foo
+  expr: sum(foo) > 0
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.InhibitionCheckName,
+			},
+		},
+		{
+			title: "internal metrics check enabled via check block",
+			config: `
+check "promql/internal" {
+  prefixes = ["prometheus_", "alertmanager_"]
+}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/internal",
+  ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo
+  expr: sum(prometheus_tsdb_head_series)
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.PrometheusInternalMetricCheckName + "(prometheus_,alertmanager_)",
+			},
+		},
+		{
+			title: "high cardinality check enabled via check block",
+			config: `
+check "promql/high_cardinality" {
+  labels = ["pod", "container"]
+}
+checks {
+  enabled = [
+    "promql/syntax",
+    "promql/high_cardinality",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include = [ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- record: foo
+  expr: sum(foo) without(instance)
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.HighCardinalityCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "business hours check enabled via check block",
+			config: `
+check "alerts/business_hours" {
+  timezone = "UTC"
+}
+checks {
+  enabled = [
+    "promql/syntax",
+    "alerts/business_hours",
+  ]
+}
+prometheus "prom1" {
+  uri     = "http://localhost"
+  timeout = "1s"
+  include = [ "rules.yml" ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- alert: foo
+  expr: sum(foo) > 0
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.BusinessHoursCheckName + "(prom1)",
+			},
+		},
+		{
+			title: "detection latency check enabled via check block",
+			config: `
+check "alerts/detection_latency" {
+  max = "15m"
+}
+checks {
+  enabled = [
+    "promql/syntax",
+    "alerts/detection_latency",
+  ]
+}
+`,
+			entry: discovery.Entry{
+				State: discovery.Modified,
+				Path: discovery.Path{
+					Name:          "rules.yml",
+					SymlinkTarget: "rules.yml",
+				},
+				Rule: newRule(t, `
+- alert: foo
+  expr: sum(foo) > 0
+  for: 5m
+`),
+			},
+			checks: []string{
+				checks.SyntaxCheckName,
+				checks.DetectionLatencyCheckName,
+			},
+		},
 		{
 			title: "rule with ignore block / mismatch",
 			config: `
@@ -1252,6 +2833,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 				checks.AnnotationCheckName + "(summary:true)",
 			},
 		},
@@ -1283,6 +2893,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -1312,6 +2951,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 				checks.AnnotationCheckName + "(summary:true)",
 			},
 		},
@@ -1342,6 +3010,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -1371,6 +3068,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -1400,6 +3126,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -1429,6 +3184,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 				checks.AnnotationCheckName + "(summary:true)",
 			},
 		},
@@ -1460,6 +3244,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
 		},
 		{
@@ -1489,6 +3302,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 				checks.AnnotationCheckName + "(summary:true)",
 			},
 		},
@@ -1522,6 +3364,35 @@ rule {
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 				checks.RuleLinkCheckName + "(^https?://(.+)$)",
 			},
 		},
@@ -1561,8 +3432,37 @@ checks {
 				checks.ComparisonCheckName,
 				checks.FragileCheckName,
 				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
 			},
-			disabledChecks: []string{"promql/rate", "promql/vector_matching", "rule/duplicate", "labels/conflict", "promql/counter"},
+			disabledChecks: []string{"promql/rate", "promql/vector_matching", "rule/duplicate", "labels/conflict", "promql/counter", "promql/deriv", "promql/sum_over_time", "promql/max_over_time", "alerts/for_format", "rule/name_conflict", "promql/scalar", "promql/clamp", "rule/cross_server", "promql/rate_window", "promql/resets", "promql/avg_over_time", "promql/rounding", "alerts/for_retention", "promql/label_join", "promql/cardinality_delta", "promql/stddev", "promql/count_positive", "promql/rate_exact_interval", "promql/histogram_type", "promql/resets_window", "promql/avg_counter", "rule/group_interval", "alerts/annotation_label", "promql/version_compatibility", "promql/increase_interval", "promql/avg_over_time_reset", "promql/sum_over_time_window", "promql/without_label", "promql/empty_matcher", "alerts/or_labels", "promql/over_time_window", "promql/logarithm"},
 		},
 		{
 			title: "two prometheus servers / snoozed checks via comment",
@@ -1576,7 +3476,7 @@ prometheus "prom2" {
   timeout = "1s"
 }
 checks {
-  disabled = [ "alerts/template", "promql/regexp" ]
+  disabled = [ "alerts/template", "promql/regexp", "alerts/threshold", "promql/timestamp", "alerts/for_order", "promql/sort", "alerts/two_phase", "promql/scalar_comparison", "promql/quantile", "promql/unless", "promql/duplicate_selector", "alerts/for_offset", "promql/count_values", "alerts/vector_literal", "rule/duplicate_expr", "rule/eval_order", "promql/up_proxy", "promql/regex_efficiency", "promql/time", "promql/complement_selector", "rule/histogram_completeness", "promql/holt_winters", "promql/idelta", "promql/empty_on", "alerts/window_for_alignment", "rule/identity", "promql/date_time", "alerts/duplicate_condition", "rule/same_group_record", "promql/series_disable" ]
 }
 `,
 			entry: discovery.Entry{
@@ -1594,6 +3494,38 @@ checks {
 # pint snooze 2099-11-28 rule/duplicate
 # pint snooze 2099-11-28T00:00:00+00:00 promql/vector_matching
 # pint snooze 2099-11-28 promql/counter
+# pint snooze 2099-11-28 promql/deriv
+# pint snooze 2099-11-28 promql/sum_over_time
+# pint snooze 2099-11-28 promql/max_over_time
+# pint snooze 2099-11-28 alerts/for_format
+# pint snooze 2099-11-28 rule/name_conflict
+# pint snooze 2099-11-28 promql/scalar
+# pint snooze 2099-11-28 promql/clamp
+# pint snooze 2099-11-28 rule/cross_server
+# pint snooze 2099-11-28 promql/rate_window
+# pint snooze 2099-11-28 promql/resets
+# pint snooze 2099-11-28 promql/avg_over_time
+# pint snooze 2099-11-28 promql/rounding
+# pint snooze 2099-11-28 alerts/for_retention
+# pint snooze 2099-11-28 promql/label_join
+# pint snooze 2099-11-28 promql/cardinality_delta
+# pint snooze 2099-11-28 promql/stddev
+# pint snooze 2099-11-28 promql/count_positive
+# pint snooze 2099-11-28 promql/rate_exact_interval
+# pint snooze 2099-11-28 promql/histogram_type
+# pint snooze 2099-11-28 promql/resets_window
+# pint snooze 2099-11-28 promql/avg_counter
+# pint snooze 2099-11-28 rule/group_interval
+# pint snooze 2099-11-28 alerts/annotation_label
+# pint snooze 2099-11-28 promql/version_compatibility
+# pint snooze 2099-11-28 promql/increase_interval
+# pint snooze 2099-11-28 promql/avg_over_time_reset
+# pint snooze 2099-11-28 promql/sum_over_time_window
+# pint snooze 2099-11-28 promql/without_label
+# pint snooze 2099-11-28 promql/empty_matcher
+# pint snooze 2099-11-28 alerts/or_labels
+# pint snooze 2099-11-28 promql/over_time_window
+# pint snooze 2099-11-28 promql/logarithm
 - record: foo
   expr: sum(foo)
 # pint file/disable promql/vector_matching
@@ -1604,6 +3536,7 @@ checks {
 				checks.AlertForCheckName,
 				checks.ComparisonCheckName,
 				checks.FragileCheckName,
+				checks.AlertNameCheckName,
 				checks.LabelsConflictCheckName + "(prom1)",
 				checks.AlertsExternalLabelsCheckName + "(prom1)",
 				checks.SeriesCheckName + "(prom2)",
@@ -1624,7 +3557,7 @@ prometheus "prom2" {
   timeout = "1s"
 }
 checks {
-  disabled = [ "alerts/template", "promql/regexp" ]
+  disabled = [ "alerts/template", "promql/regexp", "alerts/threshold", "promql/timestamp", "alerts/for_order", "promql/sort", "alerts/two_phase", "promql/scalar_comparison", "promql/quantile", "promql/unless", "promql/duplicate_selector", "alerts/for_offset", "promql/count_values", "alerts/vector_literal", "rule/duplicate_expr", "rule/eval_order", "promql/up_proxy", "promql/regex_efficiency", "promql/time", "promql/complement_selector", "rule/histogram_completeness", "promql/holt_winters", "promql/idelta", "promql/empty_on", "alerts/window_for_alignment", "rule/identity", "promql/date_time", "alerts/duplicate_condition", "rule/same_group_record", "promql/series_disable" ]
 }
 `,
 			entry: discovery.Entry{
@@ -1648,6 +3581,7 @@ checks {
 				checks.AlertForCheckName,
 				checks.ComparisonCheckName,
 				checks.FragileCheckName,
+				checks.AlertNameCheckName,
 				checks.SeriesCheckName + "(prom1)",
 				checks.VectorMatchingCheckName + "(prom1)",
 				checks.RangeQueryCheckName + "(prom1)",
@@ -1655,6 +3589,30 @@ checks {
 				checks.LabelsConflictCheckName + "(prom1)",
 				checks.AlertsExternalLabelsCheckName + "(prom1)",
 				checks.CounterCheckName + "(prom1)",
+				checks.DerivCheckName + "(prom1)",
+				checks.SumOverTimeCheckName + "(prom1)",
+				checks.MaxOverTimeCheckName + "(prom1)",
+				checks.CrossServerCheckName + "(prom1)",
+				checks.RateWindowCheckName + "(prom1)",
+				checks.ResetsCheckName + "(prom1)",
+				checks.AvgOverTimeCheckName + "(prom1)",
+				checks.RoundingCheckName + "(prom1)",
+				checks.ForRetentionCheckName + "(prom1)",
+				checks.LabelJoinCheckName + "(prom1)",
+				checks.CardinalityDeltaCheckName + "(prom1)",
+				checks.StddevCheckName + "(prom1)",
+				checks.RateExactIntervalCheckName + "(prom1)",
+				checks.HistogramTypeCheckName + "(prom1)",
+				checks.AvgCounterCheckName + "(prom1)",
+				checks.GroupIntervalCheckName + "(prom1)",
+				checks.AnnotationLabelCheckName + "(prom1)",
+				checks.VersionCompatibilityCheckName + "(prom1)",
+				checks.IncreaseIntervalCheckName + "(prom1)",
+				checks.SumOverTimeWindowCheckName + "(prom1)",
+				checks.WithoutLabelCheckName + "(prom1)",
+				checks.EmptyMatcherCheckName + "(prom1)",
+				checks.OrLabelMismatchCheckName + "(prom1)",
+				checks.OverTimeSingleSampleCheckName + "(prom1)",
 				checks.SeriesCheckName + "(prom2)",
 				checks.VectorMatchingCheckName + "(prom2)",
 				checks.RangeQueryCheckName + "(prom2)",
@@ -1662,6 +3620,30 @@ checks {
 				checks.LabelsConflictCheckName + "(prom2)",
 				checks.AlertsExternalLabelsCheckName + "(prom2)",
 				checks.CounterCheckName + "(prom2)",
+				checks.DerivCheckName + "(prom2)",
+				checks.SumOverTimeCheckName + "(prom2)",
+				checks.MaxOverTimeCheckName + "(prom2)",
+				checks.CrossServerCheckName + "(prom2)",
+				checks.RateWindowCheckName + "(prom2)",
+				checks.ResetsCheckName + "(prom2)",
+				checks.AvgOverTimeCheckName + "(prom2)",
+				checks.RoundingCheckName + "(prom2)",
+				checks.ForRetentionCheckName + "(prom2)",
+				checks.LabelJoinCheckName + "(prom2)",
+				checks.CardinalityDeltaCheckName + "(prom2)",
+				checks.StddevCheckName + "(prom2)",
+				checks.RateExactIntervalCheckName + "(prom2)",
+				checks.HistogramTypeCheckName + "(prom2)",
+				checks.AvgCounterCheckName + "(prom2)",
+				checks.GroupIntervalCheckName + "(prom2)",
+				checks.AnnotationLabelCheckName + "(prom2)",
+				checks.VersionCompatibilityCheckName + "(prom2)",
+				checks.IncreaseIntervalCheckName + "(prom2)",
+				checks.SumOverTimeWindowCheckName + "(prom2)",
+				checks.WithoutLabelCheckName + "(prom2)",
+				checks.EmptyMatcherCheckName + "(prom2)",
+				checks.OrLabelMismatchCheckName + "(prom2)",
+				checks.OverTimeSingleSampleCheckName + "(prom2)",
 			},
 			disabledChecks: []string{"promql/rate"},
 		},
@@ -1692,8 +3674,68 @@ prometheus "prom3" {
 # pint disable alerts/external_labels(+disable)
 # pint disable labels/conflict(+disable)
 # pint disable promql/counter(+disable)
+# pint disable promql/deriv(+disable)
+# pint disable promql/sum_over_time(+disable)
+# pint disable promql/max_over_time(+disable)
+# pint disable alerts/for_format(+disable)
+# pint disable rule/name_conflict(+disable)
+# pint disable promql/scalar(+disable)
+# pint disable promql/clamp(+disable)
+# pint disable rule/cross_server(+disable)
+# pint disable promql/rate_window(+disable)
+# pint disable promql/resets(+disable)
+# pint disable promql/avg_over_time(+disable)
+# pint disable promql/rounding(+disable)
+# pint disable alerts/for_retention(+disable)
+# pint disable promql/label_join(+disable)
+# pint disable promql/cardinality_delta(+disable)
+# pint disable promql/stddev(+disable)
+# pint disable promql/count_positive(+disable)
+# pint disable promql/rate_exact_interval(+disable)
+# pint disable promql/histogram_type(+disable)
+# pint disable promql/resets_window(+disable)
+# pint disable promql/avg_counter(+disable)
+# pint disable rule/group_interval(+disable)
+# pint disable alerts/annotation_label(+disable)
+# pint disable promql/version_compatibility(+disable)
+# pint disable promql/increase_interval(+disable)
+# pint disable promql/avg_over_time_reset(+disable)
+# pint disable promql/sum_over_time_window(+disable)
+# pint disable promql/without_label(+disable)
+# pint disable promql/empty_matcher(+disable)
+# pint disable alerts/or_labels(+disable)
+# pint disable promql/over_time_window(+disable)
+# pint disable promql/logarithm(+disable)
 # pint disable promql/range_query(+disable)
 # pint disable promql/regexp(+disable)
+# pint disable alerts/threshold(+disable)
+# pint disable promql/timestamp(+disable)
+# pint disable alerts/for_order(+disable)
+# pint disable promql/sort(+disable)
+# pint disable alerts/two_phase(+disable)
+# pint disable promql/scalar_comparison(+disable)
+# pint disable promql/quantile(+disable)
+# pint disable promql/unless(+disable)
+# pint disable promql/duplicate_selector(+disable)
+# pint disable alerts/for_offset(+disable)
+# pint disable promql/count_values(+disable)
+# pint disable alerts/vector_literal(+disable)
+# pint disable rule/duplicate_expr(+disable)
+# pint disable rule/eval_order(+disable)
+# pint disable promql/up_proxy(+disable)
+# pint disable promql/regex_efficiency(+disable)
+# pint disable promql/time(+disable)
+# pint disable promql/complement_selector(+disable)
+# pint disable rule/histogram_completeness(+disable)
+# pint disable promql/holt_winters(+disable)
+# pint disable promql/idelta(+disable)
+# pint disable promql/empty_on(+disable)
+# pint disable alerts/window_for_alignment(+disable)
+# pint disable rule/identity(+disable)
+# pint disable promql/date_time(+disable)
+# pint disable alerts/duplicate_condition(+disable)
+# pint disable rule/same_group_record(+disable)
+# pint disable promql/series_disable(+disable)
 # pint disable promql/series(+disable)
 # pint disable promql/rate(+disable)
 # pint disable promql/vector_matching(+disable)
@@ -1708,7 +3750,37 @@ prometheus "prom3" {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RateCheckName + "(prom2)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RateCheckName + "(prom2)",
 				checks.SeriesCheckName + "(prom2)",
 				checks.VectorMatchingCheckName + "(prom2)",
 				checks.RangeQueryCheckName + "(prom2)",
@@ -1716,6 +3788,30 @@ prometheus "prom3" {
 				checks.LabelsConflictCheckName + "(prom2)",
 				checks.AlertsExternalLabelsCheckName + "(prom2)",
 				checks.CounterCheckName + "(prom2)",
+				checks.DerivCheckName + "(prom2)",
+				checks.SumOverTimeCheckName + "(prom2)",
+				checks.MaxOverTimeCheckName + "(prom2)",
+				checks.CrossServerCheckName + "(prom2)",
+				checks.RateWindowCheckName + "(prom2)",
+				checks.ResetsCheckName + "(prom2)",
+				checks.AvgOverTimeCheckName + "(prom2)",
+				checks.RoundingCheckName + "(prom2)",
+				checks.ForRetentionCheckName + "(prom2)",
+				checks.LabelJoinCheckName + "(prom2)",
+				checks.CardinalityDeltaCheckName + "(prom2)",
+				checks.StddevCheckName + "(prom2)",
+				checks.RateExactIntervalCheckName + "(prom2)",
+				checks.HistogramTypeCheckName + "(prom2)",
+				checks.AvgCounterCheckName + "(prom2)",
+				checks.GroupIntervalCheckName + "(prom2)",
+				checks.AnnotationLabelCheckName + "(prom2)",
+				checks.VersionCompatibilityCheckName + "(prom2)",
+				checks.IncreaseIntervalCheckName + "(prom2)",
+				checks.SumOverTimeWindowCheckName + "(prom2)",
+				checks.WithoutLabelCheckName + "(prom2)",
+				checks.EmptyMatcherCheckName + "(prom2)",
+				checks.OrLabelMismatchCheckName + "(prom2)",
+				checks.OverTimeSingleSampleCheckName + "(prom2)",
 				checks.RateCheckName + "(prom3)",
 				checks.SeriesCheckName + "(prom3)",
 				checks.VectorMatchingCheckName + "(prom3)",
@@ -1724,6 +3820,30 @@ prometheus "prom3" {
 				checks.LabelsConflictCheckName + "(prom3)",
 				checks.AlertsExternalLabelsCheckName + "(prom3)",
 				checks.CounterCheckName + "(prom3)",
+				checks.DerivCheckName + "(prom3)",
+				checks.SumOverTimeCheckName + "(prom3)",
+				checks.MaxOverTimeCheckName + "(prom3)",
+				checks.CrossServerCheckName + "(prom3)",
+				checks.RateWindowCheckName + "(prom3)",
+				checks.ResetsCheckName + "(prom3)",
+				checks.AvgOverTimeCheckName + "(prom3)",
+				checks.RoundingCheckName + "(prom3)",
+				checks.ForRetentionCheckName + "(prom3)",
+				checks.LabelJoinCheckName + "(prom3)",
+				checks.CardinalityDeltaCheckName + "(prom3)",
+				checks.StddevCheckName + "(prom3)",
+				checks.RateExactIntervalCheckName + "(prom3)",
+				checks.HistogramTypeCheckName + "(prom3)",
+				checks.AvgCounterCheckName + "(prom3)",
+				checks.GroupIntervalCheckName + "(prom3)",
+				checks.AnnotationLabelCheckName + "(prom3)",
+				checks.VersionCompatibilityCheckName + "(prom3)",
+				checks.IncreaseIntervalCheckName + "(prom3)",
+				checks.SumOverTimeWindowCheckName + "(prom3)",
+				checks.WithoutLabelCheckName + "(prom3)",
+				checks.EmptyMatcherCheckName + "(prom3)",
+				checks.OrLabelMismatchCheckName + "(prom3)",
+				checks.OverTimeSingleSampleCheckName + "(prom3)",
 			},
 		},
 		{
@@ -1754,7 +3874,67 @@ prometheus "prom3" {
 # pint snooze 2099-11-28 labels/conflict(+disable)
 # pint snooze 2099-11-28 promql/range_query(+disable)
 # pint snooze 2099-11-28 promql/regexp(+disable)
+# pint snooze 2099-11-28 alerts/threshold(+disable)
+# pint snooze 2099-11-28 promql/timestamp(+disable)
+# pint snooze 2099-11-28 alerts/for_order(+disable)
+# pint snooze 2099-11-28 promql/sort(+disable)
+# pint snooze 2099-11-28 alerts/two_phase(+disable)
+# pint snooze 2099-11-28 promql/scalar_comparison(+disable)
+# pint snooze 2099-11-28 promql/quantile(+disable)
+# pint snooze 2099-11-28 promql/unless(+disable)
+# pint snooze 2099-11-28 promql/duplicate_selector(+disable)
+# pint snooze 2099-11-28 alerts/for_offset(+disable)
+# pint snooze 2099-11-28 promql/count_values(+disable)
+# pint snooze 2099-11-28 alerts/vector_literal(+disable)
+# pint snooze 2099-11-28 rule/duplicate_expr(+disable)
+# pint snooze 2099-11-28 rule/eval_order(+disable)
+# pint snooze 2099-11-28 promql/up_proxy(+disable)
+# pint snooze 2099-11-28 promql/regex_efficiency(+disable)
+# pint snooze 2099-11-28 promql/time(+disable)
+# pint snooze 2099-11-28 promql/complement_selector(+disable)
+# pint snooze 2099-11-28 rule/histogram_completeness(+disable)
+# pint snooze 2099-11-28 promql/holt_winters(+disable)
+# pint snooze 2099-11-28 promql/idelta(+disable)
+# pint snooze 2099-11-28 promql/empty_on(+disable)
+# pint snooze 2099-11-28 alerts/window_for_alignment(+disable)
+# pint snooze 2099-11-28 rule/identity(+disable)
+# pint snooze 2099-11-28 promql/date_time(+disable)
+# pint snooze 2099-11-28 alerts/duplicate_condition(+disable)
+# pint snooze 2099-11-28 rule/same_group_record(+disable)
+# pint snooze 2099-11-28 promql/series_disable(+disable)
 # pint snooze 2099-11-28 promql/counter(+disable)
+# pint snooze 2099-11-28 promql/deriv(+disable)
+# pint snooze 2099-11-28 promql/sum_over_time(+disable)
+# pint snooze 2099-11-28 promql/max_over_time(+disable)
+# pint snooze 2099-11-28 alerts/for_format(+disable)
+# pint snooze 2099-11-28 rule/name_conflict(+disable)
+# pint snooze 2099-11-28 promql/scalar(+disable)
+# pint snooze 2099-11-28 promql/clamp(+disable)
+# pint snooze 2099-11-28 rule/cross_server(+disable)
+# pint snooze 2099-11-28 promql/rate_window(+disable)
+# pint snooze 2099-11-28 promql/resets(+disable)
+# pint snooze 2099-11-28 promql/avg_over_time(+disable)
+# pint snooze 2099-11-28 promql/rounding(+disable)
+# pint snooze 2099-11-28 alerts/for_retention(+disable)
+# pint snooze 2099-11-28 promql/label_join(+disable)
+# pint snooze 2099-11-28 promql/cardinality_delta(+disable)
+# pint snooze 2099-11-28 promql/stddev(+disable)
+# pint snooze 2099-11-28 promql/count_positive(+disable)
+# pint snooze 2099-11-28 promql/rate_exact_interval(+disable)
+# pint snooze 2099-11-28 promql/histogram_type(+disable)
+# pint snooze 2099-11-28 promql/resets_window(+disable)
+# pint snooze 2099-11-28 promql/avg_counter(+disable)
+# pint snooze 2099-11-28 rule/group_interval(+disable)
+# pint snooze 2099-11-28 alerts/annotation_label(+disable)
+# pint snooze 2099-11-28 promql/version_compatibility(+disable)
+# pint snooze 2099-11-28 promql/increase_interval(+disable)
+# pint snooze 2099-11-28 promql/avg_over_time_reset(+disable)
+# pint snooze 2099-11-28 promql/sum_over_time_window(+disable)
+# pint snooze 2099-11-28 promql/without_label(+disable)
+# pint snooze 2099-11-28 promql/empty_matcher(+disable)
+# pint snooze 2099-11-28 alerts/or_labels(+disable)
+# pint snooze 2099-11-28 promql/over_time_window(+disable)
+# pint snooze 2099-11-28 promql/logarithm(+disable)
 # pint snooze 2099-11-28 promql/series(+disable)
 # pint snooze 2099-11-28 promql/rate(+disable)
 # pint snooze 2099-11-28 promql/vector_matching(+disable)
@@ -1769,7 +3949,37 @@ prometheus "prom3" {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RateCheckName + "(prom2)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RateCheckName + "(prom2)",
 				checks.SeriesCheckName + "(prom2)",
 				checks.VectorMatchingCheckName + "(prom2)",
 				checks.RangeQueryCheckName + "(prom2)",
@@ -1777,6 +3987,30 @@ prometheus "prom3" {
 				checks.LabelsConflictCheckName + "(prom2)",
 				checks.AlertsExternalLabelsCheckName + "(prom2)",
 				checks.CounterCheckName + "(prom2)",
+				checks.DerivCheckName + "(prom2)",
+				checks.SumOverTimeCheckName + "(prom2)",
+				checks.MaxOverTimeCheckName + "(prom2)",
+				checks.CrossServerCheckName + "(prom2)",
+				checks.RateWindowCheckName + "(prom2)",
+				checks.ResetsCheckName + "(prom2)",
+				checks.AvgOverTimeCheckName + "(prom2)",
+				checks.RoundingCheckName + "(prom2)",
+				checks.ForRetentionCheckName + "(prom2)",
+				checks.LabelJoinCheckName + "(prom2)",
+				checks.CardinalityDeltaCheckName + "(prom2)",
+				checks.StddevCheckName + "(prom2)",
+				checks.RateExactIntervalCheckName + "(prom2)",
+				checks.HistogramTypeCheckName + "(prom2)",
+				checks.AvgCounterCheckName + "(prom2)",
+				checks.GroupIntervalCheckName + "(prom2)",
+				checks.AnnotationLabelCheckName + "(prom2)",
+				checks.VersionCompatibilityCheckName + "(prom2)",
+				checks.IncreaseIntervalCheckName + "(prom2)",
+				checks.SumOverTimeWindowCheckName + "(prom2)",
+				checks.WithoutLabelCheckName + "(prom2)",
+				checks.EmptyMatcherCheckName + "(prom2)",
+				checks.OrLabelMismatchCheckName + "(prom2)",
+				checks.OverTimeSingleSampleCheckName + "(prom2)",
 				checks.RateCheckName + "(prom3)",
 				checks.SeriesCheckName + "(prom3)",
 				checks.VectorMatchingCheckName + "(prom3)",
@@ -1785,6 +4019,30 @@ prometheus "prom3" {
 				checks.LabelsConflictCheckName + "(prom3)",
 				checks.AlertsExternalLabelsCheckName + "(prom3)",
 				checks.CounterCheckName + "(prom3)",
+				checks.DerivCheckName + "(prom3)",
+				checks.SumOverTimeCheckName + "(prom3)",
+				checks.MaxOverTimeCheckName + "(prom3)",
+				checks.CrossServerCheckName + "(prom3)",
+				checks.RateWindowCheckName + "(prom3)",
+				checks.ResetsCheckName + "(prom3)",
+				checks.AvgOverTimeCheckName + "(prom3)",
+				checks.RoundingCheckName + "(prom3)",
+				checks.ForRetentionCheckName + "(prom3)",
+				checks.LabelJoinCheckName + "(prom3)",
+				checks.CardinalityDeltaCheckName + "(prom3)",
+				checks.StddevCheckName + "(prom3)",
+				checks.RateExactIntervalCheckName + "(prom3)",
+				checks.HistogramTypeCheckName + "(prom3)",
+				checks.AvgCounterCheckName + "(prom3)",
+				checks.GroupIntervalCheckName + "(prom3)",
+				checks.AnnotationLabelCheckName + "(prom3)",
+				checks.VersionCompatibilityCheckName + "(prom3)",
+				checks.IncreaseIntervalCheckName + "(prom3)",
+				checks.SumOverTimeWindowCheckName + "(prom3)",
+				checks.WithoutLabelCheckName + "(prom3)",
+				checks.EmptyMatcherCheckName + "(prom3)",
+				checks.OrLabelMismatchCheckName + "(prom3)",
+				checks.OverTimeSingleSampleCheckName + "(prom3)",
 			},
 		},
 		{
@@ -1817,7 +4075,37 @@ rule {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RateCheckName + "(prom)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RateCheckName + "(prom)",
 				checks.SeriesCheckName + "(prom)",
 				checks.VectorMatchingCheckName + "(prom)",
 				checks.RangeQueryCheckName + "(prom)",
@@ -1825,6 +4113,30 @@ rule {
 				checks.LabelsConflictCheckName + "(prom)",
 				checks.AlertsExternalLabelsCheckName + "(prom)",
 				checks.CounterCheckName + "(prom)",
+				checks.DerivCheckName + "(prom)",
+				checks.SumOverTimeCheckName + "(prom)",
+				checks.MaxOverTimeCheckName + "(prom)",
+				checks.CrossServerCheckName + "(prom)",
+				checks.RateWindowCheckName + "(prom)",
+				checks.ResetsCheckName + "(prom)",
+				checks.AvgOverTimeCheckName + "(prom)",
+				checks.RoundingCheckName + "(prom)",
+				checks.ForRetentionCheckName + "(prom)",
+				checks.LabelJoinCheckName + "(prom)",
+				checks.CardinalityDeltaCheckName + "(prom)",
+				checks.StddevCheckName + "(prom)",
+				checks.RateExactIntervalCheckName + "(prom)",
+				checks.HistogramTypeCheckName + "(prom)",
+				checks.AvgCounterCheckName + "(prom)",
+				checks.GroupIntervalCheckName + "(prom)",
+				checks.AnnotationLabelCheckName + "(prom)",
+				checks.VersionCompatibilityCheckName + "(prom)",
+				checks.IncreaseIntervalCheckName + "(prom)",
+				checks.SumOverTimeWindowCheckName + "(prom)",
+				checks.WithoutLabelCheckName + "(prom)",
+				checks.EmptyMatcherCheckName + "(prom)",
+				checks.OrLabelMismatchCheckName + "(prom)",
+				checks.OverTimeSingleSampleCheckName + "(prom)",
 				checks.AlertsCheckName + "(prom)",
 			},
 		},
@@ -1861,7 +4173,37 @@ rule {
 				checks.ComparisonCheckName,
 				checks.TemplateCheckName,
 				checks.FragileCheckName,
-				checks.RegexpCheckName, checks.RateCheckName + "(prom)",
+				checks.RegexpCheckName,
+				checks.ThresholdRecordingRuleCheckName,
+				checks.TimestampCheckName,
+				checks.ForDurationOrderCheckName,
+				checks.SortCheckName,
+				checks.TwoPhaseAlertCheckName,
+				checks.ScalarComparisonCheckName,
+				checks.QuantileCheckName,
+				checks.UnlessCheckName,
+				checks.DuplicateSelectorCheckName,
+				checks.ForOffsetCheckName,
+				checks.CountValuesCheckName,
+				checks.VectorLiteralCheckName,
+				checks.ExpressionDuplicateCheckName,
+				checks.EvalOrderCheckName,
+				checks.UpProxyCheckName,
+				checks.RegexEfficiencyCheckName,
+				checks.TimeCheckName,
+				checks.ComplementSelectorCheckName,
+				checks.HistogramCompletenessCheckName,
+				checks.HoltWintersCheckName,
+				checks.IdeltaCheckName,
+				checks.EmptyOnCheckName,
+				checks.WindowForAlignmentCheckName,
+				checks.IdentityRecordCheckName,
+				checks.DateTimeFunctionCheckName,
+				checks.AlertDuplicateConditionCheckName,
+				checks.SameGroupRecordCheckName,
+				checks.SeriesDisableCheckName,
+				checks.AlertNameCheckName,
+				checks.RateCheckName + "(prom)",
 				checks.SeriesCheckName + "(prom)",
 				checks.VectorMatchingCheckName + "(prom)",
 				checks.RangeQueryCheckName + "(prom)",
@@ -1869,6 +4211,30 @@ rule {
 				checks.LabelsConflictCheckName + "(prom)",
 				checks.AlertsExternalLabelsCheckName + "(prom)",
 				checks.CounterCheckName + "(prom)",
+				checks.DerivCheckName + "(prom)",
+				checks.SumOverTimeCheckName + "(prom)",
+				checks.MaxOverTimeCheckName + "(prom)",
+				checks.CrossServerCheckName + "(prom)",
+				checks.RateWindowCheckName + "(prom)",
+				checks.ResetsCheckName + "(prom)",
+				checks.AvgOverTimeCheckName + "(prom)",
+				checks.RoundingCheckName + "(prom)",
+				checks.ForRetentionCheckName + "(prom)",
+				checks.LabelJoinCheckName + "(prom)",
+				checks.CardinalityDeltaCheckName + "(prom)",
+				checks.StddevCheckName + "(prom)",
+				checks.RateExactIntervalCheckName + "(prom)",
+				checks.HistogramTypeCheckName + "(prom)",
+				checks.AvgCounterCheckName + "(prom)",
+				checks.GroupIntervalCheckName + "(prom)",
+				checks.AnnotationLabelCheckName + "(prom)",
+				checks.VersionCompatibilityCheckName + "(prom)",
+				checks.IncreaseIntervalCheckName + "(prom)",
+				checks.SumOverTimeWindowCheckName + "(prom)",
+				checks.WithoutLabelCheckName + "(prom)",
+				checks.EmptyMatcherCheckName + "(prom)",
+				checks.OrLabelMismatchCheckName + "(prom)",
+				checks.OverTimeSingleSampleCheckName + "(prom)",
 				checks.AlertsCheckName + "(prom)",
 			},
 		},
@@ -1891,7 +4257,10 @@ rule {
 			defer gen.Stop()
 			require.NoError(t, gen.GenerateStatic())
 
-			checks := cfg.GetChecksForRule(ctx, gen, tc.entry, tc.disabledChecks)
+			settings, err := cfg.CheckSettings()
+			require.NoError(t, err)
+
+			checks := cfg.GetChecksForRule(ctx, gen, tc.entry, settings, tc.disabledChecks)
 			checkNames := make([]string, 0, len(checks))
 			for _, c := range checks {
 				checkNames = append(checkNames, c.String())
@@ -2079,6 +4448,33 @@ func TestConfigErrors(t *testing.T) {
 }`,
 			err: "error parsing regexp: invalid nested repetition operator: `++`",
 		},
+		{
+			config: `check "alerts/inhibition" {}`,
+			err:    "at least one inhibit block is required",
+		},
+		{
+			config: `check "alerts/inhibition" {
+  inhibit {
+    equal = []
+  }
+}`,
+			err: "equal cannot be empty",
+		},
+		{
+			config: `check "alerts/inhibition" {
+  inhibit {
+    targetMatchers = ["severity"]
+    equal          = ["cluster"]
+  }
+}`,
+			err: "invalid targetMatchers value: bad matcher format: severity",
+		},
+		{
+			config: `check "promql/internal" {
+  prefixes = [""]
+}`,
+			err: "prefixes cannot contain empty values",
+		},
 		{
 			config: `check "bob" {}`,
 			err:    `unknown check "bob"`,
@@ -2091,6 +4487,61 @@ func TestConfigErrors(t *testing.T) {
 			config: `check "promql/series" { ignoreMetrics = [".+++"] }`,
 			err:    "error parsing regexp: invalid nested repetition operator: `++`",
 		},
+		{
+			config: `check "promql/series" { skip = ["(foo"] }`,
+			err:    "error parsing regexp: missing closing ): `^(foo$`",
+		},
+		{
+			config: `check "alerts/for_format" { weeksMinVersion = "2.x" }`,
+			err:    `invalid weeksMinVersion value: "2.x" is not a valid version`,
+		},
+		{
+			config: `check "alerts/name" { pattern = "(foo" }`,
+			err:    "invalid pattern value: error parsing regexp: missing closing ): `(foo`",
+		},
+		{
+			config: `check "rule/name_length" { maxLength = -5 }`,
+			err:    "maxLength cannot be negative",
+		},
+		{
+			config: `check "alerts/extract_opportunity" { minRules = 1 }`,
+			err:    "minRules must be at least 2",
+		},
+		{
+			config: `check "rule/name_convention" { pattern = "(foo" }`,
+			err:    "invalid pattern value: error parsing regexp: missing closing ): `(foo`",
+		},
+		{
+			config: `check "promql/cardinality_delta" { maxIncrease = -5 }`,
+			err:    "maxIncrease cannot be negative",
+		},
+		{
+			config: `check "alerts/routing" { uri = "" }`,
+			err:    "uri cannot be empty",
+		},
+		{
+			config: `check "alerts/notification_delay" {
+  uri      = "http://localhost"
+  maxDelay = "0s"
+}`,
+			err: "maxDelay must be greater than zero",
+		},
+		{
+			config: `check "promql/eval_duration" { threshold = "0s" }`,
+			err:    "threshold must be greater than zero",
+		},
+		{
+			config: `check "promql/high_cardinality" { labels = ["pod", ""] }`,
+			err:    "labels cannot contain empty values",
+		},
+		{
+			config: `check "alerts/business_hours" { timezone = "Foo/Bar" }`,
+			err:    "invalid timezone value: unknown time zone Foo/Bar",
+		},
+		{
+			config: `check "alerts/detection_latency" { max = "0s" }`,
+			err:    "max must be greater than zero",
+		},
 		{
 			config: `rule {
   link ".+++" {}
@@ -2225,3 +4676,10 @@ prometheus "prom" {
 	_, err = config.Load(path, true)
 	require.EqualError(t, err, `prometheus server name must be unique, found two or more config blocks using "prom" name`)
 }
+
+func TestCheckSettingsDecodeError(t *testing.T) {
+	cfg := config.Config{Check: []config.Check{{Name: "foo/bar"}}}
+
+	_, err := cfg.CheckSettings()
+	require.EqualError(t, err, `failed to decode "foo/bar" check settings: unknown check "foo/bar"`)
+}
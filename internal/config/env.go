@@ -0,0 +1,36 @@
+package config
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+)
+
+const (
+	envPrometheusURL = "PINT_PROMETHEUS_URL"
+
+	envPrometheusName = "prometheus"
+)
+
+// applyEnv overrides settings loaded from the config file with values
+// from PINT_* environment variables.
+func applyEnv(cfg *Config) error {
+	if uri, ok := os.LookupEnv(envPrometheusURL); ok && uri != "" {
+		switch len(cfg.Prometheus) {
+		case 0:
+			slog.Debug("Adding Prometheus server from environment variable", slog.String("env", envPrometheusURL))
+			cfg.Prometheus = append(cfg.Prometheus, PrometheusConfig{
+				Name: envPrometheusName,
+				URI:  uri,
+			})
+		case 1:
+			slog.Debug("Overriding Prometheus server URI from environment variable",
+				slog.String("env", envPrometheusURL),
+				slog.String("name", cfg.Prometheus[0].Name))
+			cfg.Prometheus[0].URI = uri
+		default:
+			return errors.New(envPrometheusURL + " can only be used when there's at most one prometheus block in the config file")
+		}
+	}
+	return nil
+}
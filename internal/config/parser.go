@@ -5,7 +5,8 @@ import (
 )
 
 type Parser struct {
-	Relaxed []string `hcl:"relaxed,optional" json:"relaxed,omitempty"`
+	Relaxed     []string `hcl:"relaxed,optional" json:"relaxed,omitempty"`
+	ReportEmpty bool     `hcl:"reportEmpty,optional" json:"reportEmpty,omitempty"`
 }
 
 func (p Parser) validate() error {
@@ -12,10 +12,12 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/cloudflare/pint/internal/alertmanager"
 	"github.com/cloudflare/pint/internal/promapi"
 )
 
@@ -78,9 +80,42 @@ func (t *TLSConfig) toHTTPConfig() (*tls.Config, error) {
 	return nil, nil
 }
 
+type OAuth2Config struct {
+	ClientID     string   `hcl:"clientID" json:"clientID"`
+	ClientSecret string   `hcl:"clientSecret" json:"-"`
+	TokenURL     string   `hcl:"tokenURL" json:"tokenURL"`
+	Scopes       []string `hcl:"scopes,optional" json:"scopes,omitempty"`
+}
+
+func (o OAuth2Config) validate() error {
+	if o.ClientID == "" {
+		return errors.New("oauth2 clientID cannot be empty")
+	}
+	if o.ClientSecret == "" {
+		return errors.New("oauth2 clientSecret cannot be empty")
+	}
+	if o.TokenURL == "" {
+		return errors.New("oauth2 tokenURL cannot be empty")
+	}
+	if u, err := url.Parse(o.TokenURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("oauth2 tokenURL %q is invalid", o.TokenURL)
+	}
+	return nil
+}
+
+func (o *OAuth2Config) toOptions() []promapi.PrometheusOption {
+	if o == nil {
+		return nil
+	}
+	return []promapi.PrometheusOption{
+		promapi.WithOAuth2(o.ClientID, o.ClientSecret, o.TokenURL, o.Scopes),
+	}
+}
+
 type PrometheusConfig struct {
 	Headers     map[string]string `hcl:"headers,optional" json:"headers,omitempty"`
 	TLS         *TLSConfig        `hcl:"tls,block" json:"tls,omitempty"`
+	OAuth2      *OAuth2Config     `hcl:"oauth2,block" json:"oauth2,omitempty"`
 	Name        string            `hcl:",label" json:"name"`
 	URI         string            `hcl:"uri" json:"uri"`
 	PublicURI   string            `hcl:"publicURI,optional" json:"publicURI,omitempty"`
@@ -141,6 +176,12 @@ func (pc PrometheusConfig) validate() error {
 		}
 	}
 
+	if pc.OAuth2 != nil {
+		if err := pc.OAuth2.validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -167,11 +208,12 @@ func newFailoverGroup(prom PrometheusConfig) *promapi.FailoverGroup {
 
 	var tlsConf *tls.Config
 	tlsConf, _ = prom.TLS.toHTTPConfig()
+	opts := prom.OAuth2.toOptions()
 	upstreams := []*promapi.Prometheus{
-		promapi.NewPrometheus(prom.Name, prom.URI, prom.PublicURI, prom.Headers, timeout, prom.Concurrency, prom.RateLimit, tlsConf),
+		promapi.NewPrometheus(prom.Name, prom.URI, prom.PublicURI, prom.Headers, timeout, prom.Concurrency, prom.RateLimit, tlsConf, opts...),
 	}
 	for _, uri := range prom.Failover {
-		upstreams = append(upstreams, promapi.NewPrometheus(prom.Name, uri, prom.PublicURI, prom.Headers, timeout, prom.Concurrency, prom.RateLimit, tlsConf))
+		upstreams = append(upstreams, promapi.NewPrometheus(prom.Name, uri, prom.PublicURI, prom.Headers, timeout, prom.Concurrency, prom.RateLimit, tlsConf, opts...))
 	}
 	include := make([]*regexp.Regexp, 0, len(prom.Include))
 	for _, path := range prom.Include {
@@ -196,7 +238,9 @@ func NewPrometheusGenerator(cfg Config, metricsRegistry *prometheus.Registry) *P
 type PrometheusGenerator struct {
 	cfg             Config
 	metricsRegistry *prometheus.Registry
+	alertmanagers   map[string]*alertmanager.Client
 	servers         []*promapi.FailoverGroup
+	mu              sync.Mutex
 }
 
 func (pg *PrometheusGenerator) Servers() []*promapi.FailoverGroup {
@@ -233,6 +277,23 @@ func (pg *PrometheusGenerator) ServerWithName(name string) *promapi.FailoverGrou
 	return nil
 }
 
+// Alertmanager returns a client for given Alertmanager URI, clients are
+// shared by all checks so the routing tree is only fetched once.
+func (pg *PrometheusGenerator) Alertmanager(uri string, timeout time.Duration) *alertmanager.Client {
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	if pg.alertmanagers == nil {
+		pg.alertmanagers = map[string]*alertmanager.Client{}
+	}
+	if am, ok := pg.alertmanagers[uri]; ok {
+		return am
+	}
+	am := alertmanager.NewClient(uri, timeout)
+	pg.alertmanagers[uri] = am
+	return am
+}
+
 func (pg *PrometheusGenerator) addServer(server *promapi.FailoverGroup) error {
 	for _, s := range pg.servers {
 		if s.Name() == server.Name() {
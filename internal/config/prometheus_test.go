@@ -1,9 +1,23 @@
 package config
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -152,6 +166,63 @@ func TestPrometheusConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			conf: PrometheusConfig{
+				Name: "prom",
+				URI:  "http://localhost",
+				OAuth2: &OAuth2Config{
+					ClientID:     "pint",
+					ClientSecret: "secret",
+					TokenURL:     "https://auth.example.com/token",
+					Scopes:       []string{"read"},
+				},
+			},
+		},
+		{
+			conf: PrometheusConfig{
+				Name: "prom",
+				URI:  "http://localhost",
+				OAuth2: &OAuth2Config{
+					ClientSecret: "secret",
+					TokenURL:     "https://auth.example.com/token",
+				},
+			},
+			err: errors.New("oauth2 clientID cannot be empty"),
+		},
+		{
+			conf: PrometheusConfig{
+				Name: "prom",
+				URI:  "http://localhost",
+				OAuth2: &OAuth2Config{
+					ClientID: "pint",
+					TokenURL: "https://auth.example.com/token",
+				},
+			},
+			err: errors.New("oauth2 clientSecret cannot be empty"),
+		},
+		{
+			conf: PrometheusConfig{
+				Name: "prom",
+				URI:  "http://localhost",
+				OAuth2: &OAuth2Config{
+					ClientID:     "pint",
+					ClientSecret: "secret",
+				},
+			},
+			err: errors.New("oauth2 tokenURL cannot be empty"),
+		},
+		{
+			conf: PrometheusConfig{
+				Name: "prom",
+				URI:  "http://localhost",
+				OAuth2: &OAuth2Config{
+					ClientID:     "pint",
+					ClientSecret: "secret",
+					TokenURL:     "/token",
+				},
+			},
+			err: errors.New(`oauth2 tokenURL "/token" is invalid`),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -165,3 +236,90 @@ func TestPrometheusConfig(t *testing.T) {
 		})
 	}
 }
+
+func writePEM(t *testing.T, path, typ string, data []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: data}), 0o644))
+}
+
+func TestPrometheusTLSClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "pint"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	clientCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "client.pem"), "CERTIFICATE", der)
+	writePEM(t, filepath.Join(dir, "client.key"), "EC PRIVATE KEY", keyDER)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+	writePEM(t, filepath.Join(dir, "ca.pem"), "CERTIFICATE", srv.Certificate().Raw)
+
+	type testCaseT struct {
+		title string
+		err   string
+		conf  TLSConfig
+	}
+
+	testCases := []testCaseT{
+		{
+			title: "client certificate",
+			conf: TLSConfig{
+				CaCert:     filepath.Join(dir, "ca.pem"),
+				ClientCert: filepath.Join(dir, "client.pem"),
+				ClientKey:  filepath.Join(dir, "client.key"),
+			},
+		},
+		{
+			title: "no client certificate",
+			conf: TLSConfig{
+				CaCert: filepath.Join(dir, "ca.pem"),
+			},
+			err: "certificate required",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			tlsConf, err := tc.conf.toHTTPConfig()
+			require.NoError(t, err)
+
+			client := http.Client{Transport: &http.Transport{TLSClientConfig: tlsConf}}
+			resp, err := client.Get(srv.URL)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, "pint", string(body))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/prometheus/prometheus/model/rulefmt"
 	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
 
 	"github.com/cloudflare/pint/internal/comments"
 	"github.com/cloudflare/pint/internal/parser"
@@ -43,6 +45,12 @@ func (fe FileIgnoreError) Error() string {
 	return fe.Err.Error()
 }
 
+type FileEmptyError struct{}
+
+func (fe FileEmptyError) Error() string {
+	return "This file doesn't contain any rules."
+}
+
 func isStrictIgnored(err error) bool {
 	s := err.Error()
 	for _, ign := range ignoredErrors {
@@ -109,10 +117,52 @@ type Entry struct {
 	ModifiedLines  []int
 	DisabledChecks []string
 	Rule           parser.Rule
-	State          ChangeType
+	// AuthorEmail is the email of the author who last changed this rule,
+	// it's only set by GitBlameAnnotator.
+	AuthorEmail string `json:",omitempty"`
+	// RuleBefore is the rule as it was before the change, it's only set
+	// for Modified entries found by GitBranchFinder.
+	RuleBefore *parser.Rule `json:",omitempty"`
+	State      ChangeType
 }
 
-func readRules(reportedPath, sourcePath string, r io.Reader, isStrict bool) (entries []Entry, err error) {
+func emptyFileEntry(reportedPath, sourcePath string) Entry {
+	return Entry{
+		Path: Path{
+			Name:          sourcePath,
+			SymlinkTarget: reportedPath,
+		},
+		PathError:     FileEmptyError{},
+		ModifiedLines: []int{1},
+	}
+}
+
+// isRuleFile returns true if given file content can hold Prometheus rules,
+// which means it's either empty or a YAML document with a list or a mapping
+// at the root.
+func isRuleFile(content []byte) bool {
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc yaml.Node
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return true
+		}
+		if err != nil {
+			return false
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		switch doc.Content[0].Kind {
+		case yaml.MappingNode, yaml.SequenceNode:
+		default:
+			return false
+		}
+	}
+}
+
+func readRules(reportedPath, sourcePath string, r io.Reader, isStrict, reportEmpty bool) (entries []Entry, err error) {
 	p := parser.NewParser()
 
 	content, fileComments, err := parser.ReadContent(r)
@@ -247,5 +297,10 @@ func readRules(reportedPath, sourcePath string, r io.Reader, isStrict bool) (ent
 	}
 
 	slog.Debug("File parsed", slog.String("path", sourcePath), slog.Int("rules", len(entries)))
+
+	if len(entries) == 0 && reportEmpty && isRuleFile(content.Body) {
+		entries = append(entries, emptyFileEntry(reportedPath, sourcePath))
+	}
+
 	return entries, nil
 }
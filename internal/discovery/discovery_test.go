@@ -34,6 +34,10 @@ func TestReadRules(t *testing.T) {
 		}
 		return r[0]
 	}
+	inGroup := func(group string, r parser.Rule) parser.Rule {
+		r.Group = group
+		return r
+	}
 
 	type testCaseT struct {
 		sourceFunc   func(t *testing.T) io.Reader
@@ -165,7 +169,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines:  []int{7, 8},
-					Rule:           mustParse(6, "- record: foo\n  expr: bar\n"),
+					Rule:           inGroup("foo", mustParse(6, "- record: foo\n  expr: bar\n")),
 					DisabledChecks: []string{"promql/series"},
 				},
 			},
@@ -219,7 +223,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: []int{7, 8},
-					Rule:          mustParse(6, "- record: foo\n  expr: bar\n"),
+					Rule:          inGroup("foo", mustParse(6, "- record: foo\n  expr: bar\n")),
 				},
 			},
 		},
@@ -273,7 +277,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines:  []int{7, 8},
-					Rule:           mustParse(6, "- record: foo\n  expr: bar\n"),
+					Rule:           inGroup("foo", mustParse(6, "- record: foo\n  expr: bar\n")),
 					DisabledChecks: []string{"promql/series"},
 				},
 			},
@@ -338,7 +342,7 @@ groups:
 			fmt.Sprintf("rPath=%s sPath=%s strict=%v title=%s", tc.reportedPath, tc.sourcePath, tc.isStrict, tc.title),
 			func(t *testing.T) {
 				r := tc.sourceFunc(t)
-				entries, err := readRules(tc.reportedPath, tc.sourcePath, r, tc.isStrict)
+				entries, err := readRules(tc.reportedPath, tc.sourcePath, r, tc.isStrict, false)
 				if tc.err != "" {
 					require.EqualError(t, err, tc.err)
 				} else {
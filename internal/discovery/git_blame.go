@@ -0,0 +1,50 @@
+package discovery
+
+import (
+	"log/slog"
+
+	"github.com/cloudflare/pint/internal/git"
+)
+
+func NewGitBlameAnnotator(gitCmd git.CommandRunner) GitBlameAnnotator {
+	return GitBlameAnnotator{gitCmd: gitCmd}
+}
+
+// GitBlameAnnotator uses git blame to find who last changed each rule.
+// Only rules that were changed are annotated, since those are the only ones
+// that can get reports, so we don't run git blame for every rule.
+type GitBlameAnnotator struct {
+	gitCmd git.CommandRunner
+}
+
+func (a GitBlameAnnotator) Annotate(entries []Entry) []Entry {
+	for i := range entries {
+		if entries[i].PathError != nil {
+			continue
+		}
+		if entries[i].State == Noop || entries[i].State == Removed || entries[i].State == Excluded {
+			continue
+		}
+		if entries[i].Rule.Lines.First == 0 {
+			continue
+		}
+
+		author, err := git.BlameAuthor(
+			a.gitCmd,
+			entries[i].Path.SymlinkTarget,
+			entries[i].Rule.Lines.First,
+			entries[i].Rule.Lines.Last,
+		)
+		if err != nil {
+			slog.Debug(
+				"Failed to get git blame for rule",
+				slog.String("path", entries[i].Path.SymlinkTarget),
+				slog.Int("line", entries[i].Rule.Lines.First),
+				slog.Any("err", err),
+			)
+			continue
+		}
+		entries[i].AuthorEmail = author
+	}
+	return entries
+}
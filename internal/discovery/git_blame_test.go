@@ -0,0 +1,56 @@
+package discovery_test
+
+import (
+	"errors"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/git"
+)
+
+func TestGitBlameAnnotator(t *testing.T) {
+	includeAll := []*regexp.Regexp{regexp.MustCompile(".*")}
+
+	dir := t.TempDir()
+	err := os.Chdir(dir)
+	require.NoError(t, err, "chdir")
+
+	_, err = git.RunGit("init", "--initial-branch=main", ".")
+	require.NoError(t, err, "git init")
+
+	commitFile(t, "rules.yml", "- record: foo\n  expr: sum(foo)\n- record: bar\n  expr: sum(bar)\n", "v1")
+
+	err = os.WriteFile("rules.yml", []byte("- record: foo\n  expr: sum(foo)\n- record: bar\n  expr: sum(bar) by (job)\n"), 0o644)
+	require.NoError(t, err, "write rules.yml")
+	_, err = git.RunGit("commit", "-am", "v2", "--author", "Bob <bob@example.com>")
+	require.NoError(t, err, "git commit v2")
+
+	err = os.WriteFile("empty.yml", []byte("\n"), 0o644)
+	require.NoError(t, err, "write empty.yml")
+
+	entries, err := discovery.NewGlobFinder([]string{"*.yml"}, git.NewPathFilter(includeAll, nil, includeAll), true).Find()
+	require.NoError(t, err, "Find()")
+	require.Len(t, entries, 3)
+
+	entries = discovery.NewGitBlameAnnotator(git.RunGit).Annotate(entries)
+	require.Empty(t, entries[1].AuthorEmail, "rules.yml / foo / noop")
+	require.Empty(t, entries[2].AuthorEmail, "rules.yml / bar / noop")
+
+	for i := range entries {
+		entries[i].State = discovery.Modified
+	}
+	entries = discovery.NewGitBlameAnnotator(git.RunGit).Annotate(entries)
+	require.Empty(t, entries[0].AuthorEmail, "empty.yml")
+	require.Equal(t, "pint@example.com", entries[1].AuthorEmail, "rules.yml / foo")
+	require.Equal(t, "bob@example.com", entries[2].AuthorEmail, "rules.yml / bar")
+
+	entries[1].AuthorEmail = ""
+	entries = discovery.NewGitBlameAnnotator(func(_ ...string) ([]byte, error) {
+		return nil, errors.New("mock error")
+	}).Annotate(entries[1:2])
+	require.Empty(t, entries[0].AuthorEmail, "git error")
+}
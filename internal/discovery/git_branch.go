@@ -2,6 +2,7 @@ package discovery
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -18,20 +19,23 @@ func NewGitBranchFinder(
 	filter git.PathFilter,
 	baseBranch string,
 	maxCommits int,
+	reportEmpty bool,
 ) GitBranchFinder {
 	return GitBranchFinder{
-		gitCmd:     gitCmd,
-		filter:     filter,
-		baseBranch: baseBranch,
-		maxCommits: maxCommits,
+		gitCmd:      gitCmd,
+		filter:      filter,
+		baseBranch:  baseBranch,
+		maxCommits:  maxCommits,
+		reportEmpty: reportEmpty,
 	}
 }
 
 type GitBranchFinder struct {
-	gitCmd     git.CommandRunner
-	baseBranch string
-	filter     git.PathFilter
-	maxCommits int
+	gitCmd      git.CommandRunner
+	baseBranch  string
+	filter      git.PathFilter
+	maxCommits  int
+	reportEmpty bool
 }
 
 func (f GitBranchFinder) Find(allEntries []Entry) (entries []Entry, err error) {
@@ -69,17 +73,25 @@ func (f GitBranchFinder) Find(allEntries []Entry) (entries []Entry, err error) {
 			change.Path.Before.Name,
 			bytes.NewReader(change.Body.Before),
 			!f.filter.IsRelaxed(change.Path.Before.Name),
+			false,
 		)
 		entriesAfter, err = readRules(
 			change.Path.After.EffectivePath(),
 			change.Path.After.Name,
 			bytes.NewReader(change.Body.After),
 			!f.filter.IsRelaxed(change.Path.After.Name),
+			f.reportEmpty,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("invalid file syntax: %w", err)
 		}
 
+		if len(entriesAfter) == 1 && errors.Is(entriesAfter[0].PathError, FileEmptyError{}) {
+			entriesAfter[0].State = Modified
+			entries = append(entries, entriesAfter[0])
+			entriesAfter = nil
+		}
+
 		for _, me := range matchEntries(entriesBefore, entriesAfter) {
 			switch {
 			case !me.hasBefore && me.hasAfter:
@@ -123,6 +135,7 @@ func (f GitBranchFinder) Find(allEntries []Entry) (entries []Entry, err error) {
 					)
 					me.after.State = Modified
 					me.after.ModifiedLines = commonLines(change.Body.ModifiedLines, me.after.ModifiedLines)
+					me.after.RuleBefore = &me.before.Rule
 				}
 				entries = append(entries, me.after)
 			case me.hasBefore && !me.hasAfter:
@@ -173,6 +186,7 @@ func (f GitBranchFinder) Find(allEntries []Entry) (entries []Entry, err error) {
 			if entry.Path.Name == globEntry.Path.Name && entry.Rule.IsSame(globEntry.Rule) {
 				allEntries[i].State = entry.State
 				allEntries[i].ModifiedLines = entry.ModifiedLines
+				allEntries[i].RuleBefore = entry.RuleBefore
 				found = true
 				break
 			}
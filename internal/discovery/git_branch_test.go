@@ -48,6 +48,18 @@ func TestGitBranchFinder(t *testing.T) {
 		}
 		return r[0]
 	}
+	mustParseRef := func(offset int, s string) *parser.Rule {
+		r := mustParse(offset, s)
+		return &r
+	}
+	inGroup := func(group string, r parser.Rule) parser.Rule {
+		r.Group = group
+		return r
+	}
+	inGroupRef := func(group string, r parser.Rule) *parser.Rule {
+		r.Group = group
+		return &r
+	}
 
 	mustErr := func(s string) error {
 		_, errs := rulefmt.Parse([]byte(s))
@@ -78,6 +90,7 @@ func TestGitBranchFinder(t *testing.T) {
 				git.NewPathFilter(includeAll, nil, nil),
 				"main",
 				50,
+				false,
 			),
 			entries: nil,
 			err:     "failed to get the list of commits to scan: mock git error: [log --format=%H --no-abbrev-commit --reverse main..HEAD]",
@@ -92,6 +105,7 @@ func TestGitBranchFinder(t *testing.T) {
 				git.NewPathFilter(includeAll, nil, nil),
 				"master",
 				50,
+				false,
 			),
 			entries: nil,
 			err:     "failed to get the list of commits to scan: mock git error: [log --format=%H --no-abbrev-commit --reverse master..HEAD]",
@@ -111,6 +125,7 @@ func TestGitBranchFinder(t *testing.T) {
 				git.NewPathFilter(includeAll, nil, nil),
 				"main",
 				3,
+				false,
 			),
 			entries: nil,
 			err:     "number of commits to check (4) is higher than maxCommits (3), exiting",
@@ -130,6 +145,7 @@ func TestGitBranchFinder(t *testing.T) {
 				git.NewPathFilter(includeAll, nil, nil),
 				"main",
 				4,
+				false,
 			),
 			entries: nil,
 			err:     "failed to get the list of modified files from git: mock git error: [log --reverse --no-merges --first-parent --format=%H --name-status c1^..c4]",
@@ -151,6 +167,7 @@ func TestGitBranchFinder(t *testing.T) {
 				git.NewPathFilter(includeAll, nil, nil),
 				"main",
 				4,
+				false,
 			),
 			entries: nil,
 			err:     "failed to get commit message for c1: mock git error: [show -s --format=%B c1]",
@@ -178,6 +195,7 @@ func TestGitBranchFinder(t *testing.T) {
 				git.NewPathFilter(includeAll, nil, nil),
 				"main",
 				4,
+				false,
 			),
 			entries: nil,
 			err:     "failed to run git blame for rules.yml: mock git error: [blame --line-porcelain c1 -- rules.yml]",
@@ -192,9 +210,32 @@ func TestGitBranchFinder(t *testing.T) {
 
 				commitFile(t, "rules.yml", "# v2\n", "v2")
 			},
-			finder:  discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4),
+			finder:  discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4, false),
 			entries: nil,
 		},
+		{
+			title: "no rules in file / reportEmpty enabled",
+			setup: func(t *testing.T) {
+				commitFile(t, "rules.yml", "# v1\n", "v1")
+
+				_, err := git.RunGit("checkout", "-b", "v2")
+				require.NoError(t, err, "git checkout v2")
+
+				commitFile(t, "rules.yml", "# v2\n", "v2")
+			},
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4, true),
+			entries: []discovery.Entry{
+				{
+					State: discovery.Modified,
+					Path: discovery.Path{
+						Name:          "rules.yml",
+						SymlinkTarget: "rules.yml",
+					},
+					PathError:     discovery.FileEmptyError{},
+					ModifiedLines: []int{1},
+				},
+			},
+		},
 		{
 			title: "no rule changes",
 			setup: func(t *testing.T) {
@@ -217,7 +258,7 @@ groups:
     expr: count(up == 1)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Excluded,
@@ -226,7 +267,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: []int{},
-					Rule:          mustParse(4, "- record: up:count\n  expr: count(up == 1)\n"),
+					Rule:          inGroup("v2", mustParse(4, "- record: up:count\n  expr: count(up == 1)\n")),
 				},
 			},
 		},
@@ -252,7 +293,7 @@ groups:
     expr: count(up == 1)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Modified,
@@ -261,7 +302,8 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: []int{6},
-					Rule:          mustParse(4, "- record: up:count\n  expr: count(up == 1)\n"),
+					Rule:          inGroup("v2", mustParse(4, "- record: up:count\n  expr: count(up == 1)\n")),
+					RuleBefore:    inGroupRef("v1", mustParse(4, "- record: up:count\n  expr: count(up)\n")),
 				},
 			},
 		},
@@ -281,7 +323,7 @@ groups:
   expr: count(up == 1)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Modified,
@@ -291,6 +333,7 @@ groups:
 					},
 					ModifiedLines: []int{3},
 					Rule:          mustParse(1, "- record: up:count\n  expr: count(up == 1)\n"),
+					RuleBefore:    mustParseRef(1, "- record: up:count\n  expr: count(up)\n"),
 				},
 			},
 		},
@@ -310,7 +353,7 @@ groups:
   expr: count(up == 1)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(nil, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(nil, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Modified,
@@ -320,6 +363,7 @@ groups:
 					},
 					ModifiedLines: []int{3},
 					Rule:          mustParse(1, "- record: up:count\n  expr: count(up == 1)\n"),
+					RuleBefore:    mustParseRef(1, "- record: up:count\n  expr: count(up)\n"),
 				},
 			},
 		},
@@ -350,6 +394,7 @@ groups:
 				git.NewPathFilter([]*regexp.Regexp{regexp.MustCompile("^foo#")}, nil, nil),
 				"main",
 				4,
+				false,
 			),
 			entries: nil,
 		},
@@ -375,7 +420,7 @@ groups:
     expr: count(up == 1)
 `, "v2\nskip this commit\n[skip ci]\n")
 			},
-			finder:  discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4),
+			finder:  discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4, false),
 			entries: nil,
 		},
 		{
@@ -400,7 +445,7 @@ groups:
     expr: count(up == 1)
 `, "v2\nskip this commit\n[no ci]\n")
 			},
-			finder:  discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4),
+			finder:  discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4, false),
 			entries: nil,
 		},
 		{
@@ -420,7 +465,7 @@ groups:
 				require.NoError(t, err, "git add")
 				gitCommit(t, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Added,
@@ -465,7 +510,7 @@ groups:
     expr: count(up)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Modified,
@@ -474,7 +519,8 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: []int{6},
-					Rule:          mustParse(4, "- record: up:count:1\n  expr: count(up == 1)\n"),
+					Rule:          inGroup("v2", mustParse(4, "- record: up:count:1\n  expr: count(up == 1)\n")),
+					RuleBefore:    inGroupRef("v1", mustParse(4, "- record: up:count:1\n  expr: count(up)\n")),
 				},
 				{
 					State: discovery.Added,
@@ -483,7 +529,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: []int{7},
-					Rule:          mustParse(6, "- record: up:count:2a\n  expr: count(up)\n"),
+					Rule:          inGroup("v2", mustParse(6, "- record: up:count:2a\n  expr: count(up)\n")),
 				},
 				{
 					State: discovery.Excluded,
@@ -492,7 +538,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: []int{},
-					Rule:          mustParse(8, "- record: up:count:3\n  expr: count(up)\n"),
+					Rule:          inGroup("v2", mustParse(8, "- record: up:count:3\n  expr: count(up)\n")),
 				},
 				{
 					State: discovery.Added,
@@ -501,7 +547,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: []int{11, 12},
-					Rule:          mustParse(10, "- record: up:count:4\n  expr: count(up)\n"),
+					Rule:          inGroup("v2", mustParse(10, "- record: up:count:4\n  expr: count(up)\n")),
 				},
 				{
 					State: discovery.Removed,
@@ -510,7 +556,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: []int{7},
-					Rule:          mustParse(6, "- record: up:count:2\n  expr: count(up)\n"),
+					Rule:          inGroup("v1", mustParse(6, "- record: up:count:2\n  expr: count(up)\n")),
 				},
 			},
 		},
@@ -537,7 +583,7 @@ groups:
   for: 0s
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Modified,
@@ -547,6 +593,7 @@ groups:
 					},
 					ModifiedLines: []int{4},
 					Rule:          mustParse(1, "- alert: rule1\n  expr: sum(foo) by(job)\n  for: 0s\n"),
+					RuleBefore:    mustParseRef(1, "- alert: rule1\n  expr: sum(foo) by(job)\n"),
 				},
 				{
 					State: discovery.Excluded,
@@ -577,7 +624,7 @@ groups:
   expr: sum(foo) by(job)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Excluded,
@@ -617,7 +664,7 @@ groups:
   expr: sum(foo) by(job)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Excluded,
@@ -661,7 +708,7 @@ groups:
   expr: sum(foo) by(job)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Excluded,
@@ -715,7 +762,7 @@ groups:
     expr: count(up)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, nil), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Added,
@@ -724,7 +771,7 @@ groups:
 						SymlinkTarget: "rules.yml",
 					},
 					ModifiedLines: nil,
-					Rule:          mustParse(4, "- record: up:count\n  expr: count(up)\n"),
+					Rule:          inGroup("v2", mustParse(4, "- record: up:count\n  expr: count(up)\n")),
 				},
 				{
 					State: discovery.Removed,
@@ -768,7 +815,7 @@ groups:
   expr: sum(foo) by(job)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Excluded,
@@ -830,7 +877,7 @@ groups:
   expr: sum(foo) by(job)
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Modified,
@@ -840,6 +887,7 @@ groups:
 					},
 					ModifiedLines: []int{3},
 					Rule:          mustParse(1, "- alert: rule1\n  expr: up == 0\n"),
+					RuleBefore:    mustParseRef(1, "- alert: rule1\n  expr: sum(foo) by(job)\n"),
 				},
 				{
 					State: discovery.Added,
@@ -899,7 +947,7 @@ groups:
     foo: bar
 `, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Excluded,
@@ -918,6 +966,7 @@ groups:
 					},
 					ModifiedLines: []int{7, 8, 9, 10, 11, 12},
 					Rule:          mustParse(4, "- alert: rule2\n  expr: sum(foo) by(job)\n  keep_firing_for: 5m\n  for: 0s\n  annotations:\n    foo: bar\n  labels:\n    foo: bar\n"),
+					RuleBefore:    mustParseRef(4, "- alert: rule2\n  expr: sum(foo) by(job)\n  for: 1s\n"),
 				},
 			},
 		},
@@ -937,7 +986,7 @@ groups:
 
 				gitCommit(t, "v2")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Moved,
@@ -972,7 +1021,7 @@ groups:
 
 				gitCommit(t, "v3")
 			},
-			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4),
+			finder: discovery.NewGitBranchFinder(git.RunGit, git.NewPathFilter(includeAll, nil, includeAll), "main", 4, false),
 			entries: []discovery.Entry{
 				{
 					State: discovery.Moved,
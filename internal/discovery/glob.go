@@ -10,16 +10,18 @@ import (
 	"github.com/cloudflare/pint/internal/git"
 )
 
-func NewGlobFinder(patterns []string, filter git.PathFilter) GlobFinder {
+func NewGlobFinder(patterns []string, filter git.PathFilter, reportEmpty bool) GlobFinder {
 	return GlobFinder{
-		patterns: patterns,
-		filter:   filter,
+		patterns:    patterns,
+		filter:      filter,
+		reportEmpty: reportEmpty,
 	}
 }
 
 type GlobFinder struct {
-	patterns []string
-	filter   git.PathFilter
+	patterns    []string
+	filter      git.PathFilter
+	reportEmpty bool
 }
 
 func (f GlobFinder) Find() (entries []Entry, err error) {
@@ -65,7 +67,7 @@ func (f GlobFinder) Find() (entries []Entry, err error) {
 		if err != nil {
 			return nil, err
 		}
-		el, err := readRules(fp.target, fp.path, fd, !f.filter.IsRelaxed(fp.target))
+		el, err := readRules(fp.target, fp.path, fd, !f.filter.IsRelaxed(fp.target), f.reportEmpty)
 		if err != nil {
 			fd.Close()
 			return nil, fmt.Errorf("invalid file syntax: %w", err)
@@ -0,0 +1,190 @@
+package discovery
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/pint/internal/git"
+)
+
+func NewHTTPFinder(uri string, headers map[string]string, pollInterval time.Duration, filter git.PathFilter, maxSize int64, reportEmpty bool) *HTTPFinder {
+	return &HTTPFinder{
+		uri:          uri,
+		headers:      headers,
+		pollInterval: pollInterval,
+		filter:       filter,
+		maxSize:      maxSize,
+		reportEmpty:  reportEmpty,
+		client:       &http.Client{Timeout: time.Minute},
+	}
+}
+
+// HTTPFinder reads rules from a YAML file or a ZIP archive of rule files
+// served over HTTP.
+// Fetched content is reused until pollInterval passes, after which a
+// conditional request is sent using the ETag returned by the server.
+// Responses bigger than maxSize bytes are rejected, same as ZIP archives
+// with more than maxSize bytes of uncompressed content.
+type HTTPFinder struct {
+	lastFetch    time.Time
+	client       *http.Client
+	headers      map[string]string
+	uri          string
+	etag         string
+	filter       git.PathFilter
+	entries      []Entry
+	pollInterval time.Duration
+	maxSize      int64
+	mu           sync.Mutex
+	reportEmpty  bool
+}
+
+func (f *HTTPFinder) Find(ctx context.Context) (entries []Entry, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.lastFetch.IsZero() && time.Since(f.lastFetch) < f.pollInterval {
+		slog.Debug("Reusing rules fetched over HTTP", slog.String("uri", f.uri), slog.Time("fetched", f.lastFetch))
+		return f.cached(), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s: %w", f.uri, err)
+	}
+	for k, v := range f.headers {
+		req.Header.Set(k, v)
+	}
+	if f.etag != "" {
+		req.Header.Set("If-None-Match", f.etag)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rules from %s: %w", f.uri, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		slog.Debug("Rules fetched over HTTP are not modified", slog.String("uri", f.uri), slog.String("etag", f.etag))
+		f.lastFetch = time.Now()
+		return f.cached(), nil
+	case resp.StatusCode/100 != 2:
+		return nil, fmt.Errorf("failed to fetch rules from %s: %s", f.uri, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules from %s: %w", f.uri, err)
+	}
+	if int64(len(body)) > f.maxSize {
+		return nil, fmt.Errorf("failed to read rules from %s: response is bigger than %d bytes", f.uri, f.maxSize)
+	}
+
+	if isZip(resp.Header.Get("Content-Type"), body) {
+		entries, err = f.readZip(body)
+	} else {
+		entries, err = f.readFile(f.uri, bytes.NewReader(body))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f.entries = entries
+	f.etag = resp.Header.Get("ETag")
+	f.lastFetch = time.Now()
+
+	slog.Debug("HTTP finder completed", slog.String("uri", f.uri), slog.Int("count", len(entries)))
+	return f.cached(), nil
+}
+
+func (f *HTTPFinder) cached() []Entry {
+	entries := make([]Entry, len(f.entries))
+	copy(entries, f.entries)
+	return entries
+}
+
+func (f *HTTPFinder) readZip(body []byte) (entries []Entry, err error) {
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip archive from %s: %w", f.uri, err)
+	}
+
+	files := make([]*zip.File, 0, len(zr.File))
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+		files = append(files, zf)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+
+	// maxSize is also the limit for the total size of all uncompressed
+	// files, so a small archive can't be used to exhaust memory.
+	remaining := f.maxSize
+	for _, zf := range files {
+		content, err := f.readZipFile(zf, remaining)
+		if err != nil {
+			return nil, err
+		}
+		remaining -= int64(len(content))
+
+		el, err := f.readFile(zf.Name, bytes.NewReader(content))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, el...)
+	}
+	return entries, nil
+}
+
+func (f *HTTPFinder) readZipFile(zf *zip.File, limit int64) ([]byte, error) {
+	fd, err := zf.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from zip archive: %w", zf.Name, err)
+	}
+	defer fd.Close()
+
+	content, err := io.ReadAll(io.LimitReader(fd, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from zip archive: %w", zf.Name, err)
+	}
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("failed to read %s from zip archive: uncompressed content is bigger than %d bytes", zf.Name, f.maxSize)
+	}
+	return content, nil
+}
+
+func (f *HTTPFinder) readFile(path string, r io.Reader) (entries []Entry, err error) {
+	el, err := readRules(path, path, r, !f.filter.IsRelaxed(path), f.reportEmpty)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file syntax: %w", err)
+	}
+	for _, e := range el {
+		e.State = Noop
+		if len(e.ModifiedLines) == 0 {
+			e.ModifiedLines = e.Rule.Lines.Expand()
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func isZip(contentType string, body []byte) bool {
+	if strings.HasPrefix(contentType, "application/zip") {
+		return true
+	}
+	return http.DetectContentType(body) == "application/zip"
+}
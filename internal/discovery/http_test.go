@@ -0,0 +1,244 @@
+package discovery_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/git"
+)
+
+const httpRuleBody = `groups:
+- name: foo
+  rules:
+  - record: foo
+    expr: sum(foo)
+  - alert: bar
+    expr: foo > 1
+`
+
+func makeZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestHTTPFinder(t *testing.T) {
+	zipBody := makeZip(t, map[string]string{
+		"rules/b.yml": httpRuleBody,
+		"rules/a.yml": httpRuleBody,
+	})
+	bigZip := makeZip(t, map[string]string{
+		"rules/a.yml": httpRuleBody + "# " + strings.Repeat("x", 1<<20) + "\n",
+	})
+	zipBomb := makeZip(t, map[string]string{
+		"rules/a.yml": httpRuleBody + "# " + strings.Repeat("x", 48<<10) + "\n",
+		"rules/b.yml": httpRuleBody + "# " + strings.Repeat("x", 48<<10) + "\n",
+	})
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/rules.yml":
+			if r.Header.Get("X-Auth") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(httpRuleBody))
+		case "/rules.zip":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(zipBody)
+		case "/big.zip":
+			w.Header().Set("Content-Type", "application/zip")
+			_, _ = w.Write(bigZip)
+		case "/bomb.zip":
+			w.Header().Set("Content-Type", "application/zip")
+			_, _ = w.Write(zipBomb)
+		case "/bad.yml":
+			_, _ = w.Write([]byte("groups:\n- name: foo\n  rules:\n  - record: foo\n    expr: sum(\n"))
+		case "/crd.yml":
+			_, _ = w.Write([]byte("apiVersion: monitoring.coreos.com/v1\nkind: PrometheusRule\nspec:\n  groups:\n  - name: foo\n    rules:\n    - record: foo\n      expr: sum(foo)\n"))
+		case "/empty.yml":
+			_, _ = w.Write([]byte("# TODO: add rules here\n"))
+		case "/invalid.yml":
+			_, _ = w.Write([]byte("- record: foo\n  expr: sum(foo)\n  bogus: true\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	t.Run("yaml", func(t *testing.T) {
+		requests.Store(0)
+		finder := discovery.NewHTTPFinder(srv.URL+"/rules.yml", map[string]string{"X-Auth": "secret"}, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		entries, err := finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, srv.URL+"/rules.yml", entries[0].Path.Name)
+		require.Equal(t, "foo", entries[0].Rule.RecordingRule.Record.Value)
+		require.Equal(t, "bar", entries[1].Rule.AlertingRule.Alert.Value)
+		require.Equal(t, discovery.Noop, entries[0].State)
+		require.Equal(t, []int{4, 5}, entries[0].ModifiedLines)
+
+		// Second request is conditional and gets a 304.
+		entries, err = finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("poll interval", func(t *testing.T) {
+		requests.Store(0)
+		finder := discovery.NewHTTPFinder(srv.URL+"/rules.yml", map[string]string{"X-Auth": "secret"}, time.Hour, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		for range 3 {
+			entries, err := finder.Find(context.Background())
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+		}
+		require.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/rules.zip", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		entries, err := finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		require.Equal(t, "rules/a.yml", entries[0].Path.Name)
+		require.Equal(t, "rules/a.yml", entries[1].Path.Name)
+		require.Equal(t, "rules/b.yml", entries[2].Path.Name)
+		require.Equal(t, "rules/b.yml", entries[3].Path.Name)
+	})
+
+	t.Run("missing headers", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/rules.yml", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		_, err := finder.Find(context.Background())
+		require.EqualError(t, err, "failed to fetch rules from "+srv.URL+"/rules.yml: 401 Unauthorized")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/rules.zip", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := finder.Find(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/missing.yml", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		_, err := finder.Find(context.Background())
+		require.EqualError(t, err, "failed to fetch rules from "+srv.URL+"/missing.yml: 404 Not Found")
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/bad.yml", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		entries, err := finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Error(t, entries[0].Rule.Expr().SyntaxError)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/invalid.yml", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		entries, err := finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Error(t, entries[0].PathError)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/empty.yml", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		entries, err := finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("empty file / reportEmpty enabled", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/empty.yml", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, true)
+
+		entries, err := finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, srv.URL+"/empty.yml", entries[0].Path.Name)
+		require.ErrorIs(t, entries[0].PathError, discovery.FileEmptyError{})
+		require.Equal(t, discovery.Noop, entries[0].State)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/crd.yml", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		entries, err := finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Error(t, entries[0].PathError)
+	})
+
+	t.Run("relaxed", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/crd.yml", nil, 0, git.NewPathFilter(nil, nil, []*regexp.Regexp{regexp.MustCompile(".*")}), 1<<20, false)
+
+		entries, err := finder.Find(context.Background())
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.NoError(t, entries[0].PathError)
+		require.Equal(t, "foo", entries[0].Rule.Name())
+	})
+
+	t.Run("response too big", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/rules.yml", map[string]string{"X-Auth": "secret"}, 0, git.NewPathFilter(nil, nil, nil), 10, false)
+
+		_, err := finder.Find(context.Background())
+		require.EqualError(t, err, "failed to read rules from "+srv.URL+"/rules.yml: response is bigger than 10 bytes")
+	})
+
+	t.Run("zip content too big", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/big.zip", nil, 0, git.NewPathFilter(nil, nil, nil), 64<<10, false)
+
+		_, err := finder.Find(context.Background())
+		require.EqualError(t, err, "failed to read rules/a.yml from zip archive: uncompressed content is bigger than 65536 bytes")
+	})
+
+	t.Run("zip total content too big", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder(srv.URL+"/bomb.zip", nil, 0, git.NewPathFilter(nil, nil, nil), 64<<10, false)
+
+		_, err := finder.Find(context.Background())
+		require.EqualError(t, err, "failed to read rules/b.yml from zip archive: uncompressed content is bigger than 65536 bytes")
+	})
+
+	t.Run("bad uri", func(t *testing.T) {
+		finder := discovery.NewHTTPFinder("http://127.0.0.1:0/rules.yml", nil, 0, git.NewPathFilter(nil, nil, nil), 1<<20, false)
+
+		_, err := finder.Find(context.Background())
+		require.ErrorContains(t, err, "failed to fetch rules from http://127.0.0.1:0/rules.yml")
+	})
+}
@@ -90,6 +90,162 @@ func Blame(cmd CommandRunner, path, commit string) (lines LineBlames, err error)
 	return lines, nil
 }
 
+// notCommittedSHA is used by git blame for lines that are not committed yet.
+const notCommittedSHA = "0000000000000000000000000000000000000000"
+
+// BlameAuthor returns the email of the author who most recently changed
+// any of the lines in the given range.
+// Lines that are not committed yet are ignored.
+func BlameAuthor(cmd CommandRunner, path string, first, last int) (string, error) {
+	slog.Debug("Running git blame", slog.String("path", path), slog.Int("first", first), slog.Int("last", last))
+	output, err := cmd("blame", "-p", "-L", fmt.Sprintf("%d,%d", first, last), "--", path)
+	if err != nil {
+		return "", err
+	}
+
+	type commitAuthor struct {
+		email string
+		time  int64
+	}
+	authors := map[string]commitAuthor{}
+
+	var commit, author string
+	var authorTime int64
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "\t"):
+			continue
+		case strings.HasPrefix(line, "author-mail "):
+			author = strings.Trim(strings.TrimPrefix(line, "author-mail "), "<>")
+		case strings.HasPrefix(line, "author-time "):
+			if authorTime, err = strconv.ParseInt(strings.TrimPrefix(line, "author-time "), 10, 64); err != nil {
+				return "", fmt.Errorf("failed to parse author time from %q: %w", line, err)
+			}
+		case strings.HasPrefix(line, "filename "):
+			// filename is the last header line of each commit.
+			if _, ok := authors[commit]; !ok {
+				authors[commit] = commitAuthor{email: author, time: authorTime}
+			}
+		default:
+			if parts := strings.Split(line, " "); len(parts) >= 3 && len(parts[0]) == len(notCommittedSHA) {
+				commit = parts[0]
+			}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return "", err
+	}
+
+	var latest commitAuthor
+	for sha, ca := range authors {
+		if sha == notCommittedSHA {
+			continue
+		}
+		if ca.time > latest.time || (ca.time == latest.time && ca.email < latest.email) {
+			latest = ca
+		}
+	}
+	return latest.email, nil
+}
+
+// DiffLines returns line numbers that were added or modified in the working
+// tree compared to HEAD, grouped by file path relative to the current directory.
+// Prefixes and path quoting are set explicitly so that user's git config
+// (diff.noprefix, diff.mnemonicPrefix, core.quotePath) doesn't change the output.
+func DiffLines(cmd CommandRunner) (map[string][]int, error) {
+	slog.Debug("Running git diff against HEAD")
+	output, err := cmd(
+		"-c", "core.quotePath=false",
+		"diff", "--no-color", "--no-ext-diff", "--relative", "--unified=0",
+		"--src-prefix=a/", "--dst-prefix=b/",
+		"HEAD",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := map[string][]int{}
+	var path string
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "+++ "):
+			path, err = diffPath(strings.TrimPrefix(line, "+++ "))
+			if err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(line, "@@ ") && path != "":
+			first, count, err := parseHunkHeader(line)
+			if err != nil {
+				return nil, err
+			}
+			for i := first; i < first+count; i++ {
+				lines[path] = append(lines[path], i)
+			}
+		}
+	}
+	return lines, nil
+}
+
+// UntrackedFiles returns paths of all files that are not tracked by git
+// and not ignored, relative to the current directory.
+func UntrackedFiles(cmd CommandRunner) ([]string, error) {
+	slog.Debug("Listing untracked files")
+	output, err := cmd("ls-files", "-z", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, path := range bytes.Split(output, []byte{0}) {
+		if len(path) > 0 {
+			paths = append(paths, string(path))
+		}
+	}
+	return paths, nil
+}
+
+// diffPath returns the file path from a "+++ b/path" line of git diff output.
+// Paths with special characters are C-quoted by git even with core.quotePath
+// disabled, and paths containing spaces are followed by a tab.
+func diffPath(s string) (string, error) {
+	if s == "/dev/null" {
+		return "", nil
+	}
+	s = strings.TrimSuffix(s, "\t")
+	if strings.HasPrefix(s, `"`) {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid path in git diff output: %s: %w", s, err)
+		}
+		s = unquoted
+	}
+	return strings.TrimPrefix(s, "b/"), nil
+}
+
+// parseHunkHeader returns the first line and the number of lines
+// of the new file from a "@@ -a,b +c,d @@" hunk header.
+func parseHunkHeader(line string) (first, count int, err error) {
+	parts := strings.Split(line, " ")
+	if len(parts) < 3 || !strings.HasPrefix(parts[2], "+") {
+		return 0, 0, fmt.Errorf("invalid hunk header: %q", line)
+	}
+	start, size, found := strings.Cut(strings.TrimPrefix(parts[2], "+"), ",")
+	if first, err = strconv.Atoi(start); err != nil {
+		return 0, 0, fmt.Errorf("invalid hunk header: %q: %w", line, err)
+	}
+	count = 1
+	if found {
+		if count, err = strconv.Atoi(size); err != nil {
+			return 0, 0, fmt.Errorf("invalid hunk header: %q: %w", line, err)
+		}
+	}
+	return first, count, nil
+}
+
 func HeadCommit(cmd CommandRunner) (string, error) {
 	commit, err := cmd("rev-parse", "--verify", "HEAD")
 	if err != nil {
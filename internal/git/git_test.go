@@ -346,3 +346,215 @@ func TestCommitMessage(t *testing.T) {
 		})
 	}
 }
+
+func blameCommit(sha, email string, authorTime, line int, content string) string {
+	return fmt.Sprintf(`%s %d %d 1
+author Mock
+author-mail <%s>
+author-time %d
+author-tz 0000
+committer Mock
+committer-mail <%s>
+committer-time %d
+committer-tz 0000
+summary Mock commit title
+filename foo.txt
+	%s
+`, sha, line, line, email, authorTime, email, authorTime, content)
+}
+
+func TestBlameAuthor(t *testing.T) {
+	type testCaseT struct {
+		mock   git.CommandRunner
+		output string
+		err    string
+	}
+
+	testCases := []testCaseT{
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return nil, errors.New("mock error")
+			},
+			err: "mock error",
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return nil, nil
+			},
+			output: "",
+		},
+		{
+			mock: func(args ...string) ([]byte, error) {
+				require.Equal(t, []string{"blame", "-p", "-L", "2,4", "--", "foo.txt"}, args)
+				return []byte(blameCommit("b33a88cea35abc47f9973983626e1c6f3f3abc44", "alice@example.com", 1559927997, 2, "- record: foo") +
+					blameCommit("c33a88cea35abc47f9973983626e1c6f3f3abc44", "bob@example.com", 1559928997, 3, "  expr: sum(foo)") +
+					"b33a88cea35abc47f9973983626e1c6f3f3abc44 4 4\n\t  labels: {}\n"), nil
+			},
+			output: "bob@example.com",
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return []byte(blameCommit("b33a88cea35abc47f9973983626e1c6f3f3abc44", "alice@example.com", 1559927997, 2, "- record: foo") +
+					blameCommit("0000000000000000000000000000000000000000", "not.committed.yet", 1559929997, 3, "  expr: sum(foo)")), nil
+			},
+			output: "alice@example.com",
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return []byte("b33a88cea35abc47f9973983626e1c6f3f3abc44 2 2 1\nauthor-time xxx\n"), nil
+			},
+			err: `failed to parse author time from "author-time xxx": strconv.ParseInt: parsing "xxx": invalid syntax`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			output, err := git.BlameAuthor(tc.mock, "foo.txt", 2, 4)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.output, output)
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	type testCaseT struct {
+		mock   git.CommandRunner
+		output map[string][]int
+		err    string
+	}
+
+	testCases := []testCaseT{
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return nil, errors.New("mock error")
+			},
+			err: "mock error",
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return nil, nil
+			},
+			output: map[string][]int{},
+		},
+		{
+			mock: func(args ...string) ([]byte, error) {
+				require.Equal(t, []string{
+					"-c", "core.quotePath=false",
+					"diff", "--no-color", "--no-ext-diff", "--relative", "--unified=0",
+					"--src-prefix=a/", "--dst-prefix=b/",
+					"HEAD",
+				}, args)
+				return []byte(`diff --git a/rules/foo.yml b/rules/foo.yml
+index 1e2d4b1..c0e4e62 100644
+--- a/rules/foo.yml
++++ b/rules/foo.yml
+@@ -2 +2 @@
+-  expr: sum(foo)
++  expr: sum(foo) by(job)
+@@ -5,0 +6,3 @@
++- record: bar
++  expr: sum(bar)
++  labels: {}
+@@ -9,2 +11,0 @@
+-- record: baz
+-  expr: sum(baz)
+diff --git a/rules/bar.yml b/rules/bar.yml
+deleted file mode 100644
+index 1e2d4b1..0000000
+--- a/rules/bar.yml
++++ /dev/null
+@@ -1,2 +0,0 @@
+-- record: bar
+-  expr: sum(bar)
+`), nil
+			},
+			output: map[string][]int{
+				"rules/foo.yml": {2, 6, 7, 8},
+			},
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return []byte("+++ b/rules/foo bar.yml\t\n@@ -1 +1 @@\n+++ \"b/rules/\\\"quoted\\\"\\tname\\303\\251.yml\"\n@@ -1,0 +2,2 @@\n"), nil
+			},
+			output: map[string][]int{
+				"rules/foo bar.yml":           {1},
+				"rules/\"quoted\"\tnameé.yml": {2, 3},
+			},
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return []byte("+++ \"b/foo.yml\n@@ -1 +1 @@\n"), nil
+			},
+			err: "invalid path in git diff output: \"b/foo.yml: invalid syntax",
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return []byte("+++ b/foo.yml\n@@ -1 +x @@\n"), nil
+			},
+			err: `invalid hunk header: "@@ -1 +x @@": strconv.Atoi: parsing "x": invalid syntax`,
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return []byte("+++ b/foo.yml\n@@ -1 @@\n"), nil
+			},
+			err: `invalid hunk header: "@@ -1 @@"`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			output, err := git.DiffLines(tc.mock)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.output, output)
+			}
+		})
+	}
+}
+
+func TestUntrackedFiles(t *testing.T) {
+	type testCaseT struct {
+		mock   git.CommandRunner
+		output []string
+		err    string
+	}
+
+	testCases := []testCaseT{
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return nil, errors.New("mock error")
+			},
+			err: "mock error",
+		},
+		{
+			mock: func(_ ...string) ([]byte, error) {
+				return nil, nil
+			},
+		},
+		{
+			mock: func(args ...string) ([]byte, error) {
+				require.Equal(t, []string{"ls-files", "-z", "--others", "--exclude-standard"}, args)
+				return []byte("rules/foo.yml\x00rules/new file.yml\x00"), nil
+			},
+			output: []string{"rules/foo.yml", "rules/new file.yml"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			output, err := git.UntrackedFiles(tc.mock)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.output, output)
+			}
+		})
+	}
+}
@@ -270,6 +270,12 @@ type Rule struct {
 	Error         ParseError
 	Comments      []comments.Comment
 	Lines         LineRange
+	// Group is the name of the rule group this rule was found in, it's empty
+	// if the rule wasn't defined inside a group.
+	Group string
+	// GroupInterval is the interval key of the rule group this rule was found in,
+	// it's nil if the group doesn't set a custom interval.
+	GroupInterval *YamlNode
 }
 
 func (r Rule) IsIdentical(b Rule) bool {
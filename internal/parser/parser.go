@@ -83,8 +83,16 @@ func parseNode(content []byte, node *yaml.Node, offset int) (rules []Rule) {
 			if !isEmpty {
 				rules = append(rules, rule)
 			} else {
+				group := ruleGroupName(root)
+				interval := ruleGroupInterval(root, offset)
 				for _, n := range root.Content {
-					rules = append(rules, parseNode(content, n, offset)...)
+					for _, r := range parseNode(content, n, offset) {
+						if r.Group == "" {
+							r.Group = group
+							r.GroupInterval = interval
+						}
+						rules = append(rules, r)
+					}
 				}
 			}
 		case yaml.ScalarNode:
@@ -100,6 +108,40 @@ func parseNode(content []byte, node *yaml.Node, offset int) (rules []Rule) {
 	return rules
 }
 
+// ruleGroupName returns the name of a rule group if given node is one,
+// or an empty string otherwise.
+func ruleGroupName(node *yaml.Node) (name string) {
+	var hasRules bool
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, val := node.Content[i], node.Content[i+1]
+		switch {
+		case key.Value == "name" && val.Kind == yaml.ScalarNode:
+			name = val.Value
+		case key.Value == "rules" && val.Kind == yaml.SequenceNode:
+			hasRules = true
+		}
+	}
+	if !hasRules {
+		return ""
+	}
+	return name
+}
+
+// ruleGroupInterval returns the interval key of a rule group if given node
+// is one and it has an interval set, or nil otherwise.
+func ruleGroupInterval(node *yaml.Node, offset int) *YamlNode {
+	if ruleGroupName(node) == "" {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, val := node.Content[i], node.Content[i+1]
+		if key.Value == "interval" && val.Kind == yaml.ScalarNode {
+			return newYamlNodeWithKey(key, val, offset)
+		}
+	}
+	return nil
+}
+
 func parseRule(content []byte, node *yaml.Node, offset int) (rule Rule, _ bool) {
 	if node.Kind != yaml.MappingNode {
 		return rule, true
@@ -437,6 +437,7 @@ groups:
 			output: []parser.Rule{
 				{
 					Lines: parser.LineRange{First: 5, Last: 9},
+					Group: "custom_rules",
 					RecordingRule: &parser.RecordingRule{
 						Record: parser.YamlNode{
 							Lines: parser.LineRange{First: 5, Last: 5},
@@ -648,6 +649,7 @@ data:
 			output: []parser.Rule{
 				{
 					Lines: parser.LineRange{First: 13, Last: 14},
+					Group: "example-app-alerts",
 					AlertingRule: &parser.AlertingRule{
 						Alert: parser.YamlNode{
 							Lines: parser.LineRange{First: 13, Last: 13},
@@ -663,6 +665,7 @@ data:
 				},
 				{
 					Lines: parser.LineRange{First: 27, Last: 28},
+					Group: "other alerts",
 					AlertingRule: &parser.AlertingRule{
 						Expr: parser.PromQLExpr{
 							Value: &parser.YamlNode{Value: "1", Lines: parser.LineRange{First: 28, Last: 28}},
@@ -700,6 +703,7 @@ data:
 			output: []parser.Rule{
 				{
 					Lines: parser.LineRange{First: 13, Last: 20},
+					Group: "example-app-alerts",
 					AlertingRule: &parser.AlertingRule{
 						Alert: parser.YamlNode{
 							Lines: parser.LineRange{First: 13, Last: 13},
@@ -767,6 +771,7 @@ data:
 				},
 				{
 					Lines: parser.LineRange{First: 22, Last: 23},
+					Group: "example-app-alerts",
 					AlertingRule: &parser.AlertingRule{
 						Alert: parser.YamlNode{
 							Lines: parser.LineRange{First: 22, Last: 22},
@@ -798,6 +803,7 @@ data:
 			output: []parser.Rule{
 				{
 					Lines: parser.LineRange{First: 4, Last: 13},
+					Group: "haproxy.api_server.rules",
 					AlertingRule: &parser.AlertingRule{
 						Alert: parser.YamlNode{
 							Lines: parser.LineRange{First: 4, Last: 4},
@@ -884,6 +890,7 @@ data:
 			output: []parser.Rule{
 				{
 					Lines: parser.LineRange{First: 6, Last: 7},
+					Group: "certmanager",
 					Comments: []comments.Comment{
 						{
 							Type:  comments.DisableType,
@@ -935,6 +942,7 @@ data:
 						},
 					},
 					Lines: parser.LineRange{First: 6, Last: 10},
+					Group: "certmanager",
 					RecordingRule: &parser.RecordingRule{
 						Record: parser.YamlNode{
 							Lines: parser.LineRange{First: 6, Last: 6},
@@ -972,6 +980,7 @@ data:
 						},
 					},
 					Lines: parser.LineRange{First: 6, Last: 7},
+					Group: "certmanager",
 					RecordingRule: &parser.RecordingRule{
 						Record: parser.YamlNode{
 							Lines: parser.LineRange{First: 6, Last: 6},
@@ -1004,6 +1013,7 @@ data:
 			output: []parser.Rule{
 				{
 					Lines: parser.LineRange{First: 4, Last: 8},
+					Group: "certmanager",
 					RecordingRule: &parser.RecordingRule{
 						Record: parser.YamlNode{
 							Lines: parser.LineRange{First: 4, Last: 4},
@@ -1054,6 +1064,7 @@ data:
 						},
 					},
 					Lines: parser.LineRange{First: 9, Last: 11},
+					Group: "certmanager",
 					RecordingRule: &parser.RecordingRule{
 						Record: parser.YamlNode{
 							Lines: parser.LineRange{First: 9, Last: 9},
@@ -1806,6 +1817,55 @@ data:
 				},
 			},
 		},
+		{
+			content: []byte(`
+groups:
+- name: fast
+  interval: 15s
+  rules:
+  - record: foo
+    expr: bar
+- name: default
+  rules:
+  - record: foo
+    expr: bar
+`),
+			output: []parser.Rule{
+				{
+					Lines:         parser.LineRange{First: 6, Last: 7},
+					Group:         "fast",
+					GroupInterval: &parser.YamlNode{Lines: parser.LineRange{First: 4, Last: 4}, Value: "15s"},
+					RecordingRule: &parser.RecordingRule{
+						Record: parser.YamlNode{
+							Lines: parser.LineRange{First: 6, Last: 6},
+							Value: "foo",
+						},
+						Expr: parser.PromQLExpr{
+							Value: &parser.YamlNode{
+								Lines: parser.LineRange{First: 7, Last: 7},
+								Value: "bar",
+							},
+						},
+					},
+				},
+				{
+					Lines: parser.LineRange{First: 10, Last: 11},
+					Group: "default",
+					RecordingRule: &parser.RecordingRule{
+						Record: parser.YamlNode{
+							Lines: parser.LineRange{First: 10, Last: 10},
+							Value: "foo",
+						},
+						Expr: parser.PromQLExpr{
+							Value: &parser.YamlNode{
+								Lines: parser.LineRange{First: 11, Last: 11},
+								Value: "bar",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	alwaysEqual := cmp.Comparer(func(_, _ interface{}) bool { return true })
@@ -102,6 +102,8 @@ func ReadContent(r io.Reader) (out Content, fileComments []comments.Comment, err
 					// pass
 				case comments.RuleSetType:
 					// pass
+				case comments.IgnoreType:
+					// pass
 				case comments.InvalidComment:
 					fileComments = append(fileComments, comment)
 				}
@@ -0,0 +1,144 @@
+package promapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prymitive/current"
+)
+
+type BuildInfoResult struct {
+	URI       string
+	PublicURI string
+	BuildInfo v1.BuildinfoResult
+}
+
+type buildInfoQuery struct {
+	prom      *Prometheus
+	ctx       context.Context
+	timestamp time.Time
+}
+
+func (q buildInfoQuery) Run() queryResult {
+	slog.Debug("Getting prometheus build info", slog.String("uri", q.prom.safeURI))
+
+	ctx, cancel := q.prom.requestContext(q.ctx)
+	defer cancel()
+
+	var qr queryResult
+
+	args := url.Values{}
+	resp, err := q.prom.doRequest(ctx, http.MethodGet, q.Endpoint(), args)
+	if err != nil {
+		qr.err = fmt.Errorf("failed to query Prometheus build info: %w", err)
+		return qr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		qr.err = tryDecodingAPIError(resp)
+		return qr
+	}
+
+	info, err := streamBuildInfo(resp.Body)
+	qr.value, qr.err = info, err
+	return qr
+}
+
+func (q buildInfoQuery) Endpoint() string {
+	return "/api/v1/status/buildinfo"
+}
+
+func (q buildInfoQuery) String() string {
+	return "/api/v1/status/buildinfo"
+}
+
+func (q buildInfoQuery) CacheKey() uint64 {
+	return hash(q.prom.unsafeURI, q.Endpoint())
+}
+
+func (q buildInfoQuery) CacheTTL() time.Duration {
+	return time.Minute * 10
+}
+
+func (p *Prometheus) BuildInfo(ctx context.Context) (*BuildInfoResult, error) {
+	slog.Debug("Scheduling Prometheus build info query", slog.String("uri", p.safeURI))
+
+	key := "/api/v1/status/buildinfo"
+	p.locker.lock(key)
+	defer p.locker.unlock(key)
+
+	resultChan := make(chan queryResult)
+	p.queries <- queryRequest{
+		query:  buildInfoQuery{prom: p, ctx: ctx, timestamp: time.Now()},
+		result: resultChan,
+	}
+
+	result := <-resultChan
+	if result.err != nil {
+		return nil, QueryError{err: result.err, msg: decodeError(result.err)}
+	}
+
+	r := BuildInfoResult{
+		URI:       p.safeURI,
+		PublicURI: p.publicURI,
+		BuildInfo: result.value.(v1.BuildinfoResult),
+	}
+
+	return &r, nil
+}
+
+func streamBuildInfo(r io.Reader) (info v1.BuildinfoResult, err error) {
+	defer dummyReadAll(r)
+
+	var status, errType, errText string
+	decoder := current.Object(
+		current.Key("status", current.Value(func(s string, _ bool) {
+			status = s
+		})),
+		current.Key("error", current.Value(func(s string, _ bool) {
+			errText = s
+		})),
+		current.Key("errorType", current.Value(func(s string, _ bool) {
+			errType = s
+		})),
+		current.Key("data", current.Object(
+			current.Key("version", current.Value(func(s string, _ bool) {
+				info.Version = s
+			})),
+			current.Key("revision", current.Value(func(s string, _ bool) {
+				info.Revision = s
+			})),
+			current.Key("branch", current.Value(func(s string, _ bool) {
+				info.Branch = s
+			})),
+			current.Key("buildUser", current.Value(func(s string, _ bool) {
+				info.BuildUser = s
+			})),
+			current.Key("buildDate", current.Value(func(s string, _ bool) {
+				info.BuildDate = s
+			})),
+			current.Key("goVersion", current.Value(func(s string, _ bool) {
+				info.GoVersion = s
+			})),
+		)),
+	)
+
+	dec := json.NewDecoder(r)
+	if err = decoder.Stream(dec); err != nil {
+		return info, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: fmt.Sprintf("JSON parse error: %s", err)}
+	}
+
+	if status != "success" {
+		return info, APIError{Status: status, ErrorType: decodeErrorType(errType), Err: errText}
+	}
+
+	return info, nil
+}
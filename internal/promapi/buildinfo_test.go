@@ -0,0 +1,123 @@
+package promapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func TestBuildInfo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/default/api/v1/status/buildinfo":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{}}`))
+		case "/v2/api/v1/status/buildinfo":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{"version":"2.45.0","revision":"8ef767e396bf8445f009f945b0162fd71827f445","branch":"HEAD","buildUser":"root@920118f645b7","buildDate":"20230623-15:09:49","goVersion":"go1.20.5"}}`))
+		case "/slow/api/v1/status/buildinfo":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			time.Sleep(time.Second * 2)
+			_, _ = w.Write([]byte(`{"status":"success","data":{}}`))
+		case "/error/api/v1/status/buildinfo":
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte("fake error\n"))
+		case "/badJson/api/v1/status/buildinfo":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":[]}`))
+		default:
+			w.WriteHeader(400)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"unhandled path"}`))
+		}
+	}))
+	defer srv.Close()
+
+	type testCaseT struct {
+		info    promapi.BuildInfoResult
+		prefix  string
+		err     string
+		timeout time.Duration
+	}
+
+	testCases := []testCaseT{
+		{
+			prefix:  "/default",
+			timeout: time.Second,
+			info: promapi.BuildInfoResult{
+				URI:       srv.URL + "/default",
+				PublicURI: srv.URL + "/default",
+				BuildInfo: v1.BuildinfoResult{},
+			},
+		},
+		{
+			prefix:  "/v2",
+			timeout: time.Second,
+			info: promapi.BuildInfoResult{
+				URI:       srv.URL + "/v2",
+				PublicURI: srv.URL + "/v2",
+				BuildInfo: v1.BuildinfoResult{
+					Version:   "2.45.0",
+					Revision:  "8ef767e396bf8445f009f945b0162fd71827f445",
+					Branch:    "HEAD",
+					BuildUser: "root@920118f645b7",
+					BuildDate: "20230623-15:09:49",
+					GoVersion: "go1.20.5",
+				},
+			},
+		},
+		{
+			prefix:  "/slow",
+			timeout: time.Millisecond * 10,
+			err:     "connection timeout",
+		},
+		{
+			prefix:  "/error",
+			timeout: time.Second,
+			err:     "server_error: server error: 500",
+		},
+		{
+			prefix:  "/badJson",
+			timeout: time.Second,
+			err:     "bad_response: JSON parse error: invalid token at offset 28 decoded by Object{version,revision,branch,buildUser,buildDate,goVersion}, expected {, got [",
+		},
+		{
+			prefix:  "/other",
+			timeout: time.Second,
+			err:     "bad_data: unhandled path",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strings.TrimPrefix(tc.prefix, "/"), func(t *testing.T) {
+			fg := promapi.NewFailoverGroup("test", srv.URL+tc.prefix, []*promapi.Prometheus{
+				promapi.NewPrometheus("test", srv.URL+tc.prefix, "", nil, tc.timeout, 1, 100, nil),
+			}, true, "up", nil, nil, nil)
+
+			reg := prometheus.NewRegistry()
+			fg.StartWorkers(reg)
+			defer fg.Close(reg)
+
+			info, err := fg.BuildInfo(context.Background())
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err, tc)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, *info, tc.info)
+			}
+		})
+	}
+}
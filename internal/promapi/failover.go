@@ -79,6 +79,15 @@ func (fg *FailoverGroup) PublicURI() string {
 	return fg.publicURI
 }
 
+// PrimaryURI returns the URI of the first server in this group,
+// which is the one queried first, before trying any failover servers.
+func (fg *FailoverGroup) PrimaryURI() string {
+	if len(fg.servers) == 0 {
+		return ""
+	}
+	return fg.servers[0].safeURI
+}
+
 func (fg *FailoverGroup) Include() []string {
 	sl := []string{}
 	for _, re := range fg.pathsInclude {
@@ -256,3 +265,63 @@ func (fg *FailoverGroup) Flags(ctx context.Context) (flags *FlagsResult, err err
 	}
 	return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
 }
+
+func (fg *FailoverGroup) TSDBStatus(ctx context.Context, cacheTTL time.Duration) (status *TSDBStatusResult, err error) {
+	var uri string
+	for _, prom := range fg.servers {
+		uri = prom.safeURI
+		status, err = prom.TSDBStatus(ctx, cacheTTL)
+		if err == nil {
+			return status, nil
+		}
+		if !IsUnavailableError(err) {
+			return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
+		}
+	}
+	return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
+}
+
+func (fg *FailoverGroup) Targets(ctx context.Context) (targets *TargetsResult, err error) {
+	var uri string
+	for _, prom := range fg.servers {
+		uri = prom.safeURI
+		targets, err = prom.Targets(ctx)
+		if err == nil {
+			return targets, nil
+		}
+		if !IsUnavailableError(err) {
+			return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
+		}
+	}
+	return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
+}
+
+func (fg *FailoverGroup) BuildInfo(ctx context.Context) (info *BuildInfoResult, err error) {
+	var uri string
+	for _, prom := range fg.servers {
+		uri = prom.safeURI
+		info, err = prom.BuildInfo(ctx)
+		if err == nil {
+			return info, nil
+		}
+		if !IsUnavailableError(err) {
+			return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
+		}
+	}
+	return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
+}
+
+func (fg *FailoverGroup) LabelValues(ctx context.Context, label string) (values *LabelValuesResult, err error) {
+	var uri string
+	for _, prom := range fg.servers {
+		uri = prom.safeURI
+		values, err = prom.LabelValues(ctx, label)
+		if err == nil {
+			return values, nil
+		}
+		if !IsUnavailableError(err) {
+			return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
+		}
+	}
+	return nil, &FailoverGroupError{err: err, uri: uri, isStrict: fg.strictErrors}
+}
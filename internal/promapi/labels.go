@@ -0,0 +1,136 @@
+package promapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prymitive/current"
+)
+
+type LabelValuesResult struct {
+	URI       string
+	PublicURI string
+	Values    []string
+}
+
+type labelValuesQuery struct {
+	timestamp time.Time
+	ctx       context.Context
+	prom      *Prometheus
+	label     string
+}
+
+func (q labelValuesQuery) Run() queryResult {
+	slog.Debug(
+		"Getting prometheus label values",
+		slog.String("uri", q.prom.safeURI),
+		slog.String("label", q.label),
+	)
+
+	ctx, cancel := q.prom.requestContext(q.ctx)
+	defer cancel()
+
+	var qr queryResult
+
+	args := url.Values{}
+	resp, err := q.prom.doRequest(ctx, http.MethodGet, q.Endpoint(), args)
+	if err != nil {
+		qr.err = fmt.Errorf("failed to query Prometheus label values: %w", err)
+		return qr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		qr.err = tryDecodingAPIError(resp)
+		return qr
+	}
+
+	values, err := streamLabelValues(resp.Body)
+	qr.value, qr.err = values, err
+	return qr
+}
+
+func (q labelValuesQuery) Endpoint() string {
+	return fmt.Sprintf("/api/v1/label/%s/values", q.label)
+}
+
+func (q labelValuesQuery) String() string {
+	return q.Endpoint()
+}
+
+func (q labelValuesQuery) CacheKey() uint64 {
+	return hash(q.prom.unsafeURI, q.Endpoint())
+}
+
+func (q labelValuesQuery) CacheTTL() time.Duration {
+	return time.Minute * 10
+}
+
+func (p *Prometheus) LabelValues(ctx context.Context, label string) (*LabelValuesResult, error) {
+	slog.Debug("Scheduling Prometheus label values query", slog.String("uri", p.safeURI), slog.String("label", label))
+
+	key := fmt.Sprintf("/api/v1/label/%s/values", label)
+	p.locker.lock(key)
+	defer p.locker.unlock(key)
+
+	resultChan := make(chan queryResult)
+	p.queries <- queryRequest{
+		query:  labelValuesQuery{prom: p, ctx: ctx, label: label, timestamp: time.Now()},
+		result: resultChan,
+	}
+
+	result := <-resultChan
+	if result.err != nil {
+		return nil, QueryError{err: result.err, msg: decodeError(result.err)}
+	}
+
+	r := LabelValuesResult{
+		URI:       p.safeURI,
+		PublicURI: p.publicURI,
+		Values:    result.value.([]string),
+	}
+
+	return &r, nil
+}
+
+func streamLabelValues(r io.Reader) (values []string, err error) {
+	defer dummyReadAll(r)
+
+	var status, errType, errText, value string
+	values = []string{}
+	decoder := current.Object(
+		current.Key("status", current.Value(func(s string, _ bool) {
+			status = s
+		})),
+		current.Key("error", current.Value(func(s string, _ bool) {
+			errText = s
+		})),
+		current.Key("errorType", current.Value(func(s string, _ bool) {
+			errType = s
+		})),
+		current.Key("data", current.Array(
+			&value,
+			func() {
+				values = append(values, value)
+			},
+		)),
+	)
+
+	dec := json.NewDecoder(r)
+	if err = decoder.Stream(dec); err != nil {
+		return nil, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: fmt.Sprintf("JSON parse error: %s", err)}
+	}
+
+	if status != "success" {
+		return nil, APIError{Status: status, ErrorType: decodeErrorType(errType), Err: errText}
+	}
+
+	return values, nil
+}
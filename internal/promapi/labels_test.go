@@ -0,0 +1,115 @@
+package promapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func TestLabelValues(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/empty/api/v1/label/__name__/values":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":[]}`))
+		case "/names/api/v1/label/__name__/values":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":["foo","http_requests_total","up"]}`))
+		case "/slow/api/v1/label/__name__/values":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			time.Sleep(time.Second * 2)
+			_, _ = w.Write([]byte(`{"status":"success","data":[]}`))
+		case "/error/api/v1/label/__name__/values":
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte("fake error\n"))
+		case "/badJson/api/v1/label/__name__/values":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{}}`))
+		default:
+			w.WriteHeader(400)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"unhandled path"}`))
+		}
+	}))
+	defer srv.Close()
+
+	type testCaseT struct {
+		prefix  string
+		err     string
+		values  promapi.LabelValuesResult
+		timeout time.Duration
+	}
+
+	testCases := []testCaseT{
+		{
+			prefix:  "/empty",
+			timeout: time.Second,
+			values: promapi.LabelValuesResult{
+				URI:       srv.URL + "/empty",
+				PublicURI: srv.URL + "/empty",
+				Values:    []string{},
+			},
+		},
+		{
+			prefix:  "/names",
+			timeout: time.Second,
+			values: promapi.LabelValuesResult{
+				URI:       srv.URL + "/names",
+				PublicURI: srv.URL + "/names",
+				Values:    []string{"foo", "http_requests_total", "up"},
+			},
+		},
+		{
+			prefix:  "/slow",
+			timeout: time.Millisecond * 10,
+			err:     "connection timeout",
+		},
+		{
+			prefix:  "/error",
+			timeout: time.Second,
+			err:     "server_error: server error: 500",
+		},
+		{
+			prefix:  "/badJson",
+			timeout: time.Second,
+			err:     "bad_response: JSON parse error: invalid token at offset 28 decoded by Array[string], expected [, got {",
+		},
+		{
+			prefix:  "/other",
+			timeout: time.Second,
+			err:     "bad_data: unhandled path",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strings.TrimPrefix(tc.prefix, "/"), func(t *testing.T) {
+			fg := promapi.NewFailoverGroup("test", srv.URL+tc.prefix, []*promapi.Prometheus{
+				promapi.NewPrometheus("test", srv.URL+tc.prefix, "", nil, tc.timeout, 1, 100, nil),
+			}, true, "up", nil, nil, nil)
+
+			reg := prometheus.NewRegistry()
+			fg.StartWorkers(reg)
+			defer fg.Close(reg)
+
+			values, err := fg.LabelValues(context.Background(), "__name__")
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err, tc)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, *values, tc.values)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package promapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func newOAuth2Transport(cfg *clientcredentials.Config, base http.RoundTripper, timeout time.Duration) *oauth2Transport {
+	return &oauth2Transport{
+		config: cfg,
+		base:   base,
+		ctx: context.WithValue(
+			context.Background(),
+			oauth2.HTTPClient,
+			&http.Client{Transport: base, Timeout: timeout},
+		),
+	}
+}
+
+type oauth2Transport struct {
+	ctx    context.Context
+	base   http.RoundTripper
+	config *clientcredentials.Config
+	source oauth2.TokenSource
+	mtx    sync.Mutex
+}
+
+func (t *oauth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	src := t.tokenSource()
+	resp, err := t.roundTrip(req, req.Body, src)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// Token might had been revoked before it expired, get a new one and retry.
+	// We can only retry if we can send the same request body again.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+	var body io.ReadCloser
+	if req.GetBody != nil {
+		if body, err = req.GetBody(); err != nil {
+			return resp, nil
+		}
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	t.resetTokenSource(src)
+	return t.roundTrip(req, body, t.tokenSource())
+}
+
+func (t *oauth2Transport) roundTrip(req *http.Request, body io.ReadCloser, src oauth2.TokenSource) (*http.Response, error) {
+	token, err := src.Token()
+	if err != nil {
+		if body != nil {
+			body.Close()
+		}
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+	token.SetAuthHeader(r)
+	return t.base.RoundTrip(r)
+}
+
+func (t *oauth2Transport) tokenSource() oauth2.TokenSource {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.source == nil {
+		t.source = t.config.TokenSource(t.ctx)
+	}
+	return t.source
+}
+
+// resetTokenSource drops cached token, unless some other request already did it.
+func (t *oauth2Transport) resetTokenSource(src oauth2.TokenSource) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.source == src {
+		t.source = nil
+	}
+}
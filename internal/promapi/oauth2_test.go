@@ -0,0 +1,114 @@
+package promapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func TestOAuth2(t *testing.T) {
+	var mtx sync.Mutex
+	var issued int
+	var valid string
+
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "client", user)
+		require.Equal(t, "secret", pass)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		require.Equal(t, "read write", r.Form.Get("scope"))
+
+		mtx.Lock()
+		issued++
+		token := fmt.Sprintf("token-%d", issued)
+		mtx.Unlock()
+
+		if token == "token-4" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"access_token":%q,"token_type":"Bearer","expires_in":3600}`, token)))
+	}))
+	defer tokenSrv.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.NotEmpty(t, r.Form.Get("query"))
+
+		mtx.Lock()
+		auth := "Bearer " + valid
+		mtx.Unlock()
+
+		if r.Header.Get("Authorization") != auth {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("unauthorized\n"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+	}))
+	defer srv.Close()
+
+	prom := promapi.NewPrometheus(
+		"test", srv.URL, "", nil, time.Second, 1, 100, nil,
+		promapi.WithOAuth2("client", "secret", tokenSrv.URL, []string{"read", "write"}),
+	)
+	prom.StartWorkers()
+	defer prom.Close()
+
+	type testCaseT struct {
+		valid  string
+		err    string
+		issued int
+	}
+
+	testCases := []testCaseT{
+		// First request fetches a new token.
+		{valid: "token-1", issued: 1},
+		// Token is cached.
+		{valid: "token-1", issued: 1},
+		// Token was revoked, we get a new one and retry.
+		{valid: "token-2", issued: 2},
+		// New token is also rejected.
+		{valid: "token-100", issued: 3, err: "client_error: client error: 401"},
+		// Token endpoint fails.
+		{
+			valid:  "token-100",
+			issued: 4,
+			err:    fmt.Sprintf("Post \"%s/api/v1/query\": oauth2: cannot fetch token: 400 Bad Request\nResponse: {\"error\":\"invalid_client\"}", srv.URL),
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			mtx.Lock()
+			valid = tc.valid
+			mtx.Unlock()
+
+			_, err := prom.Query(context.Background(), fmt.Sprintf("foo%d", i))
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mtx.Lock()
+			require.Equal(t, tc.issued, issued)
+			mtx.Unlock()
+		})
+	}
+}
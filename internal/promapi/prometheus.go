@@ -16,6 +16,7 @@ import (
 	"github.com/cespare/xxhash/v2"
 	"github.com/klauspost/compress/gzhttp"
 	"go.uber.org/ratelimit"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
 type PrometheusContextKey string
@@ -46,8 +47,9 @@ type querier interface {
 }
 
 type queryRequest struct {
-	query  querier
-	result chan queryResult
+	query   querier
+	result  chan queryResult
+	noCache bool
 }
 
 type queryResult struct {
@@ -105,12 +107,42 @@ type Prometheus struct {
 	concurrency int
 }
 
-func NewPrometheus(name, uri, publicURI string, headers map[string]string, timeout time.Duration, concurrency, rl int, tlsConf *tls.Config) *Prometheus {
+type PrometheusOption func(*prometheusOptions)
+
+type prometheusOptions struct {
+	oauth2 *clientcredentials.Config
+}
+
+// WithOAuth2 will make all requests use bearer tokens obtained from tokenURL
+// using OAuth2 client credentials flow.
+// Tokens are refreshed when they expire or when Prometheus responds with 401.
+func WithOAuth2(clientID, clientSecret, tokenURL string, scopes []string) PrometheusOption {
+	return func(opts *prometheusOptions) {
+		opts.oauth2 = &clientcredentials.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			TokenURL:     tokenURL,
+			Scopes:       scopes,
+		}
+	}
+}
+
+func NewPrometheus(name, uri, publicURI string, headers map[string]string, timeout time.Duration, concurrency, rl int, tlsConf *tls.Config, opts ...PrometheusOption) *Prometheus {
+	var options prometheusOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	if tlsConf != nil {
 		transport.TLSClientConfig = tlsConf
 	}
 
+	var rt http.RoundTripper = gzhttp.Transport(transport)
+	if options.oauth2 != nil {
+		rt = newOAuth2Transport(options.oauth2, rt, timeout)
+	}
+
 	uri = strings.TrimSuffix(uri, "/")
 	publicURI = strings.TrimSuffix(publicURI, "/")
 	if publicURI == "" {
@@ -124,7 +156,7 @@ func NewPrometheus(name, uri, publicURI string, headers map[string]string, timeo
 		safeURI:     sanitizeURI(uri),
 		headers:     headers,
 		timeout:     timeout,
-		client:      http.Client{Transport: gzhttp.Transport(transport)},
+		client:      http.Client{Transport: rt},
 		locker:      newPartitionLocker((&sync.Mutex{})),
 		rateLimiter: ratelimit.New(rl),
 		concurrency: concurrency,
@@ -204,7 +236,7 @@ func queryWorker(prom *Prometheus, queries chan queryRequest) {
 
 func processJob(prom *Prometheus, job queryRequest) queryResult {
 	cacheKey := job.query.CacheKey()
-	if prom.cache != nil {
+	if prom.cache != nil && !job.noCache {
 		if cached, ok := prom.cache.get(cacheKey, job.query.Endpoint()); ok {
 			return cached.(queryResult)
 		}
@@ -231,7 +263,7 @@ func processJob(prom *Prometheus, job queryRequest) queryResult {
 		return result
 	}
 
-	if prom.cache != nil {
+	if prom.cache != nil && !job.noCache {
 		prom.cache.set(cacheKey, result, job.query.CacheTTL())
 	}
 
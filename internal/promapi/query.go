@@ -114,10 +114,26 @@ type Sample struct {
 }
 
 func streamSamples(r io.Reader) (samples []Sample, stats QueryStats, err error) {
+	samples = []Sample{}
+	stats, err = decodeSamples(r, false, func(s Sample) {
+		samples = append(samples, s)
+	})
+	if err != nil {
+		return nil, stats, err
+	}
+	return samples, stats, nil
+}
+
+// decodeSamples decodes instant query response and calls fn for every
+// sample as soon as it's decoded, without buffering the whole result.
+// If validate is true then fn is only called after a successful status
+// and vector result type were decoded, samples that come before that are
+// dropped and an error is returned.
+func decodeSamples(r io.Reader, validate bool, fn func(Sample)) (stats QueryStats, err error) {
 	defer dummyReadAll(r)
 
 	var status, resultType, errType, errText string
-	samples = []Sample{}
+	var isUnordered bool
 	var sample model.Sample
 	decoder := current.Object(
 		current.Key("status", current.Value(func(s string, _ bool) {
@@ -136,10 +152,14 @@ func streamSamples(r io.Reader) (samples []Sample, stats QueryStats, err error)
 			current.Key("result", current.Array(
 				&sample,
 				func() {
-					samples = append(samples, Sample{
-						Labels: MetricToLabels(sample.Metric),
-						Value:  float64(sample.Value),
-					})
+					if validate && (status != "success" || resultType != "vector") {
+						isUnordered = true
+					} else {
+						fn(Sample{
+							Labels: MetricToLabels(sample.Metric),
+							Value:  float64(sample.Value),
+						})
+					}
 					sample.Metric = model.Metric{}
 				},
 			)),
@@ -178,16 +198,20 @@ func streamSamples(r io.Reader) (samples []Sample, stats QueryStats, err error)
 
 	dec := json.NewDecoder(r)
 	if err = decoder.Stream(dec); err != nil {
-		return nil, stats, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: fmt.Sprintf("JSON parse error: %s", err)}
+		return stats, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: fmt.Sprintf("JSON parse error: %s", err)}
 	}
 
 	if status != "success" {
-		return nil, stats, APIError{Status: status, ErrorType: decodeErrorType(errType), Err: errText}
+		return stats, APIError{Status: status, ErrorType: decodeErrorType(errType), Err: errText}
 	}
 
 	if resultType != "vector" {
-		return nil, stats, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: fmt.Sprintf("invalid result type, expected vector, got %s", resultType)}
+		return stats, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: fmt.Sprintf("invalid result type, expected vector, got %s", resultType)}
 	}
 
-	return samples, stats, nil
+	if isUnordered {
+		return stats, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: "result was received before status and resultType"}
+	}
+
+	return stats, nil
 }
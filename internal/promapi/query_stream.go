@@ -0,0 +1,144 @@
+package promapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// QuerySample is a single sample returned by a streamed instant query.
+type QuerySample = Sample
+
+type streamQuery struct {
+	timestamp time.Time
+	ctx       context.Context
+	prom      *Prometheus
+	out       chan<- QuerySample
+	expr      string
+}
+
+func (q streamQuery) Run() queryResult {
+	slog.Debug(
+		"Running streamed prometheus query",
+		slog.String("uri", q.prom.safeURI),
+		slog.String("query", q.expr),
+	)
+
+	ctx, cancel := q.prom.requestContext(q.ctx)
+	defer cancel()
+
+	var qr queryResult
+
+	args := url.Values{}
+	args.Set("query", q.expr)
+	args.Set("timeout", q.prom.timeout.String())
+	if !q.timestamp.IsZero() {
+		args.Set("time", formatTime(q.timestamp))
+	}
+	resp, err := q.prom.doRequest(ctx, http.MethodPost, q.Endpoint(), args)
+	if err != nil {
+		qr.err = err
+		return qr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		qr.err = tryDecodingAPIError(resp)
+		return qr
+	}
+
+	var sent int
+	qr.stats, qr.err = decodeSamples(resp.Body, true, func(s Sample) {
+		if ctx.Err() != nil {
+			return
+		}
+		select {
+		case q.out <- s:
+			sent++
+		case <-ctx.Done():
+		}
+	})
+	if qr.err == nil {
+		qr.err = ctx.Err()
+	}
+	qr.value = sent
+	return qr
+}
+
+func (q streamQuery) Endpoint() string {
+	return "/api/v1/query"
+}
+
+func (q streamQuery) String() string {
+	return q.expr
+}
+
+func (q streamQuery) CacheKey() uint64 {
+	return hash(q.prom.unsafeURI, q.Endpoint(), q.expr, formatTime(q.timestamp))
+}
+
+func (q streamQuery) CacheTTL() time.Duration {
+	return 0
+}
+
+// queryStream schedules a streamed instant query on the worker queue and
+// waits for it to finish, results are never cached.
+// It returns the number of samples sent to out.
+func (p *Prometheus) queryStream(ctx context.Context, expr string, ts time.Time, out chan<- QuerySample) (sent int, err error) {
+	slog.Debug("Scheduling streamed prometheus query", slog.String("uri", p.safeURI), slog.String("query", expr))
+
+	resultChan := make(chan queryResult)
+	p.queries <- queryRequest{
+		query:   streamQuery{prom: p, ctx: ctx, expr: expr, timestamp: ts, out: out},
+		result:  resultChan,
+		noCache: true,
+	}
+
+	result := <-resultChan
+	if result.value != nil {
+		sent = result.value.(int)
+	}
+	return sent, result.err
+}
+
+// QueryStream runs an instant query and returns decoded samples one by one
+// without buffering the whole result in memory, which is useful for queries
+// returning a large number of time series.
+// The samples channel is closed once the query is done, after that the error
+// channel will receive an error, if there was one, and will be closed too.
+// If a server is unavailable then the next one is tried, but only if no
+// samples were sent yet. An error is returned if there are no servers.
+func (fg *FailoverGroup) QueryStream(ctx context.Context, expr string, ts time.Time) (<-chan QuerySample, <-chan error) {
+	samples := make(chan QuerySample)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(samples)
+
+		if len(fg.servers) == 0 {
+			errs <- fmt.Errorf("no Prometheus servers configured for %q", fg.name)
+			return
+		}
+
+		var uri string
+		var err error
+		for _, prom := range fg.servers {
+			uri = prom.safeURI
+			var sent int
+			sent, err = prom.queryStream(ctx, expr, ts, samples)
+			if err == nil {
+				return
+			}
+			if sent > 0 || !IsUnavailableError(err) {
+				break
+			}
+		}
+		errs <- &FailoverGroupError{err: QueryError{err: err, msg: decodeError(err)}, uri: uri, isStrict: fg.strictErrors}
+	}()
+
+	return samples, errs
+}
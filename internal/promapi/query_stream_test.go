@@ -0,0 +1,188 @@
+package promapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func TestQueryStream(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.URL.Path == "/down/api/v1/query" {
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte("down"))
+			return
+		}
+
+		switch r.Form.Get("query") {
+		case "three_results":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"status":"success",
+				"data":{
+					"resultType":"vector",
+					"result":[
+						{"metric":{"instance": "1"},"value":[1614859502.068,"1"]},
+						{"metric":{"instance": "2"},"value":[1614859502.168,"2"]},
+						{"metric":{"instance": "3"},"value":[1614859503.000,"3"]}
+					]
+				}
+			}`))
+		case "time":
+			if r.Form.Get("time") != "1614859502" {
+				w.WriteHeader(400)
+				_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"bad time"}`))
+				return
+			}
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"status":"success",
+				"data":{
+					"resultType":"vector",
+					"result":[{"metric":{},"value":[1614859502,"1"]}]
+				}
+			}`))
+		case "unordered":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"data":{
+					"result":[{"metric":{"instance": "1"},"value":[1614859502.068,"1"]}],
+					"resultType":"vector"
+				},
+				"status":"success"
+			}`))
+		case "matrix":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"status":"success",
+				"data":{
+					"resultType":"matrix",
+					"result":[]
+				}
+			}`))
+		default:
+			w.WriteHeader(400)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"status":"error",
+				"errorType":"bad_data",
+				"error":"unhandled query"
+			}`))
+		}
+	}))
+	defer srv.Close()
+
+	type testCaseT struct {
+		description string
+		servers     []string
+		query       string
+		ts          time.Time
+		samples     []promapi.QuerySample
+		err         string
+	}
+
+	testCases := []testCaseT{
+		{
+			description: "three results",
+			servers:     []string{srv.URL},
+			query:       "three_results",
+			samples: []promapi.QuerySample{
+				{Labels: labels.FromStrings("instance", "1"), Value: 1},
+				{Labels: labels.FromStrings("instance", "2"), Value: 2},
+				{Labels: labels.FromStrings("instance", "3"), Value: 3},
+			},
+		},
+		{
+			description: "with timestamp",
+			servers:     []string{srv.URL},
+			query:       "time",
+			ts:          time.Unix(1614859502, 0),
+			samples: []promapi.QuerySample{
+				{Labels: labels.EmptyLabels(), Value: 1},
+			},
+		},
+		{
+			description: "error",
+			servers:     []string{srv.URL},
+			query:       "error",
+			err:         "bad_data: unhandled query",
+		},
+		{
+			description: "matrix",
+			servers:     []string{srv.URL},
+			query:       "matrix",
+			err:         "bad_response: invalid result type, expected vector, got matrix",
+		},
+		{
+			description: "result before status",
+			servers:     []string{srv.URL},
+			query:       "unordered",
+			err:         "bad_response: result was received before status and resultType",
+		},
+		{
+			description: "failover",
+			servers:     []string{srv.URL + "/down", srv.URL},
+			query:       "three_results",
+			samples: []promapi.QuerySample{
+				{Labels: labels.FromStrings("instance", "1"), Value: 1},
+				{Labels: labels.FromStrings("instance", "2"), Value: 2},
+				{Labels: labels.FromStrings("instance", "3"), Value: 3},
+			},
+		},
+		{
+			description: "all servers down",
+			servers:     []string{srv.URL + "/down"},
+			query:       "three_results",
+			err:         "server_error: server error: 500",
+		},
+		{
+			description: "no servers",
+			query:       "three_results",
+			err:         `no Prometheus servers configured for "test"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			servers := make([]*promapi.Prometheus, 0, len(tc.servers))
+			for _, uri := range tc.servers {
+				servers = append(servers, promapi.NewPrometheus("test", uri, uri, nil, time.Second, 1, 100, nil))
+			}
+			fg := promapi.NewFailoverGroup("test", srv.URL, servers, true, "up", nil, nil, nil)
+			reg := prometheus.NewRegistry()
+			fg.StartWorkers(reg)
+			defer fg.Close(reg)
+
+			var samples []promapi.QuerySample
+			sc, ec := fg.QueryStream(context.Background(), tc.query, tc.ts)
+			for s := range sc {
+				samples = append(samples, s)
+			}
+			err := <-ec
+
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.samples, samples)
+		})
+	}
+}
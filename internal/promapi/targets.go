@@ -0,0 +1,167 @@
+package promapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/prymitive/current"
+)
+
+type Target struct {
+	Labels         map[string]string
+	ScrapePool     string
+	ScrapeInterval time.Duration
+}
+
+type TargetsResult struct {
+	URI       string
+	PublicURI string
+	Targets   []Target
+}
+
+type targetsQuery struct {
+	prom      *Prometheus
+	ctx       context.Context
+	timestamp time.Time
+}
+
+func (q targetsQuery) Run() queryResult {
+	slog.Debug("Getting prometheus targets", slog.String("uri", q.prom.safeURI))
+
+	ctx, cancel := q.prom.requestContext(q.ctx)
+	defer cancel()
+
+	var qr queryResult
+
+	args := url.Values{}
+	args.Set("state", "active")
+	resp, err := q.prom.doRequest(ctx, http.MethodGet, q.Endpoint(), args)
+	if err != nil {
+		qr.err = fmt.Errorf("failed to query Prometheus targets: %w", err)
+		return qr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		qr.err = tryDecodingAPIError(resp)
+		return qr
+	}
+
+	qr.value, qr.err = streamTargets(resp.Body)
+	return qr
+}
+
+func (q targetsQuery) Endpoint() string {
+	return "/api/v1/targets"
+}
+
+func (q targetsQuery) String() string {
+	return "/api/v1/targets"
+}
+
+func (q targetsQuery) CacheKey() uint64 {
+	return hash(q.prom.unsafeURI, q.Endpoint())
+}
+
+func (q targetsQuery) CacheTTL() time.Duration {
+	return time.Minute * 10
+}
+
+// Targets returns the list of all active scrape targets.
+func (p *Prometheus) Targets(ctx context.Context) (*TargetsResult, error) {
+	slog.Debug("Scheduling Prometheus targets query", slog.String("uri", p.safeURI))
+
+	key := "/api/v1/targets"
+	p.locker.lock(key)
+	defer p.locker.unlock(key)
+
+	resultChan := make(chan queryResult)
+	p.queries <- queryRequest{
+		query:  targetsQuery{prom: p, ctx: ctx, timestamp: time.Now()},
+		result: resultChan,
+	}
+
+	result := <-resultChan
+	if result.err != nil {
+		return nil, QueryError{err: result.err, msg: decodeError(result.err)}
+	}
+
+	r := TargetsResult{
+		URI:       p.safeURI,
+		PublicURI: p.publicURI,
+		Targets:   result.value.([]Target),
+	}
+
+	return &r, nil
+}
+
+// JobScrapeInterval returns the longest scrape interval used by targets
+// with given job label, or zero if there are no such targets.
+func (tr TargetsResult) JobScrapeInterval(job string) (interval time.Duration) {
+	for _, t := range tr.Targets {
+		if t.Labels[model.JobLabel] == job && t.ScrapeInterval > interval {
+			interval = t.ScrapeInterval
+		}
+	}
+	return interval
+}
+
+type targetEntry struct {
+	Labels         map[string]string `json:"labels"`
+	ScrapePool     string            `json:"scrapePool"`
+	ScrapeInterval string            `json:"scrapeInterval"`
+}
+
+func streamTargets(r io.Reader) (targets []Target, err error) {
+	defer dummyReadAll(r)
+
+	var status, errType, errText string
+	var target targetEntry
+	var skip struct{}
+	targets = []Target{}
+	decoder := current.Object(
+		current.Key("status", current.Value(func(s string, _ bool) {
+			status = s
+		})),
+		current.Key("error", current.Value(func(s string, _ bool) {
+			errText = s
+		})),
+		current.Key("errorType", current.Value(func(s string, _ bool) {
+			errType = s
+		})),
+		current.Key("data", current.Object(
+			current.Key("activeTargets", current.Array(&target, func() {
+				t := Target{
+					Labels:     target.Labels,
+					ScrapePool: target.ScrapePool,
+				}
+				if d, err := model.ParseDuration(target.ScrapeInterval); err == nil {
+					t.ScrapeInterval = time.Duration(d)
+				}
+				targets = append(targets, t)
+				target.Labels = nil
+				target.ScrapeInterval = ""
+			})),
+			current.Key("droppedTargets", current.Array(&skip, func() {})),
+		)),
+	)
+
+	dec := json.NewDecoder(r)
+	if err = decoder.Stream(dec); err != nil {
+		return nil, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: fmt.Sprintf("JSON parse error: %s", err)}
+	}
+
+	if status != "success" {
+		return nil, APIError{Status: status, ErrorType: decodeErrorType(errType), Err: errText}
+	}
+
+	return targets, nil
+}
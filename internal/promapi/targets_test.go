@@ -0,0 +1,123 @@
+package promapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func TestTargets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/default/api/v1/targets":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{
+"activeTargets":[
+  {"discoveredLabels":{"__address__":"127.0.0.1:9090"},"labels":{"instance":"127.0.0.1:9090","job":"prometheus"},"scrapePool":"prometheus","scrapeUrl":"http://127.0.0.1:9090/metrics","lastError":"","health":"up","scrapeInterval":"15s","scrapeTimeout":"10s"},
+  {"discoveredLabels":{"__address__":"127.0.0.1:9100"},"labels":{"instance":"127.0.0.1:9100","job":"node"},"scrapePool":"node","scrapeUrl":"http://127.0.0.1:9100/metrics","lastError":"","health":"up","scrapeInterval":"1m","scrapeTimeout":"10s"},
+  {"discoveredLabels":{"__address__":"127.0.0.2:9100"},"labels":{"instance":"127.0.0.2:9100","job":"node"},"scrapePool":"node-slow","scrapeUrl":"http://127.0.0.2:9100/metrics","lastError":"","health":"up","scrapeInterval":"2m","scrapeTimeout":"10s"}
+],
+"droppedTargets":[{"discoveredLabels":{"__address__":"127.0.0.3:9100"}}]
+}}`))
+		case "/slow/api/v1/targets":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			time.Sleep(time.Second * 2)
+			_, _ = w.Write([]byte(`{"status":"success","data":{"activeTargets":[]}}`))
+		case "/error/api/v1/targets":
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte("fake error\n"))
+		case "/badJson/api/v1/targets":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{"activeTargets":{}}}`))
+		default:
+			w.WriteHeader(400)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"unhandled path"}`))
+		}
+	}))
+	defer srv.Close()
+
+	type testCaseT struct {
+		targets promapi.TargetsResult
+		prefix  string
+		err     string
+		timeout time.Duration
+	}
+
+	testCases := []testCaseT{
+		{
+			prefix:  "/default",
+			timeout: time.Second,
+			targets: promapi.TargetsResult{
+				URI:       srv.URL + "/default",
+				PublicURI: srv.URL + "/default",
+				Targets: []promapi.Target{
+					{
+						Labels:         map[string]string{"instance": "127.0.0.1:9090", "job": "prometheus"},
+						ScrapePool:     "prometheus",
+						ScrapeInterval: time.Second * 15,
+					},
+					{
+						Labels:         map[string]string{"instance": "127.0.0.1:9100", "job": "node"},
+						ScrapePool:     "node",
+						ScrapeInterval: time.Minute,
+					},
+					{
+						Labels:         map[string]string{"instance": "127.0.0.2:9100", "job": "node"},
+						ScrapePool:     "node-slow",
+						ScrapeInterval: time.Minute * 2,
+					},
+				},
+			},
+		},
+		{
+			prefix:  "/slow",
+			timeout: time.Millisecond * 10,
+			err:     "connection timeout",
+		},
+		{
+			prefix:  "/error",
+			timeout: time.Second,
+			err:     "server_error: server error: 500",
+		},
+		{
+			prefix:  "/badJson",
+			timeout: time.Second,
+			err:     "bad_response: JSON parse error: invalid token at offset 45 decoded by Array[promapi.targetEntry], expected [, got {",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strings.TrimPrefix(tc.prefix, "/"), func(t *testing.T) {
+			fg := promapi.NewFailoverGroup("test", srv.URL+tc.prefix, []*promapi.Prometheus{
+				promapi.NewPrometheus("test", srv.URL+tc.prefix, "", nil, tc.timeout, 1, 100, nil),
+			}, true, "up", nil, nil, nil)
+
+			reg := prometheus.NewRegistry()
+			fg.StartWorkers(reg)
+			defer fg.Close(reg)
+
+			targets, err := fg.Targets(context.Background())
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err, tc)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.targets, *targets)
+				require.Equal(t, time.Minute*2, targets.JobScrapeInterval("node"))
+				require.Equal(t, time.Second*15, targets.JobScrapeInterval("prometheus"))
+				require.Equal(t, time.Duration(0), targets.JobScrapeInterval("unknown"))
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package promapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/prymitive/current"
+)
+
+type TSDBHeadStats struct {
+	NumSeries     int
+	NumLabelPairs int
+	ChunkCount    int
+	MinTime       int64
+	MaxTime       int64
+}
+
+type TSDBStatusResult struct {
+	URI                  string
+	PublicURI            string
+	StorageRetentionSize string
+	HeadStats            TSDBHeadStats
+	RetentionDuration    time.Duration
+}
+
+type tsdbQuery struct {
+	prom      *Prometheus
+	ctx       context.Context
+	timestamp time.Time
+	cacheTTL  time.Duration
+}
+
+func (q tsdbQuery) Run() queryResult {
+	slog.Debug("Getting prometheus TSDB status", slog.String("uri", q.prom.safeURI))
+
+	ctx, cancel := q.prom.requestContext(q.ctx)
+	defer cancel()
+
+	var qr queryResult
+
+	args := url.Values{}
+	resp, err := q.prom.doRequest(ctx, http.MethodGet, q.Endpoint(), args)
+	if err != nil {
+		qr.err = fmt.Errorf("failed to query Prometheus TSDB status: %w", err)
+		return qr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		qr.err = tryDecodingAPIError(resp)
+		return qr
+	}
+
+	qr.value, qr.err = streamTSDBStatus(resp.Body)
+	return qr
+}
+
+func (q tsdbQuery) Endpoint() string {
+	return "/api/v1/status/tsdb"
+}
+
+func (q tsdbQuery) String() string {
+	return "/api/v1/status/tsdb"
+}
+
+func (q tsdbQuery) CacheKey() uint64 {
+	return hash(q.prom.unsafeURI, q.Endpoint())
+}
+
+func (q tsdbQuery) CacheTTL() time.Duration {
+	return q.cacheTTL
+}
+
+// TSDBStatus returns TSDB head stats together with retention settings.
+// Retention is read from Prometheus flags and will be left empty if
+// flags cannot be queried.
+func (p *Prometheus) TSDBStatus(ctx context.Context, cacheTTL time.Duration) (*TSDBStatusResult, error) {
+	slog.Debug("Scheduling Prometheus TSDB status query", slog.String("uri", p.safeURI))
+
+	key := "/api/v1/status/tsdb"
+	p.locker.lock(key)
+	defer p.locker.unlock(key)
+
+	if cacheTTL == 0 {
+		cacheTTL = time.Minute
+	}
+
+	resultChan := make(chan queryResult)
+	p.queries <- queryRequest{
+		query:  tsdbQuery{prom: p, ctx: ctx, timestamp: time.Now(), cacheTTL: cacheTTL},
+		result: resultChan,
+	}
+
+	result := <-resultChan
+	if result.err != nil {
+		return nil, QueryError{err: result.err, msg: decodeError(result.err)}
+	}
+
+	r := TSDBStatusResult{
+		URI:       p.safeURI,
+		PublicURI: p.publicURI,
+		HeadStats: result.value.(TSDBHeadStats),
+	}
+
+	flags, err := p.Flags(ctx)
+	if err != nil {
+		slog.Debug("Cannot get Prometheus retention from flags", slog.String("uri", p.safeURI), slog.Any("err", err))
+		return &r, nil
+	}
+	if v, ok := flags.Flags["storage.tsdb.retention.time"]; ok {
+		if d, err := model.ParseDuration(v); err == nil {
+			r.RetentionDuration = time.Duration(d)
+		}
+	}
+	if v, ok := flags.Flags["storage.tsdb.retention.size"]; ok {
+		r.StorageRetentionSize = v
+	}
+
+	return &r, nil
+}
+
+func streamTSDBStatus(r io.Reader) (stats TSDBHeadStats, err error) {
+	defer dummyReadAll(r)
+
+	var status, errType, errText string
+	var skip struct{}
+	decoder := current.Object(
+		current.Key("status", current.Value(func(s string, _ bool) {
+			status = s
+		})),
+		current.Key("error", current.Value(func(s string, _ bool) {
+			errText = s
+		})),
+		current.Key("errorType", current.Value(func(s string, _ bool) {
+			errType = s
+		})),
+		current.Key("data", current.Object(
+			current.Key("headStats", current.Object(
+				current.Key("numSeries", current.Value(func(v float64, _ bool) {
+					stats.NumSeries = int(v)
+				})),
+				current.Key("numLabelPairs", current.Value(func(v float64, _ bool) {
+					stats.NumLabelPairs = int(v)
+				})),
+				current.Key("chunkCount", current.Value(func(v float64, _ bool) {
+					stats.ChunkCount = int(v)
+				})),
+				current.Key("minTime", current.Value(func(v float64, _ bool) {
+					stats.MinTime = int64(v)
+				})),
+				current.Key("maxTime", current.Value(func(v float64, _ bool) {
+					stats.MaxTime = int64(v)
+				})),
+			)),
+			current.Key("seriesCountByMetricName", current.Array(&skip, func() {})),
+			current.Key("labelValueCountByLabelName", current.Array(&skip, func() {})),
+			current.Key("memoryInBytesByLabelName", current.Array(&skip, func() {})),
+			current.Key("seriesCountByLabelValuePair", current.Array(&skip, func() {})),
+		)),
+	)
+
+	dec := json.NewDecoder(r)
+	if err = decoder.Stream(dec); err != nil {
+		return stats, APIError{Status: status, ErrorType: v1.ErrBadResponse, Err: fmt.Sprintf("JSON parse error: %s", err)}
+	}
+
+	if status != "success" {
+		return stats, APIError{Status: status, ErrorType: decodeErrorType(errType), Err: errText}
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,130 @@
+package promapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/promapi"
+)
+
+func TestTSDBStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/default/api/v1/status/tsdb", "/noflags/api/v1/status/tsdb":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{
+"headStats":{"numSeries":508,"numLabelPairs":1234,"chunkCount":937,"minTime":1591516800000,"maxTime":1598896800143},
+"seriesCountByMetricName":[{"name":"net_conntrack_dialer_conn_failed_total","value":20}],
+"labelValueCountByLabelName":[{"name":"__name__","value":211}],
+"memoryInBytesByLabelName":[{"name":"__name__","value":8266}],
+"seriesCountByLabelValuePair":[{"name":"job=prometheus","value":425}]
+}}`))
+		case "/default/api/v1/status/flags":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{"storage.tsdb.retention.time":"15d","storage.tsdb.retention.size":"512MB"}}`))
+		case "/slow/api/v1/status/tsdb":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			time.Sleep(time.Second * 2)
+			_, _ = w.Write([]byte(`{"status":"success","data":{}}`))
+		case "/error/api/v1/status/tsdb":
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte("fake error\n"))
+		case "/badJson/api/v1/status/tsdb":
+			w.WriteHeader(200)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{"headStats":[]}}`))
+		default:
+			w.WriteHeader(400)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"unhandled path"}`))
+		}
+	}))
+	defer srv.Close()
+
+	type testCaseT struct {
+		status  promapi.TSDBStatusResult
+		prefix  string
+		err     string
+		timeout time.Duration
+	}
+
+	testCases := []testCaseT{
+		{
+			prefix:  "/default",
+			timeout: time.Second,
+			status: promapi.TSDBStatusResult{
+				URI:       srv.URL + "/default",
+				PublicURI: srv.URL + "/default",
+				HeadStats: promapi.TSDBHeadStats{
+					NumSeries:     508,
+					NumLabelPairs: 1234,
+					ChunkCount:    937,
+					MinTime:       1591516800000,
+					MaxTime:       1598896800143,
+				},
+				RetentionDuration:    time.Hour * 24 * 15,
+				StorageRetentionSize: "512MB",
+			},
+		},
+		{
+			prefix:  "/noflags",
+			timeout: time.Second,
+			status: promapi.TSDBStatusResult{
+				URI:       srv.URL + "/noflags",
+				PublicURI: srv.URL + "/noflags",
+				HeadStats: promapi.TSDBHeadStats{
+					NumSeries:     508,
+					NumLabelPairs: 1234,
+					ChunkCount:    937,
+					MinTime:       1591516800000,
+					MaxTime:       1598896800143,
+				},
+			},
+		},
+		{
+			prefix:  "/slow",
+			timeout: time.Millisecond * 10,
+			err:     "connection timeout",
+		},
+		{
+			prefix:  "/error",
+			timeout: time.Second,
+			err:     "server_error: server error: 500",
+		},
+		{
+			prefix:  "/badJson",
+			timeout: time.Second,
+			err:     "bad_response: JSON parse error: invalid token at offset 41 decoded by Object{numSeries,numLabelPairs,chunkCount,minTime,maxTime}, expected {, got [",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strings.TrimPrefix(tc.prefix, "/"), func(t *testing.T) {
+			fg := promapi.NewFailoverGroup("test", srv.URL+tc.prefix, []*promapi.Prometheus{
+				promapi.NewPrometheus("test", srv.URL+tc.prefix, "", nil, tc.timeout, 1, 100, nil),
+			}, true, "up", nil, nil, nil)
+
+			reg := prometheus.NewRegistry()
+			fg.StartWorkers(reg)
+			defer fg.Close(reg)
+
+			status, err := fg.TSDBStatus(context.Background(), 0)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err, tc)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.status, *status)
+			}
+		})
+	}
+}
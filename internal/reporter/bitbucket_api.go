@@ -619,6 +619,12 @@ func (bb bitBucketAPI) makeComments(summary Summary, changes *bitBucketPRChanges
 				buf.WriteString(report.Path.Name)
 				buf.WriteString("`.\n\n")
 			}
+			if report.Author != "" {
+				buf.WriteString(":bust_in_silhouette: This rule was last modified by ")
+				buf.WriteRune('`')
+				buf.WriteString(report.Author)
+				buf.WriteString("`.\n\n")
+			}
 		}
 		if mergeDetails && reports[0].Problem.Details != "" {
 			buf.WriteString("------\n\n")
@@ -230,6 +230,51 @@ func TestBitBucketMakeComments(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "report with author",
+			maxComments: 50,
+			summary: Summary{reports: []Report{
+				{
+					Path: discovery.Path{
+						SymlinkTarget: "rule.yaml",
+						Name:          "rule.yaml",
+					},
+					ModifiedLines: []int{2, 3},
+					Author:        "bob@example.com",
+					Problem: checks.Problem{
+						Severity: checks.Bug,
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Text:     "first error",
+						Details:  "first details",
+						Reporter: "r1",
+					},
+				},
+			}},
+			changes: &bitBucketPRChanges{
+				pathModifiedLines: map[string][]int{
+					"rule.yaml": {2, 3},
+				},
+				pathLineMapping: map[string]map[int]int{
+					"rule.yaml": {2: 2, 3: 3},
+				},
+			},
+			comments: []BitBucketPendingComment{
+				{
+					Text:     commentBody("stop_sign", "Bug", "r1", "first error\n\nfirst details\n\n:bust_in_silhouette: This rule was last modified by `bob@example.com`."),
+					Severity: "BLOCKER",
+					Anchor: BitBucketPendingCommentAnchor{
+						Path:     "rule.yaml",
+						Line:     2,
+						LineType: "ADDED",
+						FileType: "TO",
+						DiffType: "EFFECTIVE",
+					},
+				},
+			},
+		},
 		{
 			description: "dedup reporter",
 			maxComments: 50,
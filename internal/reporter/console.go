@@ -69,8 +69,13 @@ func (cr ConsoleReporter) Submit(summary Summary) (err error) {
 			path = fmt.Sprintf("%s ~> %s", report.Path.Name, report.Path.SymlinkTarget)
 		}
 		path = color.CyanString("%s:%s", path, report.Problem.Lines)
-		if report.Problem.Anchor == checks.AnchorBefore {
-			path += " " + color.RedString("(deleted)")
+		switch report.Problem.Anchor {
+		case checks.AnchorBefore:
+			// Reported lines point to the file content before the change.
+			path = color.CyanString("▲ ") + path + " " + color.RedString("(deleted)")
+		case checks.AnchorAfter:
+			// Reported lines point to the current file content.
+			path = color.CyanString("▼ ") + path
 		}
 		path += " "
 
@@ -81,11 +86,18 @@ func (cr ConsoleReporter) Submit(summary Summary) (err error) {
 		case checks.Warning:
 			msg = append(msg, color.YellowString("%s: %s", report.Problem.Severity, report.Problem.Text))
 		case checks.Information:
-			msg = append(msg, color.HiBlackString("%s: %s", report.Problem.Severity, report.Problem.Text))
+			msg = append(msg, color.BlueString("%s: %s", report.Problem.Severity, report.Problem.Text))
 		}
-		msg = append(msg, color.MagentaString(" (%s)\n", report.Problem.Reporter))
+		msg = append(msg, color.MagentaString(" (%s)", report.Problem.Reporter))
+		if report.Author != "" {
+			msg = append(msg, color.WhiteString(" author: %s", report.Author))
+		}
+		if report.Problem.SuppressedBy != "" {
+			msg = append(msg, color.WhiteString(" suppressed: %s", report.Problem.SuppressedBy))
+		}
+		msg = append(msg, "\n")
 
-		if report.Problem.Anchor == checks.AnchorAfter {
+		if report.Problem.Anchor == checks.AnchorAfter && content != "" {
 			lines := strings.Split(content, "\n")
 			lastLine := report.Problem.Lines.Last
 			if lastLine > len(lines)-1 {
@@ -0,0 +1,136 @@
+package reporter_test
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/neilotoole/slogt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/reporter"
+)
+
+func TestConsoleReporter(t *testing.T) {
+	type testCaseT struct {
+		description string
+		output      string
+		err         string
+		summary     reporter.Summary
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rules.yml")
+	require.NoError(t, os.WriteFile(path, []byte("- record: target is down\n  expr: up == 0\n"), 0o644))
+
+	p := parser.NewParser()
+	mockRules, _ := p.Parse([]byte(`
+- record: target is down
+  expr: up == 0
+`))
+
+	testCases := []testCaseT{
+		{
+			description: "no reports",
+			summary:     reporter.Summary{},
+			output:      "",
+		},
+		{
+			description: "anchor after",
+			summary: reporter.NewSummary([]reporter.Report{
+				{
+					Path: discovery.Path{
+						SymlinkTarget: path,
+						Name:          path,
+					},
+					ModifiedLines: []int{2},
+					Rule:          mockRules[0],
+					Problem: checks.Problem{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: "mock",
+						Text:     "mock text",
+						Severity: checks.Bug,
+						Anchor:   checks.AnchorAfter,
+					},
+				},
+			}),
+			output: "▼ " + path + ":2 Bug: mock text (mock)\n 2 |   expr: up == 0\n\n",
+		},
+		{
+			description: "anchor before",
+			summary: reporter.NewSummary([]reporter.Report{
+				{
+					Path: discovery.Path{
+						SymlinkTarget: path,
+						Name:          path,
+					},
+					ModifiedLines: []int{2},
+					Rule:          mockRules[0],
+					Problem: checks.Problem{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  3,
+						},
+						Reporter: "mock",
+						Text:     "mock text",
+						Severity: checks.Warning,
+						Anchor:   checks.AnchorBefore,
+					},
+				},
+			}),
+			output: "▲ " + path + ":2-3 (deleted) Warning: mock text (mock)\n\n",
+		},
+		{
+			description: "missing file",
+			summary: reporter.NewSummary([]reporter.Report{
+				{
+					Path: discovery.Path{
+						SymlinkTarget: filepath.Join(dir, "missing.yml"),
+						Name:          filepath.Join(dir, "missing.yml"),
+					},
+					ModifiedLines: []int{2},
+					Rule:          mockRules[0],
+					Problem: checks.Problem{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: "mock",
+						Text:     "mock text",
+						Severity: checks.Bug,
+						Anchor:   checks.AnchorAfter,
+					},
+				},
+			}),
+			err: "open " + filepath.Join(dir, "missing.yml") + ": no such file or directory",
+		},
+	}
+
+	color.NoColor = true
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			slog.SetDefault(slogt.New(t))
+
+			out := bytes.NewBuffer(nil)
+
+			reporter := reporter.NewConsoleReporter(out, checks.Information)
+			err := reporter.Submit(tc.summary)
+
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.output, out.String())
+			}
+		})
+	}
+}
@@ -309,6 +309,9 @@ func reportToGitHubComment(headCommit string, rep Report) *github.PullRequestCom
 	if rep.Problem.Details != "" {
 		msgSuffix = "\n\n" + rep.Problem.Details
 	}
+	if rep.Author != "" {
+		msgSuffix += fmt.Sprintf("\n\nThis rule was last modified by `%s`.", rep.Author)
+	}
 
 	var side string
 	if rep.Problem.Anchor == checks.AnchorBefore {
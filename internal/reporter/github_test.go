@@ -347,6 +347,59 @@ filename %s
 				},
 			},
 		},
+		{
+			description: "comment with author",
+			owner:       "foo",
+			repo:        "bar",
+			token:       "something",
+			prNum:       123,
+			maxComments: 50,
+			timeout:     time.Second,
+			gitCmd: func(args ...string) ([]byte, error) {
+				if args[0] == "rev-parse" {
+					return []byte("fake-commit-id"), nil
+				}
+				if args[0] == "blame" {
+					content := blameLine("fake-commit-id", 2, "foo.txt", "up == 0")
+					return []byte(content), nil
+				}
+				return nil, nil
+			},
+			httpHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost && r.URL.Path == "/api/v3/repos/foo/bar/pulls/123/comments" {
+					body, _ := io.ReadAll(r.Body)
+					b := strings.TrimSpace(strings.TrimRight(string(body), "\n\t\r"))
+					switch b {
+					case `{"body":":stop_sign: [mock](https://cloudflare.github.io/pint/checks/mock.html): syntax error\n\nsyntax details\n\nThis rule was last modified by ` + "`bob@example.com`" + `.","path":"foo.txt","line":2,"side":"RIGHT","commit_id":"fake-commit-id"}`:
+					default:
+						t.Errorf("Unexpected comment: %s", b)
+					}
+				}
+				_, _ = w.Write([]byte(""))
+			}),
+			reports: []reporter.Report{
+				{
+					Path: discovery.Path{
+						Name:          "foo.txt",
+						SymlinkTarget: "foo.txt",
+					},
+
+					ModifiedLines: []int{2},
+					Rule:          mockRules[1],
+					Author:        "bob@example.com",
+					Problem: checks.Problem{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: "mock",
+						Text:     "syntax error",
+						Details:  "syntax details",
+						Severity: checks.Fatal,
+					},
+				},
+			},
+		},
 		{
 			description: "maxComments 2",
 			owner:       "foo",
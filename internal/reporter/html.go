@@ -0,0 +1,241 @@
+package reporter
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/pint/internal/checks"
+)
+
+var (
+	htmlYamlKey = regexp.MustCompile(`^(\s*(?:-\s+)?)([^\s#:'"][^:#]*?)(:)(\s|$)`)
+
+	htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>pint report</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #24292f; }
+h1 { font-size: 1.6em; }
+h2 { font-size: 1.2em; margin-top: 2em; border-bottom: 1px solid #d0d7de; padding-bottom: .3em; }
+.filters label { margin-right: 1em; }
+.problem { margin: .5em 0; }
+.problem a { color: #0969da; text-decoration: none; font-family: monospace; }
+.badge { display: inline-block; min-width: 6em; padding: 0 .4em; border-radius: 1em; color: #fff; font-size: .85em; text-align: center; }
+.badge.Fatal, .badge.Bug { background: #cf222e; }
+.badge.Warning { background: #bf8700; }
+.badge.Information { background: #0969da; }
+.reporter { color: #8250df; }
+.suppressed { color: #6e7781; }
+.details { margin: .3em 0 0 7em; color: #57606a; white-space: pre-wrap; }
+pre { background: #f6f8fa; padding: .5em 0; overflow-x: auto; }
+pre span.line { display: block; padding: 0 1em; }
+pre span.line:target, pre span.line.marked { background: #fff8c5; }
+pre span.nr { display: inline-block; width: 4em; color: #6e7781; user-select: none; }
+pre span.gap { color: #6e7781; }
+.yaml-key { color: #0550ae; }
+.yaml-comment { color: #6e7781; font-style: italic; }
+</style>
+</head>
+<body>
+<h1>pint report</h1>
+<div class="filters">
+{{- range .Severities }}
+<label><input type="checkbox" data-severity="{{ . }}" checked> {{ . }}</label>
+{{- end }}
+</div>
+{{- range $file := .Files }}
+<h2>{{ $file.Path }}</h2>
+{{- range $file.Problems }}
+<div class="problem" data-severity="{{ .Severity }}">
+<span class="badge {{ .Severity }}">{{ .Severity }}</span>
+{{ if .Deleted }}<a>{{ $file.Path }}:{{ .Lines }} (deleted)</a>{{ else }}<a href="#{{ $file.ID }}-L{{ .Line }}">{{ $file.Path }}:{{ .Lines }}</a>{{ end }}
+{{ .Text }} <span class="reporter">({{ .Reporter }})</span>
+{{- if .SuppressedBy }} <span class="suppressed">suppressed: {{ .SuppressedBy }}</span>{{ end }}
+{{- if .Details }}
+<div class="details">{{ .Details }}</div>
+{{- end }}
+</div>
+{{- end }}
+{{- if $file.Lines }}
+<pre>
+{{- range $file.Lines }}
+{{- if .Gap }}<span class="line"><span class="nr gap">...</span></span>{{ else }}<span class="line{{ if .Marked }} marked{{ end }}" id="{{ $file.ID }}-L{{ .Number }}"><span class="nr">{{ .Number }}</span>{{ .Content }}</span>{{ end }}
+{{- end }}
+</pre>
+{{- end }}
+{{- end }}
+<script>
+document.querySelectorAll(".filters input").forEach(function (input) {
+  input.addEventListener("change", function () {
+    document.querySelectorAll('.problem[data-severity="' + input.dataset.severity + '"]').forEach(function (p) {
+      p.style.display = input.checked ? "" : "none";
+    });
+  });
+});
+</script>
+</body>
+</html>
+`))
+)
+
+func NewHTMLReporter(output io.Writer, minSeverity checks.Severity) HTMLReporter {
+	return HTMLReporter{output: output, minSeverity: minSeverity}
+}
+
+// HTMLReporter writes all problems as a single self-contained HTML page.
+type HTMLReporter struct {
+	output      io.Writer
+	minSeverity checks.Severity
+}
+
+type htmlProblem struct {
+	Severity     string
+	Lines        string
+	Line         int
+	Deleted      bool
+	Text         string
+	Details      string
+	Reporter     string
+	SuppressedBy string
+}
+
+type htmlLine struct {
+	Number  int
+	Content template.HTML
+	Marked  bool
+	Gap     bool
+}
+
+type htmlFile struct {
+	ID       string
+	Path     string
+	Problems []htmlProblem
+	Lines    []htmlLine
+	source   string
+}
+
+func (hr HTMLReporter) Submit(summary Summary) (err error) {
+	reports := summary.Reports()
+	sort.SliceStable(reports, func(i, j int) bool {
+		if reports[i].Path.Name != reports[j].Path.Name {
+			return reports[i].Path.Name < reports[j].Path.Name
+		}
+		if reports[i].Problem.Lines.First != reports[j].Problem.Lines.First {
+			return reports[i].Problem.Lines.First < reports[j].Problem.Lines.First
+		}
+		if reports[i].Problem.Reporter != reports[j].Problem.Reporter {
+			return reports[i].Problem.Reporter < reports[j].Problem.Reporter
+		}
+		return reports[i].Problem.Text < reports[j].Problem.Text
+	})
+
+	var files []htmlFile
+	visible := map[int][]int{}
+	marked := map[int][]int{}
+	for _, report := range reports {
+		if report.Problem.Severity < hr.minSeverity {
+			continue
+		}
+
+		path := report.Path.Name
+		if report.Path.Name != report.Path.SymlinkTarget {
+			path = fmt.Sprintf("%s ~> %s", report.Path.Name, report.Path.SymlinkTarget)
+		}
+		if len(files) == 0 || files[len(files)-1].Path != path {
+			files = append(files, htmlFile{ID: fmt.Sprintf("f%d", len(files)+1), Path: path})
+		}
+		idx := len(files) - 1
+
+		files[idx].Problems = append(files[idx].Problems, htmlProblem{
+			Severity:     report.Problem.Severity.String(),
+			Lines:        report.Problem.Lines.String(),
+			Line:         report.Problem.Lines.First,
+			Deleted:      report.Problem.Anchor == checks.AnchorBefore,
+			Text:         report.Problem.Text,
+			Details:      report.Problem.Details,
+			Reporter:     report.Problem.Reporter,
+			SuppressedBy: report.Problem.SuppressedBy,
+		})
+
+		if report.Problem.Anchor != checks.AnchorAfter {
+			continue
+		}
+		for i := report.Rule.Lines.First; i <= report.Rule.Lines.Last; i++ {
+			visible[idx] = append(visible[idx], i)
+		}
+		for i := report.Problem.Lines.First; i <= report.Problem.Lines.Last; i++ {
+			visible[idx] = append(visible[idx], i)
+			marked[idx] = append(marked[idx], i)
+		}
+		files[idx].source = report.Path.Name
+	}
+
+	for idx := range files {
+		if files[idx].source == "" {
+			continue
+		}
+		content, err := readFile(files[idx].source)
+		if err != nil {
+			return err
+		}
+		files[idx].Lines = htmlSnippet(strings.Split(content, "\n"), visible[idx], marked[idx])
+	}
+
+	severities := []string{}
+	for _, s := range []checks.Severity{checks.Fatal, checks.Bug, checks.Warning, checks.Information} {
+		if s >= hr.minSeverity {
+			severities = append(severities, s.String())
+		}
+	}
+
+	return htmlTemplate.Execute(hr.output, struct {
+		Severities []string
+		Files      []htmlFile
+	}{
+		Severities: severities,
+		Files:      files,
+	})
+}
+
+func htmlSnippet(lines []string, visible, marked []int) (snippet []htmlLine) {
+	slices.Sort(visible)
+	visible = slices.Compact(visible)
+	var last int
+	for _, nr := range visible {
+		if nr < 1 || nr > len(lines) {
+			continue
+		}
+		if last > 0 && nr > last+1 {
+			snippet = append(snippet, htmlLine{Gap: true})
+		}
+		snippet = append(snippet, htmlLine{
+			Number:  nr,
+			Content: highlightYAML(lines[nr-1]),
+			Marked:  slices.Contains(marked, nr),
+		})
+		last = nr
+	}
+	return snippet
+}
+
+// highlightYAML adds very basic syntax highlighting to a single line of YAML,
+// marking mapping keys and full line comments.
+func highlightYAML(line string) template.HTML {
+	if strings.HasPrefix(strings.TrimSpace(line), "#") {
+		return template.HTML(`<span class="yaml-comment">` + html.EscapeString(line) + `</span>`) // nolint: gosec
+	}
+	if m := htmlYamlKey.FindStringSubmatchIndex(line); m != nil {
+		return template.HTML(html.EscapeString(line[:m[4]]) + // nolint: gosec
+			`<span class="yaml-key">` + html.EscapeString(line[m[4]:m[5]]) + `</span>` +
+			html.EscapeString(line[m[5]:]))
+	}
+	return template.HTML(html.EscapeString(line)) // nolint: gosec
+}
@@ -0,0 +1,139 @@
+package reporter_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/neilotoole/slogt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudflare/pint/internal/checks"
+	"github.com/cloudflare/pint/internal/discovery"
+	"github.com/cloudflare/pint/internal/parser"
+	"github.com/cloudflare/pint/internal/reporter"
+)
+
+func TestHTMLReporter(t *testing.T) {
+	type testCaseT struct {
+		description string
+		minSeverity checks.Severity
+		contains    []string
+		excludes    []string
+		err         string
+		summary     reporter.Summary
+	}
+
+	p := parser.NewParser()
+	mockRules, _ := p.Parse([]byte(`
+- record: target is down
+  expr: up == 0
+`))
+
+	testCases := []testCaseT{
+		{
+			description: "no reports",
+			summary:     reporter.Summary{},
+			contains:    []string{"<!DOCTYPE html>", "</html>"},
+			excludes:    []string{"<h2>", "<pre>"},
+		},
+		{
+			description: "deleted rule",
+			summary: reporter.NewSummary([]reporter.Report{
+				{
+					Path: discovery.Path{
+						SymlinkTarget: "foo.txt",
+						Name:          "foo.txt",
+					},
+					Rule: mockRules[0],
+					Problem: checks.Problem{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  3,
+						},
+						Reporter: "mock",
+						Text:     "mock <text>",
+						Severity: checks.Bug,
+						Anchor:   checks.AnchorBefore,
+					},
+				},
+			}),
+			contains: []string{
+				"<h2>foo.txt</h2>",
+				`<span class="badge Bug">Bug</span>`,
+				"<a>foo.txt:2-3 (deleted)</a>",
+				"mock &lt;text&gt; <span class=\"reporter\">(mock)</span>",
+			},
+			excludes: []string{"<pre>"},
+		},
+		{
+			description: "below min severity",
+			minSeverity: checks.Warning,
+			summary: reporter.NewSummary([]reporter.Report{
+				{
+					Path: discovery.Path{
+						SymlinkTarget: "foo.txt",
+						Name:          "foo.txt",
+					},
+					Rule: mockRules[0],
+					Problem: checks.Problem{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: "mock",
+						Text:     "mock text",
+						Severity: checks.Information,
+					},
+				},
+			}),
+			contains: []string{`data-severity="Warning"`},
+			excludes: []string{"<h2>", "mock text", `data-severity="Information"`},
+		},
+		{
+			description: "missing file",
+			summary: reporter.NewSummary([]reporter.Report{
+				{
+					Path: discovery.Path{
+						SymlinkTarget: "this/file/doesnt/exist.yml",
+						Name:          "this/file/doesnt/exist.yml",
+					},
+					Rule: mockRules[0],
+					Problem: checks.Problem{
+						Lines: parser.LineRange{
+							First: 2,
+							Last:  2,
+						},
+						Reporter: "mock",
+						Text:     "mock text",
+						Severity: checks.Bug,
+					},
+				},
+			}),
+			err: "open this/file/doesnt/exist.yml: no such file or directory",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			slog.SetDefault(slogt.New(t))
+
+			out := bytes.NewBuffer(nil)
+
+			reporter := reporter.NewHTMLReporter(out, tc.minSeverity)
+			err := reporter.Submit(tc.summary)
+
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				for _, s := range tc.contains {
+					require.Contains(t, out.String(), s)
+				}
+				for _, s := range tc.excludes {
+					require.NotContains(t, out.String(), s)
+				}
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 type Report struct {
 	Path          discovery.Path
 	Owner         string
+	Author        string
 	ModifiedLines []int
 	Rule          parser.Rule
 	Problem       checks.Problem
@@ -96,12 +97,26 @@ func (s *Summary) SortReports() {
 	})
 }
 
+// FilterReports removes all reports for which keep returns false.
+func (s *Summary) FilterReports(keep func(Report) bool) {
+	reports := make([]Report, 0, len(s.reports))
+	for _, r := range s.reports {
+		if keep(r) {
+			reports = append(reports, r)
+		}
+	}
+	s.reports = reports
+}
+
 func (s Summary) Reports() (reports []Report) {
 	return s.reports
 }
 
 func (s Summary) HasFatalProblems() bool {
 	for _, r := range s.Reports() {
+		if r.Problem.SuppressedBy != "" {
+			continue
+		}
 		if r.Problem.Severity == checks.Fatal {
 			return true
 		}
@@ -112,6 +127,9 @@ func (s Summary) HasFatalProblems() bool {
 func (s Summary) CountBySeverity() map[checks.Severity]int {
 	m := map[checks.Severity]int{}
 	for _, report := range s.Reports() {
+		if report.Problem.SuppressedBy != "" {
+			continue
+		}
 		if _, ok := m[report.Problem.Severity]; !ok {
 			m[report.Problem.Severity] = 0
 		}